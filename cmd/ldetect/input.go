@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// maxJSONLLine bounds the size of a single JSON Lines record
+const maxJSONLLine = 16 << 20
+
+// inputKind selects the service an input is detected with
+type inputKind int
+
+const (
+	textInput inputKind = iota
+	documentInput
+	subtitleInput
+)
+
+// Subtitle MIME types accepted by -mime-type
+const (
+	mimeTypeSRT    = "application/x-subrip"
+	mimeTypeWebVTT = "text/vtt"
+)
+
+// fileKinds maps file extensions to their input kind and MIME type
+var fileKinds = map[string]struct {
+	kind     inputKind
+	mimeType string
+}{
+	".docx": {documentInput, domain.MimeTypeDOCX},
+	".odt":  {documentInput, domain.MimeTypeODT},
+	".epub": {documentInput, domain.MimeTypeEPUB},
+	".srt":  {subtitleInput, mimeTypeSRT},
+	".vtt":  {subtitleInput, mimeTypeWebVTT},
+}
+
+// input is a single unit of detection. Files are read when they are detected,
+// so large globs are not held in memory at once.
+type input struct {
+	source   string
+	kind     inputKind
+	mimeType string
+	path     string // read on detection when set
+	content  []byte
+	request  domain.LanguageDetectionRequest
+	err      error // set when the input could not be read
+}
+
+// collectInputs expands the command line arguments into inputs
+func collectInputs(args []string, stdin io.Reader, jsonl bool, mimeType string) ([]*input, error) {
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	var inputs []*input
+	for _, arg := range args {
+		if arg == "-" {
+			data, err := io.ReadAll(stdin)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read stdin: %w", err)
+			}
+			if jsonl {
+				inputs = append(inputs, parseJSONL("stdin", data)...)
+			} else {
+				inputs = append(inputs, stdinInput(data, mimeType))
+			}
+			continue
+		}
+
+		paths := []string{arg}
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid glob %q: %w", arg, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no files match %q", arg)
+			}
+			paths = matches
+		}
+
+		for _, path := range paths {
+			if !jsonl {
+				inputs = append(inputs, fileInput(path))
+				continue
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				inputs = append(inputs, &input{source: path, err: err})
+				continue
+			}
+			inputs = append(inputs, parseJSONL(path, data)...)
+		}
+	}
+	return inputs, nil
+}
+
+// stdinInput treats stdin as text, or as a file of the given MIME type
+func stdinInput(data []byte, mimeType string) *input {
+	in := &input{source: "stdin", content: data, mimeType: mimeType}
+	switch mimeType {
+	case "":
+		in.kind = textInput
+		in.request.Text = domain.Text(strings.TrimSpace(string(data)))
+	case mimeTypeSRT, mimeTypeWebVTT:
+		in.kind = subtitleInput
+	default:
+		in.kind = documentInput
+	}
+	return in
+}
+
+// fileInput selects the input kind of a file by its extension
+func fileInput(path string) *input {
+	in := &input{
+		source:   path,
+		path:     path,
+		kind:     documentInput,
+		mimeType: domain.MimeTypePlainText,
+	}
+	if kind, ok := fileKinds[strings.ToLower(filepath.Ext(path))]; ok {
+		in.kind = kind.kind
+		in.mimeType = kind.mimeType
+	}
+	in.request.DocumentID = filepath.Base(path)
+	return in
+}
+
+// parseJSONL turns every non-empty line into a text input named source:line
+func parseJSONL(source string, data []byte) []*input {
+	var inputs []*input
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), maxJSONLLine)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		in := &input{source: fmt.Sprintf("%s:%d", source, line), kind: textInput}
+		if err := json.Unmarshal(scanner.Bytes(), &in.request); err != nil {
+			in.err = fmt.Errorf("invalid JSON record: %w", err)
+		}
+		inputs = append(inputs, in)
+	}
+	if err := scanner.Err(); err != nil {
+		inputs = append(inputs, &input{source: fmt.Sprintf("%s:%d", source, line+1), err: err})
+	}
+	return inputs
+}
+
+// detectors holds the services inputs are detected with
+type detectors struct {
+	text      domain.LanguageDetectionService
+	documents domain.DocumentDetectionService
+	subtitles domain.SubtitleDetectionService
+}
+
+// detectAll detects inputs with the given number of workers and returns
+// their results in input order
+func (d *detectors) detectAll(ctx context.Context, inputs []*input, workers int) <-chan result {
+	pending := make(chan chan result, workers)
+	go func() {
+		defer close(pending)
+		for _, in := range inputs {
+			ch := make(chan result, 1)
+			select {
+			case pending <- ch:
+			case <-ctx.Done():
+				return
+			}
+			go func(in *input) {
+				ch <- d.detect(ctx, in)
+			}(in)
+		}
+	}()
+
+	results := make(chan result)
+	go func() {
+		defer close(results)
+		for ch := range pending {
+			results <- <-ch
+		}
+	}()
+	return results
+}
+
+// detect runs a single input through the matching service
+func (d *detectors) detect(ctx context.Context, in *input) result {
+	r := result{Source: in.source, DocumentID: in.request.DocumentID}
+	if in.err != nil {
+		r.Error = in.err.Error()
+		return r
+	}
+
+	content := in.content
+	if in.path != "" {
+		data, err := os.ReadFile(in.path)
+		if err != nil {
+			r.Error = err.Error()
+			return r
+		}
+		content = data
+	}
+
+	switch in.kind {
+	case textInput:
+		resp, err := d.text.DetectLanguage(ctx, &in.request)
+		if err != nil {
+			r.Error = err.Error()
+			return r
+		}
+		r.LanguageCode = resp.LanguageCode
+		r.Confidence = resp.Confidence
+		r.Provider = resp.Metadata.Provider
+
+	case documentInput:
+		resp, err := d.documents.DetectDocumentLanguage(ctx, &domain.DocumentDetectionRequest{
+			Content:    content,
+			MimeType:   in.mimeType,
+			DocumentID: in.request.DocumentID,
+		})
+		if err != nil {
+			r.Error = err.Error()
+			return r
+		}
+		r.LanguageCode = resp.LanguageCode
+		r.Confidence = resp.Confidence
+		r.Provider = resp.Metadata.Provider
+		if len(resp.Sections) > 1 {
+			r.Sections = resp.Sections
+		}
+
+	case subtitleInput:
+		format := domain.SubtitleFormatSRT
+		if in.mimeType == mimeTypeWebVTT {
+			format = domain.SubtitleFormatWebVTT
+		}
+		resp, err := d.subtitles.DetectSubtitleLanguage(ctx, &domain.SubtitleDetectionRequest{
+			Content: content,
+			Format:  format,
+			TrackID: in.request.DocumentID,
+		})
+		if err != nil {
+			r.Error = err.Error()
+			return r
+		}
+		r.LanguageCode = resp.LanguageCode
+		r.Confidence = resp.Confidence
+		r.Provider = resp.Metadata.Provider
+		r.DisagreeingCues = resp.DisagreeingCues
+	}
+
+	return r
+}
@@ -0,0 +1,132 @@
+// Command ldetect detects languages offline, without a running server. It
+// builds the same detector stack as the server from the same environment
+// variables and reads text from stdin, files, globs or JSON Lines.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/config"
+	"language-detection-service/internal/language_detection/infrastructure/stack"
+)
+
+// Exit codes
+const (
+	exitOK     = 0
+	exitFailed = 1 // at least one input could not be detected
+	exitUsage  = 2
+)
+
+const usage = `Usage: ldetect [flags] [file|glob|-]...
+
+Detects the language of each input. Without arguments, or with "-", text is
+read from stdin. Files ending in .docx, .odt or .epub are read as documents,
+.srt and .vtt as subtitles, and anything else as plain text. With -jsonl,
+every line is a {"text": ..., "document_id": ...} record.
+
+The detector is configured with the same config file and environment variables
+as the server, e.g. USE_AWS_COMPREHEND=false selects pattern-based detection.
+
+Flags:
+`
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns its exit code
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("ldetect", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "table", "output format: table, json (one object per line) or csv")
+	jsonl := flags.Bool("jsonl", false, "read inputs as JSON Lines records")
+	mimeType := flags.String("mime-type", "", "MIME type of stdin; detects stdin as a document or subtitle track instead of text")
+	workers := flags.Int("workers", 4, "number of inputs detected in parallel")
+	verbose := flags.Bool("v", false, "log detector setup to stderr")
+	configFile := flags.String("config", os.Getenv("CONFIG_FILE"), "YAML or JSON config file of the server, overridden by environment variables")
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if *workers < 1 {
+		fmt.Fprintln(stderr, "ldetect: -workers must be positive")
+		return exitUsage
+	}
+
+	writer, err := newResultWriter(*format, stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "ldetect: %v\n", err)
+		return exitUsage
+	}
+
+	logOutput := io.Discard
+	if *verbose {
+		logOutput = stderr
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(logOutput, nil)))
+
+	inputs, err := collectInputs(flags.Args(), stdin, *jsonl, *mimeType)
+	if err != nil {
+		fmt.Fprintf(stderr, "ldetect: %v\n", err)
+		return exitUsage
+	}
+
+	// Build the same detector stack as the server
+	configProvider, err := config.LoadConfigProvider(*configFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "ldetect: %v\n", err)
+		return exitUsage
+	}
+	if err := configProvider.ValidateConfig(); err != nil {
+		fmt.Fprintf(stderr, "ldetect: configuration validation failed: %v\n", err)
+		return exitUsage
+	}
+
+	detection, err := stack.New(configProvider, domain.NopMetrics{})
+	if err != nil {
+		fmt.Fprintf(stderr, "ldetect: %v\n", err)
+		return exitUsage
+	}
+	d := &detectors{
+		text:      detection.Service,
+		documents: detection.Documents,
+		subtitles: detection.Subtitles,
+	}
+
+	failed := false
+	for result := range d.detectAll(ctx, inputs, *workers) {
+		if result.Error != "" {
+			failed = true
+		}
+		if err := writer.Write(result); err != nil {
+			fmt.Fprintf(stderr, "ldetect: %v\n", err)
+			return exitFailed
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		fmt.Fprintf(stderr, "ldetect: %v\n", err)
+		return exitFailed
+	}
+
+	if ctx.Err() != nil || failed {
+		return exitFailed
+	}
+	return exitOK
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runCommand(t *testing.T, stdin string, args ...string) (string, string, int) {
+	t.Helper()
+	t.Setenv("USE_AWS_COMPREHEND", "false")
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestRun_Stdin(t *testing.T) {
+	stdout, _, code := runCommand(t, "the technology is in the cloud and it is crucial\n", "-format", "json")
+
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d", exitOK, code)
+	}
+
+	var r result
+	if err := json.Unmarshal([]byte(stdout), &r); err != nil {
+		t.Fatalf("Expected a JSON result, got %q: %v", stdout, err)
+	}
+
+	if r.Source != "stdin" || r.LanguageCode != "en-US" || r.Provider != "fallback" {
+		t.Errorf("Unexpected result: %+v", r)
+	}
+}
+
+func TestRun_FilesAndGlobs(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "Bonjour et bienvenue à la présentation, merci pour votre attention")
+	writeFile(t, dir, "b.txt", "Hola y bienvenidos a la presentación de hoy, gracias por su atención")
+	srt := writeFile(t, dir, "c.srt", "1\n00:00:01,000 --> 00:00:02,000\nthe presentation is about the technology and the cloud\n")
+
+	stdout, _, code := runCommand(t, "", "-format", "csv", filepath.Join(dir, "*.txt"), srt)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d", exitOK, code)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected header and 3 rows, got %q", stdout)
+	}
+
+	expected := []string{"fr-FR", "es-ES", "en-US"}
+	for i, language := range expected {
+		if !strings.Contains(lines[i+1], ","+language+",") {
+			t.Errorf("Expected row %d to detect %s, got %q", i+1, language, lines[i+1])
+		}
+	}
+}
+
+func TestRun_JSONLWithFailures(t *testing.T) {
+	stdin := `{"text": "Hola y bienvenidos a la presentación de hoy", "document_id": "doc-1"}
+
+not json
+{"text": ""}
+`
+	stdout, _, code := runCommand(t, stdin, "-jsonl", "-format", "json")
+
+	if code != exitFailed {
+		t.Errorf("Expected exit code %d, got %d", exitFailed, code)
+	}
+
+	var results []result
+	decoder := json.NewDecoder(strings.NewReader(stdout))
+	for decoder.More() {
+		var r result
+		if err := decoder.Decode(&r); err != nil {
+			t.Fatalf("Failed to decode output: %v", err)
+		}
+		results = append(results, r)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d: %q", len(results), stdout)
+	}
+
+	if results[0].Source != "stdin:1" || results[0].DocumentID != "doc-1" || results[0].LanguageCode != "es-ES" {
+		t.Errorf("Unexpected first result: %+v", results[0])
+	}
+
+	if results[1].Source != "stdin:3" || results[1].Error == "" {
+		t.Errorf("Expected invalid record on line 3, got %+v", results[1])
+	}
+
+	if results[2].Error == "" {
+		t.Errorf("Expected empty text to fail, got %+v", results[2])
+	}
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"Unknown format", []string{"-format", "xml"}},
+		{"Unknown flag", []string{"-bogus"}},
+		{"Unmatched glob", []string{filepath.Join(t.TempDir(), "*.txt")}},
+		{"Invalid workers", []string{"-workers", "0"}},
+		{"Missing config file", []string{"-config", filepath.Join(t.TempDir(), "missing.yaml")}},
+		{"Invalid config value", []string{"-config", writeFile(t, t.TempDir(), "config.yaml", "max_text_length: many\n")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, code := runCommand(t, "text", tt.args...)
+			if code != exitUsage {
+				t.Errorf("Expected exit code %d, got %d", exitUsage, code)
+			}
+		})
+	}
+}
+
+func TestFileInput_Kinds(t *testing.T) {
+	tests := []struct {
+		path     string
+		kind     inputKind
+		mimeType string
+	}{
+		{"book.EPUB", documentInput, "application/epub+zip"},
+		{"report.docx", documentInput, "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		{"notes.md", documentInput, "text/plain"},
+		{"track.vtt", subtitleInput, mimeTypeWebVTT},
+	}
+
+	for _, tt := range tests {
+		in := fileInput(tt.path)
+		if in.kind != tt.kind || in.mimeType != tt.mimeType {
+			t.Errorf("fileInput(%q) = kind %d, %s; want kind %d, %s", tt.path, in.kind, in.mimeType, tt.kind, tt.mimeType)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// result is the outcome of detecting a single input
+type result struct {
+	Source          string                    `json:"source"`
+	DocumentID      string                    `json:"document_id,omitempty"`
+	LanguageCode    domain.LanguageCode       `json:"language_code,omitempty"`
+	Confidence      domain.Confidence         `json:"confidence,omitempty"`
+	Provider        string                    `json:"provider,omitempty"`
+	Sections        []domain.SectionDetection `json:"sections,omitempty"`
+	DisagreeingCues []domain.CueDetection     `json:"disagreeing_cues,omitempty"`
+	Error           string                    `json:"error,omitempty"`
+}
+
+// resultWriter prints results in one output format
+type resultWriter interface {
+	Write(r result) error
+	Flush() error
+}
+
+// newResultWriter returns the writer for the named format
+func newResultWriter(format string, w io.Writer) (resultWriter, error) {
+	switch format {
+	case "table":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "SOURCE\tLANGUAGE\tCONFIDENCE\tPROVIDER\tERROR")
+		return &tableWriter{w: tw}, nil
+	case "json":
+		return &jsonWriter{encoder: json.NewEncoder(w)}, nil
+	case "csv":
+		cw := csv.NewWriter(w)
+		err := cw.Write([]string{"source", "document_id", "language_code", "confidence", "provider", "error"})
+		return &csvWriter{w: cw}, err
+	default:
+		return nil, fmt.Errorf("unknown output format %q (want table, json or csv)", format)
+	}
+}
+
+// tableWriter prints aligned columns, with document sections and
+// disagreeing subtitle cues indented below their input
+type tableWriter struct {
+	w *tabwriter.Writer
+}
+
+func (t *tableWriter) Write(r result) error {
+	fmt.Fprintf(t.w, "%s\t%s\t%s\t%s\t%s\n",
+		r.Source, r.LanguageCode, formatConfidence(r.Confidence, r.Error), r.Provider, r.Error)
+
+	for _, section := range r.Sections {
+		name := section.Name
+		if name == "" {
+			name = fmt.Sprintf("section %d", section.Index+1)
+		}
+		_, err := fmt.Fprintf(t.w, "  %s\t%s\t%s\t\t%s\n",
+			name, section.LanguageCode, formatConfidence(section.Confidence, section.Error), section.Error)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, cue := range r.DisagreeingCues {
+		_, err := fmt.Fprintf(t.w, "  %s --> %s\t%s\t%s\t\t%s\n",
+			formatCueTime(cue.Start), formatCueTime(cue.End), cue.SmoothedLanguageCode,
+			formatConfidence(cue.SmoothedConfidence, ""), cue.Text)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *tableWriter) Flush() error {
+	return t.w.Flush()
+}
+
+// jsonWriter prints one JSON object per line
+type jsonWriter struct {
+	encoder *json.Encoder
+}
+
+func (j *jsonWriter) Write(r result) error {
+	return j.encoder.Encode(r)
+}
+
+func (j *jsonWriter) Flush() error {
+	return nil
+}
+
+// csvWriter prints one row per input
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Write(r result) error {
+	err := c.w.Write([]string{
+		r.Source,
+		r.DocumentID,
+		string(r.LanguageCode),
+		formatConfidence(r.Confidence, r.Error),
+		r.Provider,
+		r.Error,
+	})
+	c.w.Flush()
+	return err
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// formatConfidence formats a confidence, leaving failed results blank
+func formatConfidence(confidence domain.Confidence, err string) string {
+	if err != "" {
+		return ""
+	}
+	return strconv.FormatFloat(float64(confidence), 'f', 2, 32)
+}
+
+// formatCueTime formats a cue timestamp as HH:MM:SS.mmm
+func formatCueTime(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d:%02d.%03d",
+		int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60, d.Milliseconds()%1000)
+}
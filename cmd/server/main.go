@@ -2,20 +2,72 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	grpcpkg "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
 	"language-detection-service/internal/language_detection/application"
 	"language-detection-service/internal/language_detection/domain"
-	"language-detection-service/internal/language_detection/infrastructure/adapters"
+	"language-detection-service/internal/language_detection/infrastructure/audit"
+	"language-detection-service/internal/language_detection/infrastructure/auth"
+	"language-detection-service/internal/language_detection/infrastructure/cache"
+	"language-detection-service/internal/language_detection/infrastructure/certs"
 	"language-detection-service/internal/language_detection/infrastructure/config"
 	"language-detection-service/internal/language_detection/infrastructure/grpc"
+	"language-detection-service/internal/language_detection/infrastructure/http"
+	"language-detection-service/internal/language_detection/infrastructure/logging"
+	"language-detection-service/internal/language_detection/infrastructure/metrics"
+	"language-detection-service/internal/language_detection/infrastructure/ratelimit"
+	"language-detection-service/internal/language_detection/infrastructure/stack"
+	"language-detection-service/internal/language_detection/infrastructure/storage"
+	"language-detection-service/internal/language_detection/infrastructure/tracing"
 )
 
+// resultCacheBackend is a result cache that reports its hit and miss counts
+type resultCacheBackend interface {
+	domain.ResultCache
+	domain.CacheStatsReporter
+}
+
+// newAuthenticator loads the configured API keys and JWT verification keys
+func newAuthenticator(cfg *config.Config) (*auth.Authenticator, error) {
+	var apiKeys *auth.APIKeys
+	if cfg.AuthAPIKeysFile != "" {
+		var err error
+		if apiKeys, err = auth.LoadAPIKeys(cfg.AuthAPIKeysFile); err != nil {
+			return nil, err
+		}
+		slog.Info("Loaded API keys", "count", apiKeys.Len(), "file", cfg.AuthAPIKeysFile)
+	}
+
+	var tokens *auth.JWTVerifier
+	if cfg.AuthJWKSFile != "" {
+		var err error
+		if tokens, err = auth.LoadJWTVerifier(cfg.AuthJWKSFile, cfg.AuthJWTIssuer, cfg.AuthJWTAudience, cfg.AuthTenantClaim); err != nil {
+			return nil, err
+		}
+		slog.Info("Loaded JWT verification keys", "file", cfg.AuthJWKSFile)
+	}
+
+	return auth.NewAuthenticator(apiKeys, tokens), nil
+}
+
+// fatal logs err and exits
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
 // createSignalContext creates a context that gets cancelled on SIGINT or SIGTERM
 func createSignalContext() (context.Context, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -25,7 +77,7 @@ func createSignalContext() (context.Context, context.CancelFunc) {
 
 	go func() {
 		<-sigChan
-		log.Println("Received shutdown signal, cancelling context...")
+		slog.Info("Received shutdown signal, cancelling context")
 		cancel()
 	}()
 
@@ -33,72 +85,328 @@ func createSignalContext() (context.Context, context.CancelFunc) {
 }
 
 func main() {
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML or JSON config file, overridden by environment variables")
+	printConfig := flag.Bool("print-config", false, "print the effective configuration with secrets redacted and exit")
+	flag.Parse()
+
 	// Load configuration
-	configProvider := config.NewConfigProvider()
+	configProvider, err := config.LoadConfigProvider(*configFile)
+	if err != nil {
+		fatal("Failed to load configuration", err)
+	}
+
+	if *printConfig {
+		if err := configProvider.WriteConfig(os.Stdout); err != nil {
+			fatal("Failed to print configuration", err)
+		}
+		if err := configProvider.ValidateConfig(); err != nil {
+			fatal("Configuration validation failed", err)
+		}
+		return
+	}
 
 	// Validate configuration
 	if err := configProvider.ValidateConfig(); err != nil {
-		log.Fatalf("Configuration validation failed: %v", err)
+		fatal("Configuration validation failed", err)
 	}
 
 	cfg := configProvider.GetConfig()
-	log.Printf("Starting Language Detection Service with configuration:")
-	log.Printf("  Server Address: %s:%d", cfg.ServerAddress, cfg.ServerPort)
-	log.Printf("  AWS Comprehend: %v", cfg.UseAWSComprehend)
-	log.Printf("  AWS Region: %s", cfg.AWSRegion)
-	log.Printf("  Max Text Length: %d", cfg.MaxTextLength)
-	log.Printf("  Min Confidence: %.2f", cfg.MinConfidenceThreshold)
-	log.Printf("  Supported Languages: %v", cfg.SupportedLanguages)
-
-	// Create language detector based on configuration
-	var detector domain.LanguageDetector
-	var err error
-
-	if cfg.UseAWSComprehend {
-		detector, err = adapters.NewAWSComprehendAdapter(cfg.AWSRegion, 3)
-		if err != nil {
-			log.Printf("Warning: Failed to create AWS Comprehend adapter: %v", err)
-			log.Printf("Falling back to pattern-based detection")
-			detector = adapters.NewFallbackAdapter()
+
+	// Log JSON records at the configured level, which was validated above
+	logLevel, _ := logging.ParseLevel(cfg.LogLevel)
+	slog.SetDefault(logging.New(os.Stderr, logLevel))
+
+	slog.Info("Starting Language Detection Service",
+		"server_address", fmt.Sprintf("%s:%d", cfg.ServerAddress, cfg.ServerPort),
+		"http_port", cfg.HTTPPort,
+		"metrics_port", cfg.MetricsPort,
+		"aws_comprehend", cfg.UseAWSComprehend,
+		"aws_region", cfg.AWSRegion,
+		"max_text_length", cfg.MaxTextLength,
+		"min_confidence", cfg.MinConfidenceThreshold,
+		"supported_languages", cfg.SupportedLanguages,
+		"log_level", cfg.LogLevel,
+	)
+	if cfg.LogRequestText {
+		slog.Warn("Request texts are included in access logs, which is meant for debugging only")
+	}
+
+	// Record request, detection and provider metrics for Prometheus
+	var metricsRecorder domain.Metrics = domain.NopMetrics{}
+	var prometheus *metrics.Prometheus
+	if cfg.MetricsPort > 0 {
+		prometheus = metrics.NewPrometheus()
+		metricsRecorder = prometheus
+	}
+
+	// Create the detector, the text service and the services built on it
+	detection, err := stack.New(configProvider, metricsRecorder)
+	if err != nil {
+		fatal("Failed to create the detection services", err)
+	}
+	service := detection.Service
+
+	// Serve repeated texts from the result cache: in-process, shared through
+	// Redis between replicas, or both
+	var resultCache resultCacheBackend
+	cacheTTL := time.Duration(cfg.CacheTTLSeconds) * time.Second
+	if cfg.CacheSize > 0 {
+		resultCache = cache.NewLRUCache(cfg.CacheSize, cacheTTL)
+		slog.Info("Result cache enabled", "entries", cfg.CacheSize, "ttl_seconds", cfg.CacheTTLSeconds)
+	}
+	if cfg.RedisAddress != "" {
+		redisClient := redis.NewClient(&redis.Options{
+			Addr:     cfg.RedisAddress,
+			Password: cfg.RedisPassword,
+			DB:       cfg.RedisDB,
+
+			// Let the per-call timeout bound reads and writes too
+			ContextTimeoutEnabled: true,
+		})
+		defer redisClient.Close()
+
+		shared := cache.NewRedisCache(redisClient, cacheTTL, time.Duration(cfg.RedisTimeoutMs)*time.Millisecond)
+		if resultCache != nil {
+			resultCache = cache.NewTieredCache(resultCache, shared)
 		} else {
-			log.Println("Using AWS Comprehend for language detection")
+			resultCache = shared
+		}
+		slog.Info("Shared result cache enabled", "redis_address", cfg.RedisAddress, "ttl_seconds", cfg.CacheTTLSeconds)
+	}
+	if resultCache != nil {
+		service.WithCache(resultCache)
+	}
+
+	// Create context that will be cancelled on signal
+	ctx, cancel := createSignalContext()
+	defer cancel()
+
+	serverOpts := []grpcpkg.ServerOption{
+		grpc.WithLanguageCatalog(detection.Catalog),
+		grpc.WithDocumentService(detection.Documents),
+		grpc.WithSubtitleService(detection.Subtitles),
+		grpc.WithRawTextService(detection.Raw),
+
+		// Tag every call with a request ID for the logs
+		grpcpkg.ChainUnaryInterceptor(grpc.UnaryRequestIDInterceptor()),
+		grpcpkg.ChainStreamInterceptor(grpc.StreamRequestIDInterceptor()),
+	}
+
+	// Log every call, describing request texts by length and hash only
+	var redactor *logging.Redactor
+	if cfg.AccessLog {
+		redactor = logging.NewRedactor(cfg.LogTextHashKey, cfg.LogRequestText)
+		serverOpts = append(serverOpts,
+			grpcpkg.ChainUnaryInterceptor(grpc.UnaryAccessLogInterceptor(slog.Default(), redactor)),
+			grpcpkg.ChainStreamInterceptor(grpc.StreamAccessLogInterceptor(slog.Default())),
+		)
+	}
+
+	// Count every call, including those rejected by the interceptors below
+	serverOpts = append(serverOpts,
+		grpcpkg.ChainUnaryInterceptor(grpc.UnaryMetricsInterceptor(metricsRecorder)),
+		grpcpkg.ChainStreamInterceptor(grpc.StreamMetricsInterceptor(metricsRecorder)),
+	)
+
+	// Trace detections through the service and adapters, continuing the trace
+	// context sent by gRPC callers
+	if cfg.TracingExporter != "" {
+		tracer, err := tracing.New(ctx, cfg)
+		if err != nil {
+			fatal("Failed to set up tracing", err)
 		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+			if err := tracer.Shutdown(shutdownCtx); err != nil {
+				slog.Error("Error flushing traces", "error", err)
+			}
+		}()
+
+		propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+		otel.SetTracerProvider(tracer.TracerProvider())
+		otel.SetTextMapPropagator(propagator)
+		serverOpts = append(serverOpts, grpc.TracingOption(tracer.TracerProvider(), propagator))
+		slog.Info("Tracing enabled", "exporter", cfg.TracingExporter, "sample_ratio", cfg.TracingSampleRatio)
+	}
+
+	// Serve gRPC and the gateway over TLS, picking up rotated certificates
+	var tlsReloader *certs.Reloader
+	if cfg.TLSCertFile != "" {
+		minVersion, err := certs.ParseVersion(cfg.TLSMinVersion)
+		if err != nil {
+			fatal("Invalid TLS configuration", err)
+		}
+		tlsReloader, err = certs.NewReloader(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSClientCAFile, minVersion)
+		if err != nil {
+			fatal("Failed to load TLS certificates", err)
+		}
+		go tlsReloader.Watch(ctx, time.Duration(cfg.TLSReloadIntervalSecs)*time.Second)
+
+		serverOpts = append(serverOpts, grpcpkg.Creds(credentials.NewTLS(tlsReloader.ServerConfig())))
+		slog.Info("TLS enabled", "cert_file", cfg.TLSCertFile, "min_version", cfg.TLSMinVersion,
+			"client_certificates_required", cfg.TLSClientCAFile != "")
+	}
+
+	// Authenticate callers, which also binds them to their tenant. Without
+	// authentication the tenant comes from the tenant header.
+	var authenticator *auth.Authenticator
+	if cfg.AuthEnabled() {
+		authenticator, err = newAuthenticator(cfg)
+		if err != nil {
+			fatal("Failed to set up authentication", err)
+		}
+		exempt := cfg.AuthExemptions()
+		serverOpts = append(serverOpts,
+			grpcpkg.ChainUnaryInterceptor(grpc.UnaryAuthInterceptor(authenticator, exempt)),
+			grpcpkg.ChainStreamInterceptor(grpc.StreamAuthInterceptor(authenticator, exempt)),
+		)
+		slog.Info("Authentication enabled", "exempt_methods", exempt)
 	} else {
-		detector = adapters.NewFallbackAdapter()
-		log.Println("Using fallback pattern-based language detection")
+		serverOpts = append(serverOpts,
+			grpcpkg.ChainUnaryInterceptor(grpc.UnaryTenantInterceptor()),
+			grpcpkg.ChainStreamInterceptor(grpc.StreamTenantInterceptor()),
+		)
+	}
+
+	// Limit each client to the rate of its class. This runs after
+	// authentication so that buckets belong to verified callers.
+	rateLimits, rateLimitClients, err := cfg.RateLimits()
+	if err != nil {
+		fatal("Invalid rate limits", err)
+	}
+	var limiter *ratelimit.Limiter
+	if rateLimits != nil {
+		limiter = ratelimit.NewLimiter(rateLimits, rateLimitClients)
+		serverOpts = append(serverOpts,
+			grpcpkg.ChainUnaryInterceptor(grpc.UnaryRateLimitInterceptor(limiter)),
+			grpcpkg.ChainStreamInterceptor(grpc.StreamRateLimitInterceptor(limiter)),
+		)
+		slog.Info("Rate limiting enabled", "client_classes", len(rateLimits))
+	}
+
+	// Account usage per tenant and enforce quotas with the local usage ledger
+	var usage *application.UsageServiceImpl
+	if cfg.UsageStorePath != "" {
+		quotas, err := cfg.Quotas()
+		if err != nil {
+			fatal("Invalid tenant quotas", err)
+		}
+
+		ledger, err := storage.NewBoltUsageLedger(cfg.UsageStorePath)
+		if err != nil {
+			fatal("Failed to open usage ledger", err)
+		}
+		defer ledger.Close()
+
+		usage = application.NewUsageService(ledger, quotas)
+		service.WithUsage(usage)
+		serverOpts = append(serverOpts, grpc.WithUsageService(usage))
+		slog.Info("Usage accounting enabled", "store", cfg.UsageStorePath, "tenant_quotas", len(quotas))
+	}
+
+	// Record every detection in rotating audit files, off the request path
+	if cfg.AuditLogFile != "" {
+		auditFile, err := audit.NewRotatingFile(cfg.AuditLogFile, int64(cfg.AuditMaxSizeMB)<<20,
+			time.Duration(cfg.AuditMaxAgeSeconds)*time.Second, cfg.AuditCompress)
+		if err != nil {
+			fatal("Failed to open audit log", err)
+		}
+
+		auditSink := audit.NewSink(auditFile, cfg.AuditBufferSize)
+		defer func() {
+			if err := auditSink.Close(); err != nil {
+				slog.Error("Failed to close audit log", "error", err)
+			}
+			slog.Info("Audit log closed", "written", auditSink.Written(), "dropped", auditSink.Dropped())
+		}()
+		service.WithAudit(auditSink)
+		slog.Info("Audit log enabled", "file", cfg.AuditLogFile, "max_size_mb", cfg.AuditMaxSizeMB,
+			"max_age_seconds", cfg.AuditMaxAgeSeconds, "compress", cfg.AuditCompress)
 	}
 
-	// Create application service
-	service := application.NewLanguageDetectionService(detector, configProvider)
+	// Create asynchronous detection jobs backed by the local job store
+	if cfg.JobStorePath != "" {
+		jobStore, err := storage.NewBoltJobStore(cfg.JobStorePath)
+		if err != nil {
+			fatal("Failed to open job store", err)
+		}
+		defer jobStore.Close()
+
+		jobManager := application.NewJobManager(service, jobStore, cfg.JobWorkers, cfg.JobMaxDocuments)
+		if usage != nil {
+			jobManager.WithUsage(usage)
+		}
+		go func() {
+			if err := jobManager.Run(ctx); err != nil && err != context.Canceled {
+				slog.Error("Detection job manager stopped", "error", err)
+			}
+		}()
+		serverOpts = append(serverOpts, grpc.WithJobService(jobManager))
+		slog.Info("Detection jobs enabled", "store", cfg.JobStorePath)
+	}
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer(service)
+	grpcServer := grpc.NewServer(service, serverOpts...)
 
 	// Create server address
 	address := fmt.Sprintf("%s:%d", cfg.ServerAddress, cfg.ServerPort)
 
-	// Create context that will be cancelled on signal
-	ctx, cancel := createSignalContext()
-	defer cancel()
-
 	// Start gRPC server in a goroutine with context support
-	serverErr := make(chan error, 1)
+	serverErr := make(chan error, 3)
 	go func() {
-		log.Printf("Starting gRPC server on %s", address)
 		if err := grpcServer.StartWithContext(ctx, address); err != nil {
 			serverErr <- fmt.Errorf("server failed to start: %w", err)
 		}
 	}()
 
+	// Start the REST/JSON gateway next to the gRPC listener
+	var httpServer *http.Server
+	if cfg.HTTPPort > 0 {
+		httpServer = http.NewServer(service).WithMetrics(metricsRecorder)
+		if redactor != nil {
+			httpServer.WithAccessLog(slog.Default(), redactor)
+		}
+		if resultCache != nil {
+			httpServer.WithCacheStats(resultCache)
+		}
+		if authenticator != nil {
+			httpServer.WithAuthenticator(authenticator)
+		}
+		if limiter != nil {
+			httpServer.WithRateLimiter(limiter)
+		}
+		if tlsReloader != nil {
+			httpServer.WithTLS(tlsReloader.ServerConfig())
+		}
+		httpAddress := fmt.Sprintf("%s:%d", cfg.ServerAddress, cfg.HTTPPort)
+		go func() {
+			if err := httpServer.StartWithContext(ctx, httpAddress); err != nil && err != context.Canceled {
+				serverErr <- fmt.Errorf("HTTP gateway failed to start: %w", err)
+			}
+		}()
+	}
+
+	// Serve the metrics on their own port
+	if prometheus != nil {
+		metricsAddress := fmt.Sprintf("%s:%d", cfg.ServerAddress, cfg.MetricsPort)
+		metricsServer := metrics.NewServer(prometheus.Handler())
+		go func() {
+			if err := metricsServer.StartWithContext(ctx, metricsAddress); err != nil && err != context.Canceled {
+				serverErr <- fmt.Errorf("metrics server failed to start: %w", err)
+			}
+		}()
+	}
+
 	// Wait for server to start
 	time.Sleep(2 * time.Second)
 
 	// Wait for context cancellation (signal) or server error
 	select {
 	case err := <-serverErr:
-		log.Fatalf("Server error: %v", err)
+		fatal("Server error", err)
 	case <-ctx.Done():
-		log.Printf("Shutdown signal received, shutting down gracefully...")
+		slog.Info("Shutdown signal received, shutting down gracefully")
 
 		// Graceful shutdown with timeout
 		_, shutdownCancel := context.WithTimeout(context.Background(),
@@ -107,9 +415,25 @@ func main() {
 
 		// Stop the server
 		if err := grpcServer.Stop(); err != nil {
-			log.Printf("Error during server shutdown: %v", err)
+			slog.Error("Error during server shutdown", "error", err)
+		}
+
+		if httpServer != nil {
+			if err := httpServer.Stop(); err != nil {
+				slog.Error("Error during HTTP gateway shutdown", "error", err)
+			}
 		}
 
-		log.Println("Language Detection Service stopped")
+		if resultCache != nil {
+			stats := resultCache.CacheStats()
+			slog.Info("Result cache statistics", "hits", stats.Hits, "misses", stats.Misses, "evictions", stats.Evictions)
+			if stats.Shared != nil {
+				slog.Info("Shared result cache statistics", "hits", stats.Shared.Hits, "misses", stats.Shared.Misses, "errors", stats.Shared.Errors)
+			}
+		}
+
+		slog.Info("Coalesced requests", "count", service.CoalescedRequests())
+
+		slog.Info("Language Detection Service stopped")
 	}
 }
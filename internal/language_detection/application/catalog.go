@@ -0,0 +1,102 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// LanguageCatalogServiceImpl implements the LanguageCatalogService interface.
+// Languages are listed as the caller's tenant sees them: its supported
+// languages, under the codes of its profile.
+type LanguageCatalogServiceImpl struct {
+	config    domain.ConfigProvider
+	profiles  domain.ConfigResolver
+	providers []domain.LanguageProvider
+}
+
+// NewLanguageCatalogService creates a new language catalog service
+func NewLanguageCatalogService(
+	config domain.ConfigProvider,
+	providers ...domain.LanguageProvider,
+) *LanguageCatalogServiceImpl {
+	return &LanguageCatalogServiceImpl{
+		config:    config,
+		providers: providers,
+	}
+}
+
+// WithConfigResolver lists the supported languages of each tenant's profile
+// instead of the service-wide ones
+func (s *LanguageCatalogServiceImpl) WithConfigResolver(profiles domain.ConfigResolver) *LanguageCatalogServiceImpl {
+	s.profiles = profiles
+	return s
+}
+
+// ListSupportedLanguages returns the configured languages with their reference data.
+// The undetermined marker "unknown" is not a language and is left out.
+func (s *LanguageCatalogServiceImpl) ListSupportedLanguages(ctx context.Context) ([]domain.LanguageInfo, error) {
+	config := tenantConfig(ctx, s.profiles, s.config)
+	var languages []domain.LanguageInfo
+	for _, code := range config.GetSupportedLanguages() {
+		if code == domain.UnknownLanguage {
+			continue
+		}
+		languages = append(languages, s.describe(code, config))
+	}
+	return languages, nil
+}
+
+// GetLanguageInfo returns the description of a single supported language
+func (s *LanguageCatalogServiceImpl) GetLanguageInfo(ctx context.Context, code domain.LanguageCode) (*domain.LanguageInfo, error) {
+	if code == "" {
+		return nil, fmt.Errorf("%w: language code is required", domain.ErrInvalidRequest)
+	}
+
+	config := tenantConfig(ctx, s.profiles, s.config)
+	for _, supported := range config.GetSupportedLanguages() {
+		if supported == code && code != domain.UnknownLanguage {
+			info := s.describe(code, config)
+			return &info, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s is not supported", domain.ErrInvalidLanguageCode, code)
+}
+
+// describe combines reference data with the providers able to produce the
+// language. A profile's own codes are matched to the provider codes they map
+// from, whose reference data is used if the profile's code has none.
+func (s *LanguageCatalogServiceImpl) describe(code domain.LanguageCode, config domain.ConfigProvider) domain.LanguageInfo {
+	profile, hasProfile := config.(domain.DetectionProfile)
+	mapped := func(producible domain.LanguageCode) domain.LanguageCode {
+		if hasProfile {
+			return profile.MapLanguageCode(producible)
+		}
+		return producible
+	}
+
+	info, found := domain.LookupLanguageInfo(code)
+	for _, provider := range s.providers {
+		for _, producible := range provider.ProducibleLanguages() {
+			if mapped(producible) != code {
+				continue
+			}
+			if !found {
+				info, found = domain.LookupLanguageInfo(producible)
+				info.LanguageCode = code
+			}
+			info.Providers = append(info.Providers, provider.ProviderName())
+			break
+		}
+	}
+
+	if !found {
+		info.EnglishName = string(code)
+		info.NativeName = string(code)
+		info.Direction = domain.DirectionLTR
+	}
+
+	return info
+}
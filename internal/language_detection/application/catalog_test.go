@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MockLanguageProvider is a mock implementation of LanguageProvider
+type MockLanguageProvider struct {
+	name      string
+	languages []domain.LanguageCode
+}
+
+func (m *MockLanguageProvider) ProviderName() string {
+	return m.name
+}
+
+func (m *MockLanguageProvider) ProducibleLanguages() []domain.LanguageCode {
+	return m.languages
+}
+
+func newTestCatalog() *LanguageCatalogServiceImpl {
+	config := &MockConfigProvider{
+		supportedLanguages: []domain.LanguageCode{"en-US", "ar-SA", "xx-XX", "unknown"},
+	}
+	return NewLanguageCatalogService(config,
+		&MockLanguageProvider{name: "fallback", languages: []domain.LanguageCode{"en-US"}},
+		&MockLanguageProvider{name: "aws-comprehend", languages: []domain.LanguageCode{"en-US", "ar-SA"}},
+	)
+}
+
+func TestLanguageCatalog_ListSupportedLanguages(t *testing.T) {
+	catalog := newTestCatalog()
+
+	languages, err := catalog.ListSupportedLanguages(context.Background())
+	if err != nil {
+		t.Fatalf("ListSupportedLanguages() error = %v, want nil", err)
+	}
+
+	// "unknown" is not a language and must be skipped
+	if len(languages) != 3 {
+		t.Fatalf("Expected 3 languages, got %d: %v", len(languages), languages)
+	}
+
+	english := languages[0]
+	if english.LanguageCode != "en-US" || english.EnglishName != "English" {
+		t.Errorf("Expected English first, got %+v", english)
+	}
+	if len(english.Providers) != 2 {
+		t.Errorf("Expected 2 providers for en-US, got %v", english.Providers)
+	}
+
+	arabic := languages[1]
+	if arabic.Direction != domain.DirectionRTL {
+		t.Errorf("Expected Arabic to be right-to-left, got %s", arabic.Direction)
+	}
+	if len(arabic.Providers) != 1 || arabic.Providers[0] != "aws-comprehend" {
+		t.Errorf("Expected only aws-comprehend for ar-SA, got %v", arabic.Providers)
+	}
+
+	// Languages without reference data are still listed under their code
+	unlisted := languages[2]
+	if unlisted.EnglishName != "xx-XX" || unlisted.NativeName != "xx-XX" {
+		t.Errorf("Expected code as name for unlisted language, got %+v", unlisted)
+	}
+	if len(unlisted.Providers) != 0 {
+		t.Errorf("Expected no providers for unlisted language, got %v", unlisted.Providers)
+	}
+}
+
+func TestLanguageCatalog_GetLanguageInfo(t *testing.T) {
+	catalog := newTestCatalog()
+	ctx := context.Background()
+
+	info, err := catalog.GetLanguageInfo(ctx, "ar-SA")
+	if err != nil {
+		t.Fatalf("GetLanguageInfo() error = %v, want nil", err)
+	}
+
+	if info.NativeName != "العربية" {
+		t.Errorf("Expected native name 'العربية', got %s", info.NativeName)
+	}
+
+	if info.Script != "Arab" {
+		t.Errorf("Expected script 'Arab', got %s", info.Script)
+	}
+}
+
+func TestLanguageCatalog_GetLanguageInfo_Errors(t *testing.T) {
+	catalog := newTestCatalog()
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		code     domain.LanguageCode
+		expected error
+	}{
+		{"Empty code", "", domain.ErrInvalidRequest},
+		{"Unsupported code", "fr-FR", domain.ErrInvalidLanguageCode},
+		{"Undetermined marker", "unknown", domain.ErrInvalidLanguageCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := catalog.GetLanguageInfo(ctx, tt.code)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("GetLanguageInfo(%q) error = %v, want %v", tt.code, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguageCatalog_TenantProfile(t *testing.T) {
+	profiles := MockConfigResolver{
+		domain.DefaultTenant: &MockConfigProvider{supportedLanguages: []domain.LanguageCode{"en-US", "ar-SA"}},
+		"acme": &MockDetectionProfile{
+			MockConfigProvider: MockConfigProvider{supportedLanguages: []domain.LanguageCode{"english", "ar"}},
+			codes:              map[domain.LanguageCode]domain.LanguageCode{"en-US": "english", "ar-SA": "ar"},
+		},
+	}
+	catalog := newTestCatalog().WithConfigResolver(profiles)
+	acme := domain.WithTenant(context.Background(), "acme")
+
+	languages, err := catalog.ListSupportedLanguages(acme)
+	if err != nil {
+		t.Fatalf("ListSupportedLanguages() error = %v, want nil", err)
+	}
+	if len(languages) != 2 {
+		t.Fatalf("Expected the tenant's 2 languages, got %v", languages)
+	}
+
+	// A code of the profile's own takes the reference data of the code it maps from
+	english := languages[0]
+	if english.LanguageCode != "english" || english.EnglishName != "English" || len(english.Providers) != 2 {
+		t.Errorf("Expected English under the tenant's code with 2 providers, got %+v", english)
+	}
+	if arabic := languages[1]; arabic.LanguageCode != "ar" || len(arabic.Providers) != 1 {
+		t.Errorf("Expected Arabic under the tenant's code with 1 provider, got %+v", arabic)
+	}
+
+	if _, err := catalog.GetLanguageInfo(acme, "en-US"); !errors.Is(err, domain.ErrInvalidLanguageCode) {
+		t.Errorf("Expected ErrInvalidLanguageCode for a code the tenant does not use, got %v", err)
+	}
+	if _, err := catalog.GetLanguageInfo(context.Background(), "en-US"); err != nil {
+		t.Errorf("Expected the default tenant to keep en-US, got %v", err)
+	}
+}
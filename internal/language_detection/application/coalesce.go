@@ -0,0 +1,80 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// flightGroup lets concurrent identical requests share one detection.
+// The zero value is ready to use.
+type flightGroup struct {
+	mu        sync.Mutex
+	flights   map[string]*flight
+	coalesced atomic.Uint64
+}
+
+// flight is a detection in progress; its result is read-only once done is closed
+type flight struct {
+	done     chan struct{}
+	response *domain.LanguageDetectionResponse
+	err      error
+}
+
+// do runs detect unless a detection of the same key is already in flight, in
+// which case it waits for that result. When the detection that was joined
+// gave up because its caller's context ended, a caller whose context is
+// still live runs its own.
+func (g *flightGroup) do(
+	ctx context.Context,
+	key string,
+	detect func() (*domain.LanguageDetectionResponse, error),
+) (*domain.LanguageDetectionResponse, error) {
+	joined := false
+	for {
+		g.mu.Lock()
+		if f, ok := g.flights[key]; ok {
+			g.mu.Unlock()
+			// A caller that joins again after a cancelled detection is counted once
+			if !joined {
+				g.coalesced.Add(1)
+				joined = true
+			}
+
+			select {
+			case <-f.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+
+			if isContextError(f.err) && ctx.Err() == nil {
+				continue
+			}
+			return f.response, f.err
+		}
+
+		if g.flights == nil {
+			g.flights = make(map[string]*flight)
+		}
+		f := &flight{done: make(chan struct{})}
+		g.flights[key] = f
+		g.mu.Unlock()
+
+		f.response, f.err = detect()
+
+		g.mu.Lock()
+		delete(g.flights, key)
+		g.mu.Unlock()
+		close(f.done)
+
+		return f.response, f.err
+	}
+}
+
+// isContextError reports whether err comes from a cancelled or expired context
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
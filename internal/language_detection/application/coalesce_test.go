@@ -0,0 +1,161 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MockBlockingDetector holds every detection until release is closed
+type MockBlockingDetector struct {
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (m *MockBlockingDetector) DetectLanguage(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+	m.calls.Add(1)
+	select {
+	case <-m.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return &domain.LanguageDetectionResponse{
+		LanguageCode: "es-ES",
+		Confidence:   0.9,
+		Metadata:     domain.ProcessingMetadata{Provider: "blocking"},
+	}, nil
+}
+
+// waitFor polls until condition holds or the test times out
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDetectLanguage_CoalescesConcurrentRequests(t *testing.T) {
+	detector := &MockBlockingDetector{release: make(chan struct{})}
+	service := NewLanguageDetectionService(detector, &MockConfigProvider{maxTextLength: 100})
+
+	const callers = 5
+	responses := make([]*domain.LanguageDetectionResponse, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			response, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{
+				Text:       "hola  mundo",
+				DocumentID: string(rune('a' + i)),
+			})
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			responses[i] = response
+		}(i)
+	}
+
+	waitFor(t, func() bool { return service.CoalescedRequests() == callers-1 })
+	close(detector.release)
+	wg.Wait()
+
+	if calls := detector.calls.Load(); calls != 1 {
+		t.Errorf("Expected 1 detector call, got %d", calls)
+	}
+
+	for i, response := range responses {
+		if response == nil {
+			continue
+		}
+		if response.DocumentID != string(rune('a'+i)) || response.LanguageCode != "es-ES" {
+			t.Errorf("Expected es-ES for document %c, got %+v", 'a'+i, response)
+		}
+		for j := 0; j < i; j++ {
+			if responses[j] == response {
+				t.Errorf("Expected callers %d and %d to get their own responses", i, j)
+			}
+		}
+	}
+}
+
+func TestFlightGroup_RetriesAfterCancelledLeader(t *testing.T) {
+	var group flightGroup
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := group.do(leaderCtx, "key", func() (*domain.LanguageDetectionResponse, error) {
+			close(started)
+			<-leaderCtx.Done()
+			return nil, leaderCtx.Err()
+		})
+		leaderErr <- err
+	}()
+	<-started
+
+	followerDone := make(chan *domain.LanguageDetectionResponse, 1)
+	go func() {
+		response, _ := group.do(context.Background(), "key", func() (*domain.LanguageDetectionResponse, error) {
+			return &domain.LanguageDetectionResponse{LanguageCode: "en-US"}, nil
+		})
+		followerDone <- response
+	}()
+
+	waitFor(t, func() bool { return group.coalesced.Load() == 1 })
+	cancel()
+
+	if err := <-leaderErr; err != context.Canceled {
+		t.Errorf("Expected the leader to be cancelled, got %v", err)
+	}
+
+	if response := <-followerDone; response == nil || response.LanguageCode != "en-US" {
+		t.Errorf("Expected the follower to run its own detection, got %+v", response)
+	}
+}
+
+func TestFlightGroup_CountsEachCallerOnce(t *testing.T) {
+	var group flightGroup
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+
+	go group.do(leaderCtx, "key", func() (*domain.LanguageDetectionResponse, error) {
+		close(started)
+		<-leaderCtx.Done()
+		return nil, leaderCtx.Err()
+	})
+	<-started
+
+	// Both followers join the cancelled detection, then one joins the other's retry
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			group.do(context.Background(), "key", func() (*domain.LanguageDetectionResponse, error) {
+				<-release
+				return &domain.LanguageDetectionResponse{LanguageCode: "en-US"}, nil
+			})
+		}()
+	}
+
+	waitFor(t, func() bool { return group.coalesced.Load() == 2 })
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if coalesced := group.coalesced.Load(); coalesced != 2 {
+		t.Errorf("Expected 2 coalesced callers, got %d", coalesced)
+	}
+}
@@ -0,0 +1,245 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+const (
+	// maxDocumentSections bounds the number of sections detected per document
+	maxDocumentSections = 1000
+
+	// maxDocumentCharacters bounds the amount of text detected per document
+	maxDocumentCharacters = 1 << 20
+
+	// documentConcurrency is the number of chunks detected in parallel
+	documentConcurrency = 4
+)
+
+// DocumentDetectionServiceImpl implements the DocumentDetectionService interface.
+// Sections longer than the maximum text length are split into chunks, and
+// chunk results are combined weighted by their length.
+type DocumentDetectionServiceImpl struct {
+	extractor domain.TextExtractor
+	service   domain.LanguageDetectionService
+	config    domain.ConfigProvider
+	profiles  domain.ConfigResolver
+}
+
+// NewDocumentDetectionService creates a new document detection service
+func NewDocumentDetectionService(
+	extractor domain.TextExtractor,
+	service domain.LanguageDetectionService,
+	config domain.ConfigProvider,
+) *DocumentDetectionServiceImpl {
+	return &DocumentDetectionServiceImpl{
+		extractor: extractor,
+		service:   service,
+		config:    config,
+	}
+}
+
+// WithConfigResolver splits sections by the maximum text length of each
+// tenant's profile instead of the service-wide one
+func (s *DocumentDetectionServiceImpl) WithConfigResolver(profiles domain.ConfigResolver) *DocumentDetectionServiceImpl {
+	s.profiles = profiles
+	return s
+}
+
+// documentChunk is a piece of a section small enough for a single detection
+type documentChunk struct {
+	section  int
+	text     domain.Text
+	response *domain.LanguageDetectionResponse
+	err      error
+}
+
+// DetectDocumentLanguage detects the language of each section and of the document as a whole
+func (s *DocumentDetectionServiceImpl) DetectDocumentLanguage(
+	ctx context.Context,
+	request *domain.DocumentDetectionRequest,
+) (*domain.DocumentDetectionResponse, error) {
+	startTime := time.Now()
+
+	if request == nil || request.MimeType == "" {
+		return nil, fmt.Errorf("%w: MIME type is required", domain.ErrInvalidRequest)
+	}
+
+	if len(request.Content) == 0 {
+		return nil, fmt.Errorf("validation failed: %w", domain.ErrEmptyText)
+	}
+
+	sections, err := s.extractor.Extract(ctx, request.Content, request.MimeType)
+	if err != nil {
+		return nil, fmt.Errorf("text extraction failed: %w", err)
+	}
+
+	if err := validateSections(sections); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	config := tenantConfig(ctx, s.profiles, s.config)
+	var chunks []*documentChunk
+	for i, section := range sections {
+		for _, text := range splitText(string(section.Text), config.GetMaxTextLength()) {
+			chunks = append(chunks, &documentChunk{section: i, text: domain.Text(text)})
+		}
+	}
+
+	if err := s.detectChunks(ctx, request, chunks); err != nil {
+		return nil, err
+	}
+
+	response := &domain.DocumentDetectionResponse{
+		DocumentID: request.DocumentID,
+		MimeType:   request.MimeType,
+		Sections:   make([]domain.SectionDetection, len(sections)),
+	}
+
+	overall := domain.NewLanguageEvidence()
+	evidence := make([]*domain.LanguageEvidence, len(sections))
+	lastErrs := make([]error, len(sections))
+	for i, section := range sections {
+		evidence[i] = domain.NewLanguageEvidence()
+		response.Sections[i] = domain.SectionDetection{
+			Index:          section.Index,
+			Name:           section.Name,
+			CharacterCount: int64(utf8.RuneCountInString(string(section.Text))),
+		}
+		response.CharacterCount += response.Sections[i].CharacterCount
+	}
+
+	var lastErr error
+	for _, chunk := range chunks {
+		if chunk.err != nil {
+			lastErrs[chunk.section] = chunk.err
+			lastErr = chunk.err
+			continue
+		}
+
+		length := float64(utf8.RuneCountInString(string(chunk.text)))
+		evidence[chunk.section].Add(chunk.response, length)
+		overall.Add(chunk.response, length)
+		response.Metadata.Provider = chunk.response.Metadata.Provider
+	}
+
+	for i := range response.Sections {
+		section := &response.Sections[i]
+		section.LanguageCode, section.Confidence, section.Alternatives = evidence[i].Result()
+		if section.LanguageCode == domain.UnknownLanguage && lastErrs[i] != nil {
+			section.LanguageCode = ""
+			section.Error = lastErrs[i].Error()
+		}
+	}
+
+	response.LanguageCode, response.Confidence, response.Alternatives = overall.Result()
+	if response.LanguageCode == domain.UnknownLanguage && lastErr != nil {
+		return nil, fmt.Errorf("no section of the document could be detected: %w", lastErr)
+	}
+
+	response.Metadata.ProcessingTimeMs = time.Since(startTime).Milliseconds()
+	response.Metadata.ServiceVersion = config.GetServiceVersion()
+	response.Metadata.ModelVersion = config.GetModelVersion()
+
+	return response, nil
+}
+
+// detectChunks detects every chunk with bounded concurrency. Per-chunk
+// failures are recorded on the chunk; only cancellation aborts the document.
+func (s *DocumentDetectionServiceImpl) detectChunks(
+	ctx context.Context,
+	request *domain.DocumentDetectionRequest,
+	chunks []*documentChunk,
+) error {
+	sem := make(chan struct{}, documentConcurrency)
+	var wg sync.WaitGroup
+
+	for _, chunk := range chunks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(chunk *documentChunk) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			chunk.response, chunk.err = s.service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{
+				Text:       chunk.text,
+				DocumentID: request.DocumentID,
+				Metadata:   request.Metadata,
+			})
+		}(chunk)
+	}
+
+	wg.Wait()
+	return ctx.Err()
+}
+
+// validateSections checks that a document has text within the size limits
+func validateSections(sections []domain.DocumentSection) error {
+	if len(sections) == 0 {
+		return fmt.Errorf("%w: document contains no text", domain.ErrEmptyText)
+	}
+
+	if len(sections) > maxDocumentSections {
+		return fmt.Errorf("%w: document has %d sections, maximum is %d",
+			domain.ErrTextTooLong, len(sections), maxDocumentSections)
+	}
+
+	var characters int
+	for _, section := range sections {
+		characters += utf8.RuneCountInString(string(section.Text))
+	}
+	if characters > maxDocumentCharacters {
+		return fmt.Errorf("%w: document has %d characters, maximum is %d",
+			domain.ErrTextTooLong, characters, maxDocumentCharacters)
+	}
+
+	return nil
+}
+
+// splitText splits text into chunks of at most maxBytes bytes, preferring to
+// break at whitespace and never splitting a UTF-8 sequence
+func splitText(text string, maxBytes int) []string {
+	if maxBytes <= 0 {
+		return []string{text}
+	}
+
+	var chunks []string
+	for len(text) > maxBytes {
+		cut := maxBytes
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+
+		if space := strings.LastIndexFunc(text[:cut], unicode.IsSpace); space > 0 {
+			cut = space
+		}
+		if cut == 0 {
+			// A single rune longer than maxBytes cannot be split further
+			_, size := utf8.DecodeRuneInString(text)
+			cut = size
+		}
+
+		if chunk := strings.TrimSpace(text[:cut]); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		text = strings.TrimLeftFunc(text[cut:], unicode.IsSpace)
+	}
+
+	if text = strings.TrimSpace(text); text != "" {
+		chunks = append(chunks, text)
+	}
+	return chunks
+}
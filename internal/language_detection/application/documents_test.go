@@ -0,0 +1,234 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MockTextExtractor is a mock implementation of TextExtractor
+type MockTextExtractor struct {
+	sections []domain.DocumentSection
+	err      error
+}
+
+func (m *MockTextExtractor) Extract(ctx context.Context, content []byte, mimeType string) ([]domain.DocumentSection, error) {
+	return m.sections, m.err
+}
+
+func (m *MockTextExtractor) SupportedMimeTypes() []string {
+	return []string{domain.MimeTypePlainText}
+}
+
+// keywordDetectionService detects French when the text starts with "le", English otherwise,
+// and fails with low confidence for text containing "???"
+func keywordDetectionService(calls *[]string, mu *sync.Mutex) MockDetectionService {
+	return func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		mu.Lock()
+		*calls = append(*calls, string(request.Text))
+		mu.Unlock()
+
+		text := string(request.Text)
+		switch {
+		case strings.Contains(text, "???"):
+			return nil, domain.ErrLowConfidence
+		case strings.HasPrefix(text, "le "):
+			return &domain.LanguageDetectionResponse{
+				LanguageCode: "fr-FR",
+				Confidence:   0.9,
+				Metadata:     domain.ProcessingMetadata{Provider: "test"},
+			}, nil
+		default:
+			return &domain.LanguageDetectionResponse{
+				LanguageCode: "en-US",
+				Confidence:   0.9,
+				Metadata:     domain.ProcessingMetadata{Provider: "test"},
+			}, nil
+		}
+	}
+}
+
+func TestDocumentDetection_Sections(t *testing.T) {
+	var calls []string
+	var mu sync.Mutex
+
+	extractor := &MockTextExtractor{sections: []domain.DocumentSection{
+		{Index: 0, Name: "One", Text: "the cat sat on the mat and the dog sat too"},
+		{Index: 1, Name: "Deux", Text: "le chat"},
+		{Index: 2, Name: "Broken", Text: "???"},
+	}}
+	config := &MockConfigProvider{maxTextLength: 20, serviceVersion: "1.0.0", modelVersion: "v1"}
+	service := NewDocumentDetectionService(extractor, keywordDetectionService(&calls, &mu), config)
+
+	resp, err := service.DetectDocumentLanguage(context.Background(), &domain.DocumentDetectionRequest{
+		Content:    []byte("ignored"),
+		MimeType:   domain.MimeTypePlainText,
+		DocumentID: "doc-1",
+	})
+	if err != nil {
+		t.Fatalf("DetectDocumentLanguage() error = %v, want nil", err)
+	}
+
+	// The first section is longer than the maximum text length and is split
+	if len(calls) != 5 {
+		t.Errorf("Expected 5 detection calls, got %d: %q", len(calls), calls)
+	}
+	for _, call := range calls {
+		if len(call) > 20 {
+			t.Errorf("Expected chunks of at most 20 bytes, got %q", call)
+		}
+	}
+
+	if resp.LanguageCode != "en-US" {
+		t.Errorf("Expected overall language en-US, got %s", resp.LanguageCode)
+	}
+
+	if len(resp.Alternatives) != 1 || resp.Alternatives[0].LanguageCode != "fr-FR" {
+		t.Errorf("Expected fr-FR alternative, got %v", resp.Alternatives)
+	}
+
+	if resp.DocumentID != "doc-1" || resp.Metadata.ServiceVersion != "1.0.0" || resp.Metadata.Provider != "test" {
+		t.Errorf("Unexpected response metadata: %+v", resp)
+	}
+
+	if len(resp.Sections) != 3 {
+		t.Fatalf("Expected 3 sections, got %d", len(resp.Sections))
+	}
+
+	if resp.Sections[1].Name != "Deux" || resp.Sections[1].LanguageCode != "fr-FR" {
+		t.Errorf("Expected French section 'Deux', got %+v", resp.Sections[1])
+	}
+
+	broken := resp.Sections[2]
+	if broken.Error == "" || broken.LanguageCode != "" {
+		t.Errorf("Expected failed section, got %+v", broken)
+	}
+
+	if resp.CharacterCount != 42+7+3 {
+		t.Errorf("Expected 52 characters, got %d", resp.CharacterCount)
+	}
+}
+
+func TestDocumentDetection_TenantTextLength(t *testing.T) {
+	var calls []string
+	var mu sync.Mutex
+
+	extractor := &MockTextExtractor{sections: []domain.DocumentSection{
+		{Index: 0, Name: "One", Text: "the cat sat on the mat and the dog sat too"},
+	}}
+	profiles := MockConfigResolver{
+		domain.DefaultTenant: &MockConfigProvider{maxTextLength: 100},
+		"acme":               &MockConfigProvider{maxTextLength: 10},
+	}
+	service := NewDocumentDetectionService(extractor, keywordDetectionService(&calls, &mu), &MockConfigProvider{maxTextLength: 100}).
+		WithConfigResolver(profiles)
+
+	_, err := service.DetectDocumentLanguage(domain.WithTenant(context.Background(), "acme"), &domain.DocumentDetectionRequest{
+		Content:  []byte("ignored"),
+		MimeType: domain.MimeTypePlainText,
+	})
+	if err != nil {
+		t.Fatalf("DetectDocumentLanguage() error = %v, want nil", err)
+	}
+
+	if len(calls) < 5 {
+		t.Errorf("Expected the section to be split for the tenant, got %q", calls)
+	}
+	for _, call := range calls {
+		if len(call) > 10 {
+			t.Errorf("Expected chunks of at most 10 bytes, got %q", call)
+		}
+	}
+}
+
+func TestDocumentDetection_Errors(t *testing.T) {
+	var calls []string
+	var mu sync.Mutex
+	detection := keywordDetectionService(&calls, &mu)
+	config := &MockConfigProvider{maxTextLength: 5000}
+
+	tests := []struct {
+		name      string
+		extractor *MockTextExtractor
+		request   *domain.DocumentDetectionRequest
+		expected  error
+	}{
+		{
+			name:      "Missing MIME type",
+			extractor: &MockTextExtractor{},
+			request:   &domain.DocumentDetectionRequest{Content: []byte("x")},
+			expected:  domain.ErrInvalidRequest,
+		},
+		{
+			name:      "Empty content",
+			extractor: &MockTextExtractor{},
+			request:   &domain.DocumentDetectionRequest{MimeType: domain.MimeTypePlainText},
+			expected:  domain.ErrEmptyText,
+		},
+		{
+			name:      "Extraction failure",
+			extractor: &MockTextExtractor{err: domain.ErrUnsupportedFormat},
+			request:   &domain.DocumentDetectionRequest{Content: []byte("x"), MimeType: "image/png"},
+			expected:  domain.ErrUnsupportedFormat,
+		},
+		{
+			name:      "No text",
+			extractor: &MockTextExtractor{},
+			request:   &domain.DocumentDetectionRequest{Content: []byte("x"), MimeType: domain.MimeTypePlainText},
+			expected:  domain.ErrEmptyText,
+		},
+		{
+			name: "Too much text",
+			extractor: &MockTextExtractor{sections: []domain.DocumentSection{
+				{Text: domain.Text(strings.Repeat("a", maxDocumentCharacters+1))},
+			}},
+			request:  &domain.DocumentDetectionRequest{Content: []byte("x"), MimeType: domain.MimeTypePlainText},
+			expected: domain.ErrTextTooLong,
+		},
+		{
+			name: "No detectable section",
+			extractor: &MockTextExtractor{sections: []domain.DocumentSection{
+				{Text: "???"},
+			}},
+			request:  &domain.DocumentDetectionRequest{Content: []byte("x"), MimeType: domain.MimeTypePlainText},
+			expected: domain.ErrLowConfidence,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewDocumentDetectionService(tt.extractor, detection, config)
+			_, err := service.DetectDocumentLanguage(context.Background(), tt.request)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("DetectDocumentLanguage() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSplitText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		maxBytes int
+		expected []string
+	}{
+		{"Short text", "hello world", 20, []string{"hello world"}},
+		{"Break at whitespace", "hello big world", 10, []string{"hello big", "world"}},
+		{"No whitespace", "abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"Multi-byte runes", "ééééé", 3, []string{"é", "é", "é", "é", "é"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := splitText(tt.text, tt.maxBytes)
+			if strings.Join(chunks, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("splitText(%q, %d) = %q, want %q", tt.text, tt.maxBytes, chunks, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+const (
+	// jobDocumentBatch is the number of pending documents loaded from the store at once
+	jobDocumentBatch = 100
+
+	// defaultJobPageSize and maxJobPageSize bound result pages
+	defaultJobPageSize = 100
+	maxJobPageSize     = 1000
+)
+
+// JobManager implements the JobService interface. Jobs are processed one at
+// a time in submission order, with the documents of a job fanned out to a
+// pool of workers.
+type JobManager struct {
+	service      domain.LanguageDetectionService
+	store        domain.JobStore
+	usage        domain.UsageService
+	workers      int
+	maxDocuments int
+
+	mu      sync.Mutex
+	queue   []string
+	pending chan struct{}
+}
+
+// NewJobManager creates a new job manager
+func NewJobManager(
+	service domain.LanguageDetectionService,
+	store domain.JobStore,
+	workers int,
+	maxDocuments int,
+) *JobManager {
+	if workers < 1 {
+		workers = 1
+	}
+	return &JobManager{
+		service:      service,
+		store:        store,
+		workers:      workers,
+		maxDocuments: maxDocuments,
+		pending:      make(chan struct{}, 1),
+	}
+}
+
+// WithUsage rejects jobs that would take their tenant over its quota
+func (m *JobManager) WithUsage(usage domain.UsageService) *JobManager {
+	m.usage = usage
+	return m
+}
+
+// Run resumes unfinished jobs and processes queued jobs until ctx is cancelled.
+// Documents interrupted by cancellation keep no result and are resumed on the next Run.
+func (m *JobManager) Run(ctx context.Context) error {
+	unfinished, err := m.store.ListUnfinishedJobs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load unfinished jobs: %w", err)
+	}
+	for _, job := range unfinished {
+		slog.Info("Resuming detection job", "job_id", job.ID,
+			"processed_documents", job.ProcessedDocuments, "total_documents", job.TotalDocuments)
+		m.enqueue(job.ID)
+	}
+
+	for {
+		id, ok := m.next(ctx)
+		if !ok {
+			return ctx.Err()
+		}
+		if err := m.processJob(ctx, id); err != nil && ctx.Err() == nil {
+			slog.Warn("Detection job interrupted", "job_id", id, "error", err)
+		}
+	}
+}
+
+// SubmitJob creates a job for the given documents and queues it for processing
+func (m *JobManager) SubmitJob(
+	ctx context.Context,
+	documents []domain.LanguageDetectionRequest,
+	metadata map[string]string,
+) (*domain.Job, error) {
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("%w: job contains no documents", domain.ErrInvalidRequest)
+	}
+
+	if m.maxDocuments > 0 && len(documents) > m.maxDocuments {
+		return nil, fmt.Errorf("%w: job has %d documents, maximum is %d",
+			domain.ErrInvalidRequest, len(documents), m.maxDocuments)
+	}
+
+	// The whole job must fit the quota; each document is still checked when detected
+	tenantID := domain.TenantFromContext(ctx)
+	if m.usage != nil {
+		var usage domain.Usage
+		for _, document := range documents {
+			usage = usage.Add(domain.TextUsage(document.Text))
+		}
+		if err := m.usage.CheckQuota(ctx, tenantID, usage); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	job := &domain.Job{
+		ID:             id,
+		TenantID:       tenantID,
+		Status:         domain.JobStatusPending,
+		TotalDocuments: len(documents),
+		Metadata:       metadata,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := m.store.CreateJob(ctx, job, documents); err != nil {
+		return nil, fmt.Errorf("failed to store job: %w", err)
+	}
+
+	m.enqueue(id)
+	return job, nil
+}
+
+// GetJob returns the current state of a job. Jobs of other tenants are
+// not found unless the caller is an admin.
+func (m *JobManager) GetJob(ctx context.Context, id string) (*domain.Job, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w: job ID is required", domain.ErrInvalidRequest)
+	}
+
+	job, err := m.store.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccessTenant(ctx, jobTenant(job)) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrJobNotFound, id)
+	}
+	return job, nil
+}
+
+// jobTenant returns the tenant that submitted a job. Jobs stored before
+// tenants were recorded belong to the default tenant.
+func jobTenant(job *domain.Job) string {
+	if job.TenantID == "" {
+		return domain.DefaultTenant
+	}
+	return job.TenantID
+}
+
+// GetJobResults returns a page of job results
+func (m *JobManager) GetJobResults(
+	ctx context.Context,
+	id string,
+	pageToken string,
+	pageSize int,
+) (*domain.JobResultPage, error) {
+	if _, err := m.GetJob(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultJobPageSize
+	}
+	if pageSize > maxJobPageSize {
+		pageSize = maxJobPageSize
+	}
+
+	return m.store.ListResults(ctx, id, pageToken, pageSize)
+}
+
+// processJob detects every pending document of a job and marks it completed.
+// Documents are detected for the tenant that submitted the job.
+func (m *JobManager) processJob(ctx context.Context, id string) error {
+	job, err := m.store.GetJob(ctx, id)
+	if err != nil {
+		return err
+	}
+	ctx = domain.WithTenant(ctx, job.TenantID)
+
+	if err := m.store.UpdateJobStatus(ctx, id, domain.JobStatusRunning); err != nil {
+		return err
+	}
+
+	from := 0
+	for {
+		documents, err := m.store.PendingDocuments(ctx, id, from, jobDocumentBatch)
+		if err != nil {
+			return err
+		}
+		if len(documents) == 0 {
+			break
+		}
+
+		if err := m.processDocuments(ctx, id, documents); err != nil {
+			return err
+		}
+		from = documents[len(documents)-1].Index + 1
+	}
+
+	return m.store.UpdateJobStatus(ctx, id, domain.JobStatusCompleted)
+}
+
+// processDocuments runs a batch of documents through the worker pool
+func (m *JobManager) processDocuments(ctx context.Context, id string, documents []domain.JobDocument) error {
+	sem := make(chan struct{}, m.workers)
+	errs := make(chan error, len(documents))
+	var wg sync.WaitGroup
+
+	for _, doc := range documents {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(doc domain.JobDocument) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := m.service.DetectLanguage(ctx, &doc.Request)
+			if ctx.Err() != nil {
+				// Leave the document pending so it is retried after a restart
+				return
+			}
+
+			result := domain.JobResult{
+				Index:      doc.Index,
+				DocumentID: doc.Request.DocumentID,
+				Response:   resp,
+			}
+			if err != nil {
+				result.Error = err.Error()
+			}
+
+			if err := m.store.SaveResult(ctx, id, result); err != nil {
+				errs <- err
+			}
+		}(doc)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return <-errs
+}
+
+// enqueue appends a job to the processing queue
+func (m *JobManager) enqueue(id string) {
+	m.mu.Lock()
+	m.queue = append(m.queue, id)
+	m.mu.Unlock()
+
+	select {
+	case m.pending <- struct{}{}:
+	default:
+	}
+}
+
+// next blocks until a job is queued or ctx is cancelled
+func (m *JobManager) next(ctx context.Context) (string, bool) {
+	for {
+		m.mu.Lock()
+		if len(m.queue) > 0 {
+			id := m.queue[0]
+			m.queue = m.queue[1:]
+			m.mu.Unlock()
+			return id, true
+		}
+		m.mu.Unlock()
+
+		select {
+		case <-m.pending:
+		case <-ctx.Done():
+			return "", false
+		}
+	}
+}
+
+// newJobID generates a random job identifier
+func newJobID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate job ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
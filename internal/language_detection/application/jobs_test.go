@@ -0,0 +1,411 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MemoryJobStore is an in-memory implementation of JobStore
+type MemoryJobStore struct {
+	mu        sync.Mutex
+	jobs      map[string]*domain.Job
+	documents map[string][]domain.LanguageDetectionRequest
+	results   map[string]map[int]domain.JobResult
+}
+
+func NewMemoryJobStore() *MemoryJobStore {
+	return &MemoryJobStore{
+		jobs:      make(map[string]*domain.Job),
+		documents: make(map[string][]domain.LanguageDetectionRequest),
+		results:   make(map[string]map[int]domain.JobResult),
+	}
+}
+
+func (m *MemoryJobStore) CreateJob(ctx context.Context, job *domain.Job, documents []domain.LanguageDetectionRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *job
+	m.jobs[job.ID] = &copied
+	m.documents[job.ID] = documents
+	m.results[job.ID] = make(map[int]domain.JobResult)
+	return nil
+}
+
+func (m *MemoryJobStore) GetJob(ctx context.Context, id string) (*domain.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, domain.ErrJobNotFound
+	}
+	copied := *job
+	return &copied, nil
+}
+
+func (m *MemoryJobStore) UpdateJobStatus(ctx context.Context, id string, status domain.JobStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return domain.ErrJobNotFound
+	}
+	job.Status = status
+	return nil
+}
+
+func (m *MemoryJobStore) ListUnfinishedJobs(ctx context.Context) ([]*domain.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var jobs []*domain.Job
+	for _, job := range m.jobs {
+		if job.Status != domain.JobStatusCompleted {
+			copied := *job
+			jobs = append(jobs, &copied)
+		}
+	}
+	return jobs, nil
+}
+
+func (m *MemoryJobStore) PendingDocuments(ctx context.Context, id string, from int, limit int) ([]domain.JobDocument, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var pending []domain.JobDocument
+	for i := from; i < len(m.documents[id]) && len(pending) < limit; i++ {
+		if _, done := m.results[id][i]; !done {
+			pending = append(pending, domain.JobDocument{Index: i, Request: m.documents[id][i]})
+		}
+	}
+	return pending, nil
+}
+
+func (m *MemoryJobStore) SaveResult(ctx context.Context, id string, result domain.JobResult) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, done := m.results[id][result.Index]; done {
+		return nil
+	}
+	m.results[id][result.Index] = result
+	m.jobs[id].ProcessedDocuments++
+	if result.Error != "" {
+		m.jobs[id].FailedDocuments++
+	}
+	return nil
+}
+
+func (m *MemoryJobStore) ListResults(ctx context.Context, id string, pageToken string, pageSize int) (*domain.JobResultPage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	from, _ := strconv.Atoi(pageToken)
+	var indexes []int
+	for index := range m.results[id] {
+		if index >= from {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Ints(indexes)
+	page := &domain.JobResultPage{}
+	for i, index := range indexes {
+		if i == pageSize {
+			page.NextPageToken = strconv.Itoa(index)
+			break
+		}
+		page.Results = append(page.Results, m.results[id][index])
+	}
+	return page, nil
+}
+
+// MockDetectionService is a function-based implementation of LanguageDetectionService
+type MockDetectionService func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error)
+
+func (f MockDetectionService) DetectLanguage(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+	return f(ctx, request)
+}
+
+func englishService() MockDetectionService {
+	return func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		if request.Text == "" {
+			return nil, domain.ErrEmptyText
+		}
+		return &domain.LanguageDetectionResponse{
+			LanguageCode: "en-US",
+			Confidence:   0.9,
+			DocumentID:   request.DocumentID,
+		}, nil
+	}
+}
+
+func makeDocuments(n int) []domain.LanguageDetectionRequest {
+	var documents []domain.LanguageDetectionRequest
+	for i := 0; i < n; i++ {
+		documents = append(documents, domain.LanguageDetectionRequest{
+			Text:       domain.Text(fmt.Sprintf("document number %d", i)),
+			DocumentID: fmt.Sprintf("doc-%d", i),
+		})
+	}
+	return documents
+}
+
+func waitForJob(t *testing.T, manager *JobManager, id string) *domain.Job {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := manager.GetJob(adminContext(), id)
+		if err != nil {
+			t.Fatalf("GetJob() error = %v, want nil", err)
+		}
+		if job.Status == domain.JobStatusCompleted {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Job %s did not complete in time", id)
+	return nil
+}
+
+// adminContext returns a context of an admin, who may read the jobs of every tenant
+func adminContext() context.Context {
+	return domain.WithPrincipal(context.Background(), domain.Principal{ID: "ops", TenantID: "ops", Admin: true})
+}
+
+func TestJobManager_SubmitAndProcess(t *testing.T) {
+	store := NewMemoryJobStore()
+	manager := NewJobManager(englishService(), store, 4, 1000)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go manager.Run(ctx)
+
+	documents := makeDocuments(250)
+	documents[7].Text = ""
+
+	job, err := manager.SubmitJob(ctx, documents, map[string]string{"source": "test"})
+	if err != nil {
+		t.Fatalf("SubmitJob() error = %v, want nil", err)
+	}
+
+	if job.ID == "" {
+		t.Fatal("Expected job ID, got empty string")
+	}
+
+	if job.Status != domain.JobStatusPending {
+		t.Errorf("Expected status pending, got %s", job.Status)
+	}
+
+	done := waitForJob(t, manager, job.ID)
+
+	if done.ProcessedDocuments != 250 {
+		t.Errorf("Expected 250 processed documents, got %d", done.ProcessedDocuments)
+	}
+
+	if done.FailedDocuments != 1 {
+		t.Errorf("Expected 1 failed document, got %d", done.FailedDocuments)
+	}
+
+	page, err := manager.GetJobResults(ctx, job.ID, "", 10)
+	if err != nil {
+		t.Fatalf("GetJobResults() error = %v, want nil", err)
+	}
+
+	if len(page.Results) != 10 {
+		t.Fatalf("Expected 10 results, got %d", len(page.Results))
+	}
+
+	if page.NextPageToken == "" {
+		t.Error("Expected next page token, got empty string")
+	}
+
+	failed := page.Results[7]
+	if failed.Error == "" || failed.Response != nil {
+		t.Errorf("Expected document 7 to have failed, got %+v", failed)
+	}
+
+	ok := page.Results[0]
+	if ok.Response == nil || ok.Response.LanguageCode != "en-US" || ok.DocumentID != "doc-0" {
+		t.Errorf("Expected English result for doc-0, got %+v", ok)
+	}
+}
+
+func TestJobManager_SubmitJob_Validation(t *testing.T) {
+	manager := NewJobManager(englishService(), NewMemoryJobStore(), 1, 10)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		documents []domain.LanguageDetectionRequest
+	}{
+		{"No documents", nil},
+		{"Too many documents", makeDocuments(11)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := manager.SubmitJob(ctx, tt.documents, nil)
+			if !errors.Is(err, domain.ErrInvalidRequest) {
+				t.Errorf("Expected ErrInvalidRequest, got %v", err)
+			}
+		})
+	}
+}
+
+func TestJobManager_GetJob_Errors(t *testing.T) {
+	manager := NewJobManager(englishService(), NewMemoryJobStore(), 1, 10)
+	ctx := context.Background()
+
+	if _, err := manager.GetJob(ctx, ""); !errors.Is(err, domain.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for empty ID, got %v", err)
+	}
+
+	if _, err := manager.GetJob(ctx, "missing"); !errors.Is(err, domain.ErrJobNotFound) {
+		t.Errorf("Expected ErrJobNotFound, got %v", err)
+	}
+
+	if _, err := manager.GetJobResults(ctx, "", "", 10); !errors.Is(err, domain.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for empty ID, got %v", err)
+	}
+}
+
+func TestJobManager_ResumesAfterRestart(t *testing.T) {
+	store := NewMemoryJobStore()
+
+	// The first manager is stopped while the job is half done
+	var calls int
+	var mu sync.Mutex
+	stop := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupted := MockDetectionService(func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		mu.Lock()
+		calls++
+		n := calls
+		mu.Unlock()
+		if n > 5 {
+			if n == 6 {
+				close(stop)
+			}
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return englishService()(ctx, request)
+	})
+
+	first := NewJobManager(interrupted, store, 1, 100)
+	job, err := first.SubmitJob(context.Background(), makeDocuments(20), nil)
+	if err != nil {
+		t.Fatalf("SubmitJob() error = %v, want nil", err)
+	}
+
+	runDone := make(chan struct{})
+	go func() {
+		first.Run(ctx)
+		close(runDone)
+	}()
+	<-stop
+	cancel()
+	<-runDone
+
+	partial, _ := store.GetJob(context.Background(), job.ID)
+	if partial.ProcessedDocuments != 5 {
+		t.Fatalf("Expected 5 processed documents before restart, got %d", partial.ProcessedDocuments)
+	}
+
+	// A new manager picks the job up from the store
+	second := NewJobManager(englishService(), store, 2, 100)
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	go second.Run(ctx2)
+
+	done := waitForJob(t, second, job.ID)
+	if done.ProcessedDocuments != 20 {
+		t.Errorf("Expected 20 processed documents after resume, got %d", done.ProcessedDocuments)
+	}
+
+	if done.FailedDocuments != 0 {
+		t.Errorf("Expected no failed documents, got %d", done.FailedDocuments)
+	}
+}
+
+func TestJobManager_DetectsForSubmittingTenant(t *testing.T) {
+	var mu sync.Mutex
+	tenants := make(map[string]int)
+	service := MockDetectionService(func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		mu.Lock()
+		tenants[domain.TenantFromContext(ctx)]++
+		mu.Unlock()
+		return englishService()(ctx, request)
+	})
+	manager := NewJobManager(service, NewMemoryJobStore(), 2, 100)
+
+	job, err := manager.SubmitJob(domain.WithTenant(context.Background(), "acme"), makeDocuments(10), nil)
+	if err != nil {
+		t.Fatalf("SubmitJob() error = %v, want nil", err)
+	}
+	if job.TenantID != "acme" {
+		t.Errorf("Expected tenant acme on the job, got %q", job.TenantID)
+	}
+
+	// The manager runs outside of any request
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go manager.Run(ctx)
+	waitForJob(t, manager, job.ID)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if tenants["acme"] != 10 || len(tenants) != 1 {
+		t.Errorf("Expected every document to be detected for acme, got %v", tenants)
+	}
+}
+
+func TestJobManager_OtherTenants(t *testing.T) {
+	manager := NewJobManager(englishService(), NewMemoryJobStore(), 1, 10)
+	acme := domain.WithTenant(context.Background(), "acme")
+
+	job, err := manager.SubmitJob(acme, makeDocuments(2), nil)
+	if err != nil {
+		t.Fatalf("SubmitJob() error = %v, want nil", err)
+	}
+
+	if _, err := manager.GetJob(acme, job.ID); err != nil {
+		t.Errorf("Expected the submitting tenant to read its job, got %v", err)
+	}
+
+	// Another tenant cannot tell the job exists
+	other := domain.WithTenant(context.Background(), "globex")
+	if _, err := manager.GetJob(other, job.ID); !errors.Is(err, domain.ErrJobNotFound) {
+		t.Errorf("Expected ErrJobNotFound for another tenant, got %v", err)
+	}
+
+	if _, err := manager.GetJobResults(other, job.ID, "", 10); !errors.Is(err, domain.ErrJobNotFound) {
+		t.Errorf("Expected ErrJobNotFound for another tenant's results, got %v", err)
+	}
+
+	if _, err := manager.GetJobResults(adminContext(), job.ID, "", 10); err != nil {
+		t.Errorf("Expected an admin to read the results, got %v", err)
+	}
+}
+
+func TestJobManager_SubmitJob_QuotaExceeded(t *testing.T) {
+	usage, _ := newTestUsageService(map[string]domain.Quota{"acme": {DailyDocuments: 5}})
+	store := NewMemoryJobStore()
+	manager := NewJobManager(englishService(), store, 1, 100).WithUsage(usage)
+
+	_, err := manager.SubmitJob(domain.WithTenant(context.Background(), "acme"), makeDocuments(6), nil)
+	if !errors.Is(err, domain.ErrQuotaExceeded) {
+		t.Errorf("Expected ErrQuotaExceeded, got %v", err)
+	}
+	if len(store.jobs) != 0 {
+		t.Errorf("Expected no job to be stored, got %d", len(store.jobs))
+	}
+
+	if _, err := manager.SubmitJob(domain.WithTenant(context.Background(), "globex"), makeDocuments(6), nil); err != nil {
+		t.Errorf("Expected other tenants to be unaffected, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// maxBytesPerCharacter bounds raw content relative to the maximum text
+// length, so no legacy encoding can be rejected only for its byte width
+const maxBytesPerCharacter = 4
+
+// RawTextDetectionServiceImpl implements the RawTextDetectionService interface.
+// When the charset is not declared, the most plausible decoding is chosen on
+// the decoded text alone, and only that one is detected, so a request costs a
+// single detection.
+type RawTextDetectionServiceImpl struct {
+	decoder  domain.CharsetDecoder
+	service  domain.LanguageDetectionService
+	config   domain.ConfigProvider
+	profiles domain.ConfigResolver
+}
+
+// NewRawTextDetectionService creates a new raw text detection service
+func NewRawTextDetectionService(
+	decoder domain.CharsetDecoder,
+	service domain.LanguageDetectionService,
+	config domain.ConfigProvider,
+) *RawTextDetectionServiceImpl {
+	return &RawTextDetectionServiceImpl{
+		decoder: decoder,
+		service: service,
+		config:  config,
+	}
+}
+
+// WithConfigResolver bounds the content by the maximum text length of each
+// tenant's profile instead of the service-wide one
+func (s *RawTextDetectionServiceImpl) WithConfigResolver(profiles domain.ConfigResolver) *RawTextDetectionServiceImpl {
+	s.profiles = profiles
+	return s
+}
+
+// DetectRawLanguage decodes raw text to UTF-8 and detects its language
+func (s *RawTextDetectionServiceImpl) DetectRawLanguage(
+	ctx context.Context,
+	request *domain.RawTextDetectionRequest,
+) (*domain.RawTextDetectionResponse, error) {
+	startTime := time.Now()
+
+	if request == nil {
+		return nil, domain.ErrInvalidRequest
+	}
+
+	if len(request.Content) == 0 {
+		return nil, fmt.Errorf("validation failed: %w", domain.ErrEmptyText)
+	}
+
+	config := tenantConfig(ctx, s.profiles, s.config)
+	if maxBytes := config.GetMaxTextLength() * maxBytesPerCharacter; len(request.Content) > maxBytes {
+		return nil, fmt.Errorf("validation failed: %w: content is %d bytes, maximum is %d",
+			domain.ErrTextTooLong, len(request.Content), maxBytes)
+	}
+
+	candidates := s.candidates(request)
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w: no charset can decode the content", domain.ErrUnknownCharset)
+	}
+
+	// Candidates come most plausible first; the first that decodes is detected
+	var lastErr error
+	for _, candidate := range candidates {
+		text, err := s.decoder.Decode(request.Content, candidate.Charset)
+		if err != nil {
+			lastErr = fmt.Errorf("decoding %s failed: %w", candidate.Charset, err)
+			continue
+		}
+
+		response, err := s.service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{
+			Text:       text,
+			DocumentID: request.DocumentID,
+			Metadata:   request.Metadata,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		response.Metadata.ProcessingTimeMs = time.Since(startTime).Milliseconds()
+		return &domain.RawTextDetectionResponse{
+			LanguageDetectionResponse: *response,
+			Charset:                   candidate.Charset,
+			CharsetConfidence:         candidate.Confidence,
+			CharsetMatchesLanguage:    candidate.Suits(response.LanguageCode),
+		}, nil
+	}
+	return nil, lastErr
+}
+
+// candidates returns the charsets to try: the declared one, or the detected
+// ones, whose confidence scores how plausible their decoded text is
+func (s *RawTextDetectionServiceImpl) candidates(request *domain.RawTextDetectionRequest) []domain.CharsetCandidate {
+	if request.Charset != "" {
+		charset := strings.ToLower(strings.TrimSpace(request.Charset))
+		for _, candidate := range s.decoder.DetectCharsets(request.Content) {
+			if candidate.Charset == charset {
+				candidate.Confidence = 1
+				return []domain.CharsetCandidate{candidate}
+			}
+		}
+		return []domain.CharsetCandidate{{Charset: charset, Confidence: 1}}
+	}
+	return s.decoder.DetectCharsets(request.Content)
+}
@@ -0,0 +1,195 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MockCharsetDecoder is a mock implementation of CharsetDecoder that decodes
+// content to a fixed text per charset
+type MockCharsetDecoder struct {
+	candidates []domain.CharsetCandidate
+	decoded    map[string]string
+}
+
+func (m *MockCharsetDecoder) DetectCharsets(content []byte) []domain.CharsetCandidate {
+	return m.candidates
+}
+
+func (m *MockCharsetDecoder) Decode(content []byte, charset string) (domain.Text, error) {
+	text, ok := m.decoded[charset]
+	if !ok {
+		return "", domain.ErrUnknownCharset
+	}
+	return domain.Text(text), nil
+}
+
+// textDetectionService detects a fixed language per text and fails for unknown text
+func textDetectionService(languages map[string]domain.LanguageDetectionResponse) MockDetectionService {
+	return func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		response, ok := languages[string(request.Text)]
+		if !ok {
+			return nil, domain.ErrLowConfidence
+		}
+		response.DocumentID = request.DocumentID
+		return &response, nil
+	}
+}
+
+func TestRawTextDetection_DetectsMostPlausibleCharset(t *testing.T) {
+	decoder := &MockCharsetDecoder{
+		candidates: []domain.CharsetCandidate{
+			{Charset: "iso-8859-5", Confidence: 0.74, Languages: []string{"ru"}},
+			{Charset: "windows-1251", Confidence: 0.72, Languages: []string{"ru"}},
+			{Charset: "windows-1255", Confidence: 0.70, Languages: []string{"he"}},
+		},
+		decoded: map[string]string{
+			"windows-1251": "привет мир",
+			"windows-1255": "hebrew-looking",
+		},
+	}
+	var calls int
+	service := MockDetectionService(func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		calls++
+		return textDetectionService(map[string]domain.LanguageDetectionResponse{
+			"привет мир":     {LanguageCode: "ru-RU", Confidence: 0.9},
+			"hebrew-looking": {LanguageCode: "he-IL", Confidence: 0.9},
+		})(ctx, request)
+	})
+
+	raw := NewRawTextDetectionService(decoder, service, &MockConfigProvider{maxTextLength: 5000})
+	resp, err := raw.DetectRawLanguage(context.Background(), &domain.RawTextDetectionRequest{
+		Content:    []byte{0xef, 0xf0, 0xe8},
+		DocumentID: "doc-1",
+	})
+	if err != nil {
+		t.Fatalf("DetectRawLanguage() error = %v, want nil", err)
+	}
+
+	// The most plausible charset fails to decode, so the next one is detected, and only it
+	if calls != 1 {
+		t.Errorf("Expected a single detection, got %d", calls)
+	}
+
+	if resp.Charset != "windows-1251" {
+		t.Errorf("Expected charset windows-1251, got %s", resp.Charset)
+	}
+
+	if resp.LanguageCode != "ru-RU" {
+		t.Errorf("Expected language ru-RU, got %s", resp.LanguageCode)
+	}
+
+	if !resp.CharsetMatchesLanguage {
+		t.Error("Expected the charset to match the language")
+	}
+
+	if resp.CharsetConfidence != 0.72 {
+		t.Errorf("Expected charset confidence 0.72, got %v", resp.CharsetConfidence)
+	}
+
+	if resp.DocumentID != "doc-1" {
+		t.Errorf("Expected document ID 'doc-1', got %s", resp.DocumentID)
+	}
+}
+
+func TestRawTextDetection_TenantTextLength(t *testing.T) {
+	decoder := &MockCharsetDecoder{
+		candidates: []domain.CharsetCandidate{{Charset: "windows-1252", Confidence: 0.5}},
+		decoded:    map[string]string{"windows-1252": "latin-looking"},
+	}
+	service := textDetectionService(map[string]domain.LanguageDetectionResponse{
+		"latin-looking": {LanguageCode: "en-US", Confidence: 0.9},
+	})
+	profiles := MockConfigResolver{
+		domain.DefaultTenant: &MockConfigProvider{maxTextLength: 5000},
+		"acme":               &MockConfigProvider{maxTextLength: 2},
+	}
+	raw := NewRawTextDetectionService(decoder, service, &MockConfigProvider{maxTextLength: 5000}).
+		WithConfigResolver(profiles)
+	request := &domain.RawTextDetectionRequest{Content: make([]byte, 9)}
+
+	if _, err := raw.DetectRawLanguage(context.Background(), request); err != nil {
+		t.Errorf("Expected the default limit to allow 9 bytes, got %v", err)
+	}
+
+	_, err := raw.DetectRawLanguage(domain.WithTenant(context.Background(), "acme"), request)
+	if !errors.Is(err, domain.ErrTextTooLong) {
+		t.Errorf("Expected ErrTextTooLong for the tenant's limit, got %v", err)
+	}
+}
+
+func TestRawTextDetection_DeclaredCharset(t *testing.T) {
+	decoder := &MockCharsetDecoder{
+		candidates: []domain.CharsetCandidate{
+			{Charset: "windows-1252", Confidence: 0.8, Languages: []string{"en"}},
+		},
+		decoded: map[string]string{
+			"windows-1252": "latin-looking",
+			"koi8-r":       "привет мир",
+		},
+	}
+	service := textDetectionService(map[string]domain.LanguageDetectionResponse{
+		"latin-looking": {LanguageCode: "en-US", Confidence: 0.9},
+		"привет мир":    {LanguageCode: "ru-RU", Confidence: 0.9},
+	})
+
+	raw := NewRawTextDetectionService(decoder, service, &MockConfigProvider{maxTextLength: 5000})
+	resp, err := raw.DetectRawLanguage(context.Background(), &domain.RawTextDetectionRequest{
+		Content: []byte{0xd0, 0xd2},
+		Charset: "KOI8-R",
+	})
+	if err != nil {
+		t.Fatalf("DetectRawLanguage() error = %v, want nil", err)
+	}
+
+	if resp.Charset != "koi8-r" || resp.CharsetConfidence != 1 {
+		t.Errorf("Expected declared charset koi8-r with confidence 1, got %s (%v)", resp.Charset, resp.CharsetConfidence)
+	}
+
+	if resp.LanguageCode != "ru-RU" {
+		t.Errorf("Expected language ru-RU, got %s", resp.LanguageCode)
+	}
+}
+
+func TestRawTextDetection_Errors(t *testing.T) {
+	decoder := &MockCharsetDecoder{
+		candidates: []domain.CharsetCandidate{{Charset: "windows-1252", Confidence: 0.5}},
+		decoded:    map[string]string{"windows-1252": "gibberish"},
+	}
+	service := textDetectionService(nil)
+	raw := NewRawTextDetectionService(decoder, service, &MockConfigProvider{maxTextLength: 2})
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		request  *domain.RawTextDetectionRequest
+		expected error
+	}{
+		{"Nil request", nil, domain.ErrInvalidRequest},
+		{"Empty content", &domain.RawTextDetectionRequest{}, domain.ErrEmptyText},
+		{"Content too long", &domain.RawTextDetectionRequest{Content: make([]byte, 9)}, domain.ErrTextTooLong},
+		{"Unknown declared charset", &domain.RawTextDetectionRequest{Content: []byte("ab"), Charset: "x-unknown"}, domain.ErrUnknownCharset},
+		{"Undetectable language", &domain.RawTextDetectionRequest{Content: []byte("ab")}, domain.ErrLowConfidence},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := raw.DetectRawLanguage(ctx, tt.request)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("Expected error %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestRawTextDetection_NoCharset(t *testing.T) {
+	raw := NewRawTextDetectionService(&MockCharsetDecoder{}, textDetectionService(nil), &MockConfigProvider{maxTextLength: 5000})
+
+	_, err := raw.DetectRawLanguage(context.Background(), &domain.RawTextDetectionRequest{Content: []byte{0x81}})
+	if !errors.Is(err, domain.ErrUnknownCharset) {
+		t.Errorf("Expected error %v, got %v", domain.ErrUnknownCharset, err)
+	}
+}
@@ -2,63 +2,436 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/trace"
 
 	"language-detection-service/internal/language_detection/domain"
 )
 
-// LanguageDetectionServiceImpl implements the LanguageDetectionService interface
+// LanguageDetectionServiceImpl implements the LanguageDetectionService interface.
+// Requests go to the default detector unless they prefer another provider; a
+// detector that is unavailable fails over to the next one in order.
 type LanguageDetectionServiceImpl struct {
-	detector domain.LanguageDetector
-	config   domain.ConfigProvider
+	detector  domain.LanguageDetector
+	providers []domain.LanguageDetector
+	failover  string
+	config    domain.ConfigProvider
+	profiles  domain.ConfigResolver
+	cache     domain.ResultCache
+	usage     domain.UsageService
+	audit     domain.AuditSink
+	metrics   domain.Metrics
+	tracing   trace.TracerProvider
+	flights   flightGroup
 }
 
-// NewLanguageDetectionService creates a new language detection service
+// NewLanguageDetectionService creates a new language detection service. The
+// other providers are only used when a request or profile chooses them by name.
 func NewLanguageDetectionService(
 	detector domain.LanguageDetector,
 	config domain.ConfigProvider,
+	providers ...domain.LanguageDetector,
 ) *LanguageDetectionServiceImpl {
 	return &LanguageDetectionServiceImpl{
-		detector: detector,
-		config:   config,
+		detector:  detector,
+		providers: providers,
+		config:    config,
+		metrics:   domain.NopMetrics{},
 	}
 }
 
+// WithAudit records every successful detection in the given audit sink
+func (s *LanguageDetectionServiceImpl) WithAudit(audit domain.AuditSink) *LanguageDetectionServiceImpl {
+	s.audit = audit
+	return s
+}
+
+// WithCache serves repeated texts from the given result cache
+func (s *LanguageDetectionServiceImpl) WithCache(cache domain.ResultCache) *LanguageDetectionServiceImpl {
+	s.cache = cache
+	return s
+}
+
+// WithConfigResolver configures every request with the profile of its tenant
+// instead of the service-wide configuration
+func (s *LanguageDetectionServiceImpl) WithConfigResolver(profiles domain.ConfigResolver) *LanguageDetectionServiceImpl {
+	s.profiles = profiles
+	return s
+}
+
+// WithFailover retries detections with the named provider while the chosen
+// one is unavailable
+func (s *LanguageDetectionServiceImpl) WithFailover(provider string) *LanguageDetectionServiceImpl {
+	s.failover = provider
+	return s
+}
+
+// WithMetrics records detections and failovers in the given metrics
+func (s *LanguageDetectionServiceImpl) WithMetrics(metrics domain.Metrics) *LanguageDetectionServiceImpl {
+	s.metrics = metrics
+	return s
+}
+
+// WithTracerProvider creates the detection spans with the given provider
+// instead of the global one
+func (s *LanguageDetectionServiceImpl) WithTracerProvider(provider trace.TracerProvider) *LanguageDetectionServiceImpl {
+	s.tracing = provider
+	return s
+}
+
+// WithUsage enforces tenant quotas and records the usage of every detection
+func (s *LanguageDetectionServiceImpl) WithUsage(usage domain.UsageService) *LanguageDetectionServiceImpl {
+	s.usage = usage
+	return s
+}
+
 // DetectLanguage performs language detection with business logic
 func (s *LanguageDetectionServiceImpl) DetectLanguage(
 	ctx context.Context,
 	request *domain.LanguageDetectionRequest,
+) (*domain.LanguageDetectionResponse, error) {
+	ctx, span := tracerFrom(s.tracing).Start(ctx, "LanguageDetectionServiceImpl.DetectLanguage",
+		trace.WithAttributes(attrTenant.String(domain.TenantFromContext(ctx))))
+	if request != nil {
+		span.SetAttributes(attrTextLength.Int(utf8.RuneCountInString(string(request.Text))))
+	}
+
+	response, err := s.detectLanguage(ctx, request)
+	if response != nil {
+		span.SetAttributes(detectionAttributes(response)...)
+	}
+	endSpan(span, err)
+	return response, err
+}
+
+// detectLanguage validates, detects and post-processes a single request
+func (s *LanguageDetectionServiceImpl) detectLanguage(
+	ctx context.Context,
+	request *domain.LanguageDetectionRequest,
 ) (*domain.LanguageDetectionResponse, error) {
 	startTime := time.Now()
+	config := s.configFor(ctx)
 
 	// Validate input
-	if err := s.validateRequest(request); err != nil {
+	if err := s.validateRequest(request, config); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	trace.SpanFromContext(ctx).AddEvent("request validated")
+
+	var options domain.DetectionOptions
+	if request.Options != nil {
+		options = *request.Options
+	}
+
+	profile, hasProfile := config.(domain.DetectionProfile)
+	provider := options.Provider
+	if provider == "" && hasProfile {
+		provider = profile.GetPreferredProvider()
+	}
+
+	if options.TimeoutMs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(options.TimeoutMs)*time.Millisecond)
+		defer cancel()
+	}
+
+	tenantID := domain.TenantFromContext(ctx)
+	usage := domain.TextUsage(request.Text)
+	if s.usage != nil {
+		if err := s.usage.CheckQuota(ctx, tenantID, usage); err != nil {
+			return nil, fmt.Errorf("quota check failed: %w", err)
+		}
+	}
 
 	// Perform language detection
-	response, err := s.detector.DetectLanguage(ctx, request.Text)
+	response, err := s.detectCached(ctx, request, provider)
 	if err != nil {
 		return nil, fmt.Errorf("language detection failed: %w", err)
 	}
+	s.metrics.DetectionCompleted(response.Metadata.Provider, response.LanguageCode, response.Confidence,
+		utf8.RuneCountInString(string(request.Text)))
+
+	if s.usage != nil {
+		if err := s.usage.RecordUsage(ctx, tenantID, usage); err != nil {
+			slog.WarnContext(ctx, "Failed to record usage", "tenant", tenantID, "error", err)
+		}
+	}
+
+	if hasProfile {
+		mapLanguageCodes(response, profile)
+	}
 
 	// Validate response
-	if err := s.validateResponse(response); err != nil {
+	if err := s.validateResponse(response, minConfidence(options, config), config); err != nil {
 		return nil, fmt.Errorf("response validation failed: %w", err)
 	}
 
+	if request.Options != nil {
+		response.Alternatives = options.FilterAlternatives(response.Alternatives)
+	}
+
 	// Update metadata
 	response.DocumentID = request.DocumentID
 	response.Metadata.ProcessingTimeMs = time.Since(startTime).Milliseconds()
-	response.Metadata.ServiceVersion = s.config.GetServiceVersion()
-	response.Metadata.ModelVersion = s.config.GetModelVersion()
+	response.Metadata.ServiceVersion = config.GetServiceVersion()
+	response.Metadata.ModelVersion = config.GetModelVersion()
+
+	if s.audit != nil {
+		s.audit.RecordDetection(ctx, auditRecord(ctx, request, response))
+	}
 
 	return response, nil
 }
 
+// auditRecord describes a finished detection for the audit sink
+func auditRecord(
+	ctx context.Context,
+	request *domain.LanguageDetectionRequest,
+	response *domain.LanguageDetectionResponse,
+) domain.AuditRecord {
+	return domain.AuditRecord{
+		Time:             time.Now().UTC(),
+		RequestID:        domain.RequestIDFromContext(ctx),
+		DocumentID:       request.DocumentID,
+		TenantID:         domain.TenantFromContext(ctx),
+		TextHash:         domain.AuditTextHash(request.Text),
+		TextLength:       utf8.RuneCountInString(string(request.Text)),
+		LanguageCode:     response.LanguageCode,
+		Confidence:       response.Confidence,
+		Provider:         response.Metadata.Provider,
+		ModelVersion:     response.Metadata.ModelVersion,
+		ServiceVersion:   response.Metadata.ServiceVersion,
+		ProcessingTimeMs: response.Metadata.ProcessingTimeMs,
+		Cached:           response.Metadata.Cached,
+		FailoverFrom:     response.Metadata.Details["failover_from"],
+	}
+}
+
+// configFor returns the configuration of the request's tenant
+func (s *LanguageDetectionServiceImpl) configFor(ctx context.Context) domain.ConfigProvider {
+	return tenantConfig(ctx, s.profiles, s.config)
+}
+
+// tenantConfig returns the profile of the request's tenant, or config if there are no profiles
+func tenantConfig(ctx context.Context, profiles domain.ConfigResolver, config domain.ConfigProvider) domain.ConfigProvider {
+	if profiles == nil {
+		return config
+	}
+	return profiles.ConfigFor(domain.TenantFromContext(ctx))
+}
+
+// mapLanguageCodes renames the detected languages to the codes of a profile
+func mapLanguageCodes(response *domain.LanguageDetectionResponse, profile domain.DetectionProfile) {
+	if response == nil {
+		return
+	}
+	response.LanguageCode = profile.MapLanguageCode(response.LanguageCode)
+	for i := range response.Alternatives {
+		response.Alternatives[i].LanguageCode = profile.MapLanguageCode(response.Alternatives[i].LanguageCode)
+	}
+	if response.Explanation != nil {
+		for i := range response.Explanation.Candidates {
+			response.Explanation.Candidates[i].LanguageCode = profile.MapLanguageCode(response.Explanation.Candidates[i].LanguageCode)
+		}
+	}
+}
+
+// detectCached answers repeated texts from the cache and lets concurrent
+// identical requests share one detection. Results that needed a failover are
+// not cached, so the preferred provider is retried next time. Every caller
+// gets its own copy of a shared result.
+func (s *LanguageDetectionServiceImpl) detectCached(
+	ctx context.Context,
+	request *domain.LanguageDetectionRequest,
+	provider string,
+) (*domain.LanguageDetectionResponse, error) {
+	key := domain.CacheKey(request.Text, provider, request.Explain)
+	if s.cache != nil {
+		if cached, ok := s.cache.Get(ctx, key); ok {
+			trace.SpanFromContext(ctx).AddEvent("cache hit")
+			response := cloneResponse(cached)
+			response.Metadata.Cached = true
+			return response, nil
+		}
+	}
+
+	response, err := s.flights.do(ctx, key, func() (*domain.LanguageDetectionResponse, error) {
+		response, err := s.detect(ctx, request, provider)
+		if err != nil || response == nil {
+			return response, err
+		}
+
+		if _, failedOver := response.Metadata.Details["failover_from"]; !failedOver && s.cache != nil {
+			s.cache.Set(ctx, key, cloneResponse(response))
+		}
+		return response, nil
+	})
+	if err != nil || response == nil {
+		return response, err
+	}
+	return cloneResponse(response), nil
+}
+
+// CoalescedRequests returns how many requests have shared another request's detection
+func (s *LanguageDetectionServiceImpl) CoalescedRequests() uint64 {
+	return s.flights.coalesced.Load()
+}
+
+// cloneResponse copies a response deeply enough that updating its metadata,
+// alternatives or explanation leaves the original untouched
+func cloneResponse(response *domain.LanguageDetectionResponse) *domain.LanguageDetectionResponse {
+	clone := *response
+	clone.Alternatives = append([]domain.LanguageAlternative(nil), response.Alternatives...)
+	if response.Metadata.Details != nil {
+		clone.Metadata.Details = make(map[string]string, len(response.Metadata.Details))
+		for k, v := range response.Metadata.Details {
+			clone.Metadata.Details[k] = v
+		}
+	}
+	if response.Explanation != nil {
+		clone.Explanation = cloneExplanation(response.Explanation)
+	}
+	return &clone
+}
+
+// cloneExplanation copies an explanation with its candidates and their features
+func cloneExplanation(explanation *domain.Explanation) *domain.Explanation {
+	clone := *explanation
+	clone.Preprocessing = append([]string(nil), explanation.Preprocessing...)
+	clone.Candidates = make([]domain.CandidateEvidence, len(explanation.Candidates))
+	for i, candidate := range explanation.Candidates {
+		candidate.Features = append([]domain.FeatureContribution(nil), candidate.Features...)
+		clone.Candidates[i] = candidate
+	}
+	return &clone
+}
+
+// detect runs the preferred detector, failing over to the others in order
+// while they report that their provider is unavailable
+func (s *LanguageDetectionServiceImpl) detect(
+	ctx context.Context,
+	request *domain.LanguageDetectionRequest,
+	provider string,
+) (*domain.LanguageDetectionResponse, error) {
+	var unavailable []string
+	var lastErr error
+	detectors := s.detectorsFor(provider)
+	for i, detector := range detectors {
+		if i > 0 {
+			s.metrics.FailedOver(unavailable[i-1], providerName(detector))
+			trace.SpanFromContext(ctx).AddEvent("failover", trace.WithAttributes(
+				attrFailoverFrom.String(unavailable[i-1]),
+				attrFailoverTo.String(providerName(detector)),
+			))
+		}
+
+		response, err := s.detectWith(ctx, detector, request)
+		if err == nil {
+			if len(unavailable) > 0 && response != nil {
+				if response.Metadata.Details == nil {
+					response.Metadata.Details = make(map[string]string)
+				}
+				response.Metadata.Details["failover_from"] = strings.Join(unavailable, ",")
+			}
+			return response, nil
+		}
+
+		if !errors.Is(err, domain.ErrProviderUnavailable) || ctx.Err() != nil {
+			return nil, err
+		}
+		unavailable = append(unavailable, providerName(detector))
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// detectorsFor returns the detector of the preferred provider, or the default
+// one, followed by the failover provider's if it is another one
+func (s *LanguageDetectionServiceImpl) detectorsFor(provider string) []domain.LanguageDetector {
+	detector := s.detector
+	if preferred := s.detectorNamed(provider); preferred != nil {
+		detector = preferred
+	}
+
+	detectors := []domain.LanguageDetector{detector}
+	if failover := s.detectorNamed(s.failover); failover != nil && s.failover != providerName(detector) {
+		detectors = append(detectors, failover)
+	}
+	return detectors
+}
+
+// detectorNamed returns the detector of the named provider, or nil if there is none
+func (s *LanguageDetectionServiceImpl) detectorNamed(provider string) domain.LanguageDetector {
+	if provider == "" {
+		return nil
+	}
+	for _, detector := range append([]domain.LanguageDetector{s.detector}, s.providers...) {
+		if providerName(detector) == provider {
+			return detector
+		}
+	}
+	return nil
+}
+
+// providerName returns the provider name of a detector, or "" if it does not report one
+func providerName(detector domain.LanguageDetector) string {
+	if provider, ok := detector.(domain.LanguageProvider); ok {
+		return provider.ProviderName()
+	}
+	return ""
+}
+
+// minConfidence returns the confidence threshold of a request
+func minConfidence(options domain.DetectionOptions, config domain.ConfigProvider) float32 {
+	if options.MinConfidence > 0 {
+		return float32(options.MinConfidence)
+	}
+	return config.GetMinConfidenceThreshold()
+}
+
+// detectWith runs a detector, asking it for an explanation when the request wants one.
+// Detectors that cannot explain themselves get an explanation built from their scores.
+func (s *LanguageDetectionServiceImpl) detectWith(
+	ctx context.Context,
+	detector domain.LanguageDetector,
+	request *domain.LanguageDetectionRequest,
+) (*domain.LanguageDetectionResponse, error) {
+	if !request.Explain {
+		return detector.DetectLanguage(ctx, request.Text)
+	}
+
+	if explainer, ok := detector.(domain.ExplainingDetector); ok {
+		return explainer.ExplainLanguage(ctx, request.Text)
+	}
+
+	response, err := detector.DetectLanguage(ctx, request.Text)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	explanation := &domain.Explanation{Candidates: []domain.CandidateEvidence{{
+		LanguageCode: response.LanguageCode,
+		Score:        float64(response.Confidence),
+	}}}
+	for _, alt := range response.Alternatives {
+		explanation.Candidates = append(explanation.Candidates, domain.CandidateEvidence{
+			LanguageCode: alt.LanguageCode,
+			Score:        float64(alt.Confidence),
+		})
+	}
+	domain.SortCandidates(explanation.Candidates)
+	response.Explanation = explanation
+	return response, nil
+}
+
 // validateRequest validates the incoming request
-func (s *LanguageDetectionServiceImpl) validateRequest(request *domain.LanguageDetectionRequest) error {
+func (s *LanguageDetectionServiceImpl) validateRequest(request *domain.LanguageDetectionRequest, config domain.ConfigProvider) error {
 	if request == nil {
 		return domain.ErrInvalidRequest
 	}
@@ -68,27 +441,75 @@ func (s *LanguageDetectionServiceImpl) validateRequest(request *domain.LanguageD
 		return domain.ErrEmptyText
 	}
 
-	if len(text) > s.config.GetMaxTextLength() {
+	if len(text) > config.GetMaxTextLength() {
 		return fmt.Errorf("%w: text length %d exceeds maximum %d",
-			domain.ErrTextTooLong, len(text), s.config.GetMaxTextLength())
+			domain.ErrTextTooLong, len(text), config.GetMaxTextLength())
+	}
+
+	if request.Options != nil {
+		return s.validateOptions(request.Options, config)
+	}
+
+	return nil
+}
+
+// validateOptions checks per-request options against the configured limits
+func (s *LanguageDetectionServiceImpl) validateOptions(options *domain.DetectionOptions, config domain.ConfigProvider) error {
+	if options.MaxAlternatives < 0 {
+		return fmt.Errorf("%w: max alternatives cannot be negative", domain.ErrInvalidOptions)
+	}
+
+	if limit := config.GetMaxAlternatives(); limit > 0 && options.MaxAlternatives > limit {
+		return fmt.Errorf("%w: max alternatives %d exceeds limit %d",
+			domain.ErrInvalidOptions, options.MaxAlternatives, limit)
+	}
+
+	if options.MinAlternativeScore < 0 || options.MinAlternativeScore > 1 {
+		return fmt.Errorf("%w: min alternative score must be between 0 and 1", domain.ErrInvalidOptions)
+	}
+
+	if options.MinConfidence < 0 || options.MinConfidence > 1 {
+		return fmt.Errorf("%w: min confidence must be between 0 and 1", domain.ErrInvalidOptions)
+	}
+
+	if floor := config.GetMinConfidenceFloor(); options.MinConfidence > 0 && float32(options.MinConfidence) < floor {
+		return fmt.Errorf("%w: min confidence %.2f is below the floor %.2f",
+			domain.ErrInvalidOptions, float32(options.MinConfidence), floor)
+	}
+
+	if options.Provider != "" && s.detectorNamed(options.Provider) == nil {
+		return fmt.Errorf("%w: provider %q is not available", domain.ErrInvalidOptions, options.Provider)
+	}
+
+	if options.TimeoutMs < 0 {
+		return fmt.Errorf("%w: timeout cannot be negative", domain.ErrInvalidOptions)
+	}
+
+	if limit := config.GetMaxRequestTimeout(); limit > 0 && time.Duration(options.TimeoutMs)*time.Millisecond > limit {
+		return fmt.Errorf("%w: timeout %dms exceeds limit %dms",
+			domain.ErrInvalidOptions, options.TimeoutMs, limit.Milliseconds())
 	}
 
 	return nil
 }
 
-// validateResponse validates the detection response
-func (s *LanguageDetectionServiceImpl) validateResponse(response *domain.LanguageDetectionResponse) error {
+// validateResponse validates the detection response against the request's confidence threshold
+func (s *LanguageDetectionServiceImpl) validateResponse(
+	response *domain.LanguageDetectionResponse,
+	minConfidence float32,
+	config domain.ConfigProvider,
+) error {
 	if response == nil {
 		return domain.ErrInternalError
 	}
 
-	if float32(response.Confidence) < s.config.GetMinConfidenceThreshold() {
+	if float32(response.Confidence) < minConfidence {
 		return fmt.Errorf("%w: confidence %.2f below threshold %.2f",
-			domain.ErrLowConfidence, float32(response.Confidence), s.config.GetMinConfidenceThreshold())
+			domain.ErrLowConfidence, float32(response.Confidence), minConfidence)
 	}
 
 	// Check if language is supported
-	supported := s.config.GetSupportedLanguages()
+	supported := config.GetSupportedLanguages()
 	if len(supported) > 0 {
 		found := false
 		for _, lang := range supported {
@@ -3,7 +3,10 @@ package application
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"language-detection-service/internal/language_detection/domain"
 )
@@ -28,6 +31,9 @@ type MockConfigProvider struct {
 	supportedLanguages     []domain.LanguageCode
 	serviceVersion         string
 	modelVersion           string
+	maxAlternatives        int
+	minConfidenceFloor     float32
+	maxRequestTimeout      time.Duration
 }
 
 func (m *MockConfigProvider) GetMaxTextLength() int {
@@ -50,6 +56,18 @@ func (m *MockConfigProvider) GetModelVersion() string {
 	return m.modelVersion
 }
 
+func (m *MockConfigProvider) GetMaxAlternatives() int {
+	return m.maxAlternatives
+}
+
+func (m *MockConfigProvider) GetMinConfidenceFloor() float32 {
+	return m.minConfidenceFloor
+}
+
+func (m *MockConfigProvider) GetMaxRequestTimeout() time.Duration {
+	return m.maxRequestTimeout
+}
+
 func TestNewLanguageDetectionService(t *testing.T) {
 	detector := &MockLanguageDetector{}
 	config := &MockConfigProvider{}
@@ -377,7 +395,7 @@ func TestValidateRequest(t *testing.T) {
 	
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := service.validateRequest(tt.request)
+			err := service.validateRequest(tt.request, service.config)
 			if tt.wantErr == nil {
 				if err != nil {
 					t.Errorf("validateRequest() error = %v, wantErr %v", err, tt.wantErr)
@@ -437,7 +455,7 @@ func TestValidateResponse(t *testing.T) {
 	
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := service.validateResponse(tt.response)
+			err := service.validateResponse(tt.response, service.config.GetMinConfidenceThreshold(), service.config)
 			if tt.wantErr == nil {
 				if err != nil {
 					t.Errorf("validateResponse() error = %v, wantErr %v", err, tt.wantErr)
@@ -450,3 +468,579 @@ func TestValidateResponse(t *testing.T) {
 		})
 	}
 }
+
+// MockExplainingDetector is a mock implementation of ExplainingDetector
+type MockExplainingDetector struct {
+	MockLanguageDetector
+	explained bool
+}
+
+func (m *MockExplainingDetector) ExplainLanguage(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+	m.explained = true
+	response := *m.response
+	response.Explanation = &domain.Explanation{Preprocessing: []string{"lowercased"}}
+	return &response, nil
+}
+
+func TestDetectLanguage_Explain(t *testing.T) {
+	ctx := context.Background()
+	config := &MockConfigProvider{maxTextLength: 100, minConfidenceThreshold: 0.1}
+
+	detector := &MockExplainingDetector{MockLanguageDetector: MockLanguageDetector{
+		response: &domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.9},
+	}}
+	service := NewLanguageDetectionService(detector, config)
+
+	response, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "hello"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if detector.explained || response.Explanation != nil {
+		t.Error("Expected no explanation unless requested")
+	}
+
+	response, err = service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "hello", Explain: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !detector.explained || response.Explanation == nil || response.Explanation.Preprocessing[0] != "lowercased" {
+		t.Errorf("Expected the detector's explanation, got %+v", response.Explanation)
+	}
+}
+
+func TestDetectLanguage_ExplainWithoutExplainer(t *testing.T) {
+	detector := &MockLanguageDetector{
+		response: &domain.LanguageDetectionResponse{
+			LanguageCode: "es-ES",
+			Confidence:   0.6,
+			Alternatives: []domain.LanguageAlternative{{LanguageCode: "pt-PT", Confidence: 0.3}},
+		},
+	}
+	service := NewLanguageDetectionService(detector, &MockConfigProvider{maxTextLength: 100})
+
+	response, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "hola", Explain: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if response.Explanation == nil || len(response.Explanation.Candidates) != 2 {
+		t.Fatalf("Expected an explanation with 2 candidates, got %+v", response.Explanation)
+	}
+
+	if response.Explanation.Candidates[0].LanguageCode != "es-ES" || response.Explanation.Candidates[1].LanguageCode != "pt-PT" {
+		t.Errorf("Expected es-ES then pt-PT, got %+v", response.Explanation.Candidates)
+	}
+}
+
+// MockNamedDetector is a mock LanguageDetector that reports a provider name
+type MockNamedDetector struct {
+	MockLanguageDetector
+	name  string
+	calls int
+}
+
+func (m *MockNamedDetector) DetectLanguage(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	response := *m.response
+	return &response, nil
+}
+
+func (m *MockNamedDetector) ProviderName() string {
+	return m.name
+}
+
+func (m *MockNamedDetector) ProducibleLanguages() []domain.LanguageCode {
+	return nil
+}
+
+func namedDetector(name string, code domain.LanguageCode, err error) *MockNamedDetector {
+	return &MockNamedDetector{
+		MockLanguageDetector: MockLanguageDetector{
+			response: &domain.LanguageDetectionResponse{
+				LanguageCode: code,
+				Confidence:   0.5,
+				Alternatives: []domain.LanguageAlternative{
+					{LanguageCode: "it-IT", Confidence: 0.1},
+					{LanguageCode: "pt-PT", Confidence: 0.3},
+					{LanguageCode: "fr-FR", Confidence: 0.2},
+				},
+				Metadata: domain.ProcessingMetadata{Provider: name},
+			},
+			err: err,
+		},
+		name: name,
+	}
+}
+
+func TestDetectLanguage_Options(t *testing.T) {
+	ctx := context.Background()
+	config := &MockConfigProvider{maxTextLength: 100, minConfidenceThreshold: 0.1, maxAlternatives: 5}
+	service := NewLanguageDetectionService(namedDetector("primary", "es-ES", nil), config)
+
+	response, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{
+		Text:    "hola",
+		Options: &domain.DetectionOptions{MaxAlternatives: 2, MinAlternativeScore: 0.15},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(response.Alternatives) != 2 || response.Alternatives[0].LanguageCode != "pt-PT" || response.Alternatives[1].LanguageCode != "fr-FR" {
+		t.Errorf("Expected pt-PT and fr-FR, got %v", response.Alternatives)
+	}
+
+	_, err = service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{
+		Text:    "hola",
+		Options: &domain.DetectionOptions{MinConfidence: 0.6},
+	})
+	if !errors.Is(err, domain.ErrLowConfidence) {
+		t.Errorf("Expected ErrLowConfidence for a stricter threshold, got %v", err)
+	}
+}
+
+func TestDetectLanguage_InvalidOptions(t *testing.T) {
+	config := &MockConfigProvider{
+		maxTextLength:          100,
+		minConfidenceThreshold: 0.3,
+		maxAlternatives:        5,
+		minConfidenceFloor:     0.2,
+		maxRequestTimeout:      time.Second,
+	}
+	service := NewLanguageDetectionService(namedDetector("primary", "es-ES", nil), config)
+
+	tests := []struct {
+		name    string
+		options domain.DetectionOptions
+	}{
+		{"Too many alternatives", domain.DetectionOptions{MaxAlternatives: 6}},
+		{"Negative alternatives", domain.DetectionOptions{MaxAlternatives: -1}},
+		{"Alternative score out of range", domain.DetectionOptions{MinAlternativeScore: 1.5}},
+		{"Threshold below floor", domain.DetectionOptions{MinConfidence: 0.1}},
+		{"Unknown provider", domain.DetectionOptions{Provider: "nope"}},
+		{"Timeout above limit", domain.DetectionOptions{TimeoutMs: 2000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := tt.options
+			_, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "hola", Options: &options})
+			if !errors.Is(err, domain.ErrInvalidOptions) {
+				t.Errorf("Expected ErrInvalidOptions, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDetectLanguage_PreferredProvider(t *testing.T) {
+	primary := namedDetector("primary", "es-ES", nil)
+	secondary := namedDetector("secondary", "pt-PT", nil)
+	service := NewLanguageDetectionService(primary, &MockConfigProvider{maxTextLength: 100}, secondary)
+
+	response, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{
+		Text:    "olá",
+		Options: &domain.DetectionOptions{Provider: "secondary"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if response.LanguageCode != "pt-PT" || primary.calls != 0 {
+		t.Errorf("Expected only the secondary provider to detect, got %s after %d primary calls", response.LanguageCode, primary.calls)
+	}
+}
+
+func TestDetectLanguage_Failover(t *testing.T) {
+	primary := namedDetector("primary", "", fmt.Errorf("%w: throttled", domain.ErrProviderUnavailable))
+	secondary := namedDetector("secondary", "es-ES", nil)
+	service := NewLanguageDetectionService(primary, &MockConfigProvider{maxTextLength: 100}, secondary)
+
+	// Failover is off unless configured
+	if _, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "hola"}); !errors.Is(err, domain.ErrProviderUnavailable) {
+		t.Errorf("Expected ErrProviderUnavailable without failover, got %v", err)
+	}
+	if secondary.calls != 0 {
+		t.Errorf("Expected no failover, got %d secondary calls", secondary.calls)
+	}
+
+	service.WithFailover("secondary")
+	response, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "hola"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if response.Metadata.Provider != "secondary" || response.Metadata.Details["failover_from"] != "primary" {
+		t.Errorf("Expected failover from primary to secondary, got %+v", response.Metadata)
+	}
+
+	// Other failures are not retried with another provider
+	primary.err = errors.New("bad credentials")
+	secondary.calls = 0
+	if _, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "hola"}); err == nil {
+		t.Error("Expected the primary error, got nil")
+	}
+	if secondary.calls != 0 {
+		t.Errorf("Expected no failover, got %d secondary calls", secondary.calls)
+	}
+}
+
+// MockMetrics records the detections and failovers reported to it
+type MockMetrics struct {
+	domain.NopMetrics
+	detections []string
+	failovers  []string
+}
+
+func (m *MockMetrics) DetectionCompleted(provider string, language domain.LanguageCode, confidence domain.Confidence, textLength int) {
+	m.detections = append(m.detections, fmt.Sprintf("%s %s %.1f %d", provider, language, confidence, textLength))
+}
+
+func (m *MockMetrics) FailedOver(from, to string) {
+	m.failovers = append(m.failovers, from+" -> "+to)
+}
+
+func TestDetectLanguage_Metrics(t *testing.T) {
+	primary := namedDetector("primary", "", domain.ErrProviderUnavailable)
+	secondary := namedDetector("secondary", "es-ES", nil)
+	metrics := &MockMetrics{}
+	service := NewLanguageDetectionService(primary, &MockConfigProvider{maxTextLength: 100}, secondary).
+		WithFailover("secondary").
+		WithMetrics(metrics)
+
+	if _, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "¿qué tal?"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(metrics.detections) != 1 || metrics.detections[0] != "secondary es-ES 0.5 9" {
+		t.Errorf("Expected one detection by secondary of 9 characters, got %v", metrics.detections)
+	}
+	if len(metrics.failovers) != 1 || metrics.failovers[0] != "primary -> secondary" {
+		t.Errorf("Expected a failover from primary to secondary, got %v", metrics.failovers)
+	}
+
+	// Failed detections are not counted
+	secondary.err = domain.ErrProviderUnavailable
+	if _, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "hola"}); err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if len(metrics.detections) != 1 {
+		t.Errorf("Expected no further detections, got %v", metrics.detections)
+	}
+}
+
+func TestDetectLanguage_Audit(t *testing.T) {
+	primary := namedDetector("primary", "", domain.ErrProviderUnavailable)
+	secondary := namedDetector("secondary", "es-ES", nil)
+	audit := &MockAuditSink{}
+	config := &MockConfigProvider{maxTextLength: 100, serviceVersion: "2.0.0", modelVersion: "2024-06"}
+	service := NewLanguageDetectionService(primary, config, secondary).WithFailover("secondary").WithAudit(audit)
+
+	ctx := domain.WithRequestID(domain.WithTenant(context.Background(), "acme"), "req-1")
+	if _, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "¿qué tal?", DocumentID: "doc-1"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(audit.records) != 1 {
+		t.Fatalf("Expected one audit record, got %d", len(audit.records))
+	}
+	record := audit.records[0]
+	if record.DocumentID != "doc-1" || record.TenantID != "acme" || record.RequestID != "req-1" {
+		t.Errorf("Expected doc-1 of acme in req-1, got %+v", record)
+	}
+	if record.TextHash != domain.AuditTextHash("¿qué tal?") || record.TextLength != 9 {
+		t.Errorf("Expected the hash and length of the text, got %q and %d", record.TextHash, record.TextLength)
+	}
+	if record.LanguageCode != "es-ES" || record.Provider != "secondary" || record.FailoverFrom != "primary" {
+		t.Errorf("Expected es-ES by secondary after primary, got %+v", record)
+	}
+	if record.ModelVersion != "2024-06" || record.ServiceVersion != "2.0.0" {
+		t.Errorf("Expected the configured versions, got %q and %q", record.ModelVersion, record.ServiceVersion)
+	}
+	if record.Time.IsZero() {
+		t.Error("Expected the record to be timestamped")
+	}
+
+	// Failed detections are not audited
+	secondary.err = domain.ErrProviderUnavailable
+	if _, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "hola"}); err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if len(audit.records) != 1 {
+		t.Errorf("Expected no further audit records, got %d", len(audit.records))
+	}
+}
+
+// MockAuditSink keeps the audit records in memory
+type MockAuditSink struct {
+	records []domain.AuditRecord
+}
+
+func (m *MockAuditSink) RecordDetection(ctx context.Context, record domain.AuditRecord) {
+	m.records = append(m.records, record)
+}
+
+func TestDetectLanguage_TimeoutOption(t *testing.T) {
+	detector := &MockContextDetector{}
+	service := NewLanguageDetectionService(detector, &MockConfigProvider{maxTextLength: 100})
+
+	_, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{
+		Text:    "hola",
+		Options: &domain.DetectionOptions{TimeoutMs: 10},
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+// MockContextDetector blocks until its context is done
+type MockContextDetector struct{}
+
+func (m *MockContextDetector) DetectLanguage(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// MockResultCache is a map-backed implementation of ResultCache
+type MockResultCache struct {
+	entries map[string]*domain.LanguageDetectionResponse
+}
+
+func (m *MockResultCache) Get(ctx context.Context, key string) (*domain.LanguageDetectionResponse, bool) {
+	response, ok := m.entries[key]
+	return response, ok
+}
+
+func (m *MockResultCache) Set(ctx context.Context, key string, response *domain.LanguageDetectionResponse) {
+	m.entries[key] = response
+}
+
+func TestDetectLanguage_Cache(t *testing.T) {
+	ctx := context.Background()
+	detector := namedDetector("primary", "es-ES", nil)
+	config := &MockConfigProvider{maxTextLength: 100, maxAlternatives: 5}
+	service := NewLanguageDetectionService(detector, config).
+		WithCache(&MockResultCache{entries: make(map[string]*domain.LanguageDetectionResponse)})
+
+	first, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "hola  mundo", DocumentID: "doc-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if first.Metadata.Cached {
+		t.Error("Expected the first response not to be cached")
+	}
+
+	second, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{
+		Text:       " hola mundo ",
+		DocumentID: "doc-2",
+		Options:    &domain.DetectionOptions{MaxAlternatives: 1},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if detector.calls != 1 {
+		t.Errorf("Expected 1 detector call, got %d", detector.calls)
+	}
+
+	if !second.Metadata.Cached || second.DocumentID != "doc-2" || second.LanguageCode != "es-ES" {
+		t.Errorf("Expected a cached es-ES response for doc-2, got %+v", second)
+	}
+
+	// Options applied after detection must not change the cached result
+	if len(second.Alternatives) != 1 || len(first.Alternatives) != 3 {
+		t.Errorf("Expected 1 filtered and 3 unfiltered alternatives, got %v and %v", second.Alternatives, first.Alternatives)
+	}
+
+	if _, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "hola mundo", Explain: true}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if detector.calls != 2 {
+		t.Errorf("Expected explain to bypass the plain result, got %d detector calls", detector.calls)
+	}
+}
+
+func TestDetectLanguage_CacheSkipsFailover(t *testing.T) {
+	primary := namedDetector("primary", "", domain.ErrProviderUnavailable)
+	secondary := namedDetector("secondary", "es-ES", nil)
+	cache := &MockResultCache{entries: make(map[string]*domain.LanguageDetectionResponse)}
+	service := NewLanguageDetectionService(primary, &MockConfigProvider{maxTextLength: 100}, secondary).
+		WithFailover("secondary").
+		WithCache(cache)
+
+	if _, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "hola"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(cache.entries) != 0 {
+		t.Errorf("Expected failover results not to be cached, got %d entries", len(cache.entries))
+	}
+}
+
+func TestDetectLanguage_Quota(t *testing.T) {
+	usage, ledger := newTestUsageService(map[string]domain.Quota{"acme": {DailyDocuments: 1}})
+	service := NewLanguageDetectionService(namedDetector("primary", "es-ES", nil), &MockConfigProvider{maxTextLength: 100}).
+		WithUsage(usage)
+	ctx := domain.WithTenant(context.Background(), "acme")
+
+	if _, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "hola"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	_, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "hola"})
+	if !errors.Is(err, domain.ErrQuotaExceeded) {
+		t.Errorf("Expected ErrQuotaExceeded, got %v", err)
+	}
+
+	recorded := ledger.usage["2026-04-15"]["acme"]
+	if recorded != (domain.Usage{Documents: 1, Characters: 4, BillingUnits: domain.MinBillingUnits}) {
+		t.Errorf("Expected one recorded detection, got %+v", recorded)
+	}
+
+	// Other tenants are not limited
+	if _, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{Text: "hola"}); err != nil {
+		t.Errorf("Expected the default tenant to pass, got %v", err)
+	}
+}
+
+func TestDetectLanguage_ProfilesDoNotShareCachedExplanations(t *testing.T) {
+	profile := func(code domain.LanguageCode) *MockDetectionProfile {
+		return &MockDetectionProfile{
+			MockConfigProvider: MockConfigProvider{maxTextLength: 100, minConfidenceThreshold: 0.1},
+			codes:              map[domain.LanguageCode]domain.LanguageCode{"es-ES": code},
+		}
+	}
+	profiles := MockConfigResolver{
+		domain.DefaultTenant: &MockConfigProvider{maxTextLength: 100, minConfidenceThreshold: 0.1},
+		"acme":               profile("es"),
+		"globex":             profile("spa"),
+	}
+	cache := &MockResultCache{entries: make(map[string]*domain.LanguageDetectionResponse)}
+	service := NewLanguageDetectionService(namedDetector("primary", "es-ES", nil), &MockConfigProvider{}).
+		WithConfigResolver(profiles).
+		WithCache(cache)
+
+	request := &domain.LanguageDetectionRequest{Text: "hola", Explain: true}
+	if _, err := service.DetectLanguage(context.Background(), request); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Both tenants read the same cache entry at once
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for _, tenant := range []string{"acme", "globex"} {
+		want := profiles[tenant].(*MockDetectionProfile).codes["es-ES"]
+		ctx := domain.WithTenant(context.Background(), tenant)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				response, err := service.DetectLanguage(ctx, request)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if got := response.Explanation.Candidates[0].LanguageCode; got != want {
+					errs <- fmt.Errorf("expected explanation code %s, got %s", want, got)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	for _, cached := range cache.entries {
+		if got := cached.Explanation.Candidates[0].LanguageCode; got != "es-ES" {
+			t.Errorf("Expected the cached explanation to keep es-ES, got %s", got)
+		}
+	}
+}
+
+// MockDetectionProfile is a mock implementation of DetectionProfile
+type MockDetectionProfile struct {
+	MockConfigProvider
+	provider string
+	codes    map[domain.LanguageCode]domain.LanguageCode
+}
+
+func (m *MockDetectionProfile) GetPreferredProvider() string {
+	return m.provider
+}
+
+func (m *MockDetectionProfile) MapLanguageCode(code domain.LanguageCode) domain.LanguageCode {
+	if mapped, ok := m.codes[code]; ok {
+		return mapped
+	}
+	return code
+}
+
+// MockConfigResolver is a mock implementation of ConfigResolver
+type MockConfigResolver map[string]domain.ConfigProvider
+
+func (m MockConfigResolver) ConfigFor(tenantID string) domain.ConfigProvider {
+	if config, ok := m[tenantID]; ok {
+		return config
+	}
+	return m[domain.DefaultTenant]
+}
+
+func TestDetectLanguage_Profiles(t *testing.T) {
+	primary := namedDetector("primary", "es-ES", nil)
+	fallback := namedDetector("fallback", "fr-FR", nil)
+	profiles := MockConfigResolver{
+		domain.DefaultTenant: &MockConfigProvider{maxTextLength: 100, minConfidenceThreshold: 0.1, serviceVersion: "default"},
+		"acme": &MockDetectionProfile{
+			MockConfigProvider: MockConfigProvider{
+				maxTextLength:          100,
+				minConfidenceThreshold: 0.1,
+				supportedLanguages:     []domain.LanguageCode{"fr", "es"},
+				serviceVersion:         "acme",
+			},
+			provider: "fallback",
+			codes:    map[domain.LanguageCode]domain.LanguageCode{"fr-FR": "fr", "es-ES": "es"},
+		},
+		"strict": &MockConfigProvider{maxTextLength: 100, minConfidenceThreshold: 0.9},
+	}
+	service := NewLanguageDetectionService(primary, &MockConfigProvider{}, fallback).WithConfigResolver(profiles)
+
+	// The profile picks the provider and renames the detected languages
+	acme := domain.WithTenant(context.Background(), "acme")
+	response, err := service.DetectLanguage(acme, &domain.LanguageDetectionRequest{Text: "bonjour"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if response.LanguageCode != "fr" || response.Metadata.Provider != "fallback" {
+		t.Errorf("Expected fr from the fallback provider, got %s from %s", response.LanguageCode, response.Metadata.Provider)
+	}
+	if response.Alternatives[0].LanguageCode != "it-IT" || response.Metadata.ServiceVersion != "acme" {
+		t.Errorf("Expected unmapped alternatives to keep their code and the acme version, got %v and %s",
+			response.Alternatives, response.Metadata.ServiceVersion)
+	}
+
+	// A request may still choose its provider
+	response, err = service.DetectLanguage(acme, &domain.LanguageDetectionRequest{
+		Text:    "hola",
+		Options: &domain.DetectionOptions{Provider: "primary"},
+	})
+	if err != nil || response.LanguageCode != "es" {
+		t.Errorf("Expected es from the primary provider, got %v (%v)", response, err)
+	}
+
+	// Each tenant has its own threshold
+	strict := domain.WithTenant(context.Background(), "strict")
+	if _, err := service.DetectLanguage(strict, &domain.LanguageDetectionRequest{Text: "hola"}); !errors.Is(err, domain.ErrLowConfidence) {
+		t.Errorf("Expected ErrLowConfidence for the strict tenant, got %v", err)
+	}
+
+	// Unknown tenants get the default profile
+	unknown := domain.WithTenant(context.Background(), "globex")
+	response, err = service.DetectLanguage(unknown, &domain.LanguageDetectionRequest{Text: "hola"})
+	if err != nil || response.LanguageCode != "es-ES" || response.Metadata.ServiceVersion != "default" {
+		t.Errorf("Expected es-ES with the default profile, got %v (%v)", response, err)
+	}
+}
@@ -0,0 +1,257 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+const (
+	// maxSubtitleCues bounds the number of cues detected per track
+	maxSubtitleCues = 20000
+
+	// subtitleSmoothingWindow is the number of neighbouring cues on each side
+	// that contribute to a cue's smoothed language
+	subtitleSmoothingWindow = 2
+
+	// subtitleConcurrency is the number of cues detected in parallel
+	subtitleConcurrency = 4
+
+	// subtitleCueMinConfidence is the confidence threshold of a single cue
+	// when the tenant's profile sets no lower floor
+	subtitleCueMinConfidence = 0.01
+)
+
+// SubtitleDetectionServiceImpl implements the SubtitleDetectionService interface.
+// Every cue is detected by the text service. A single cue is often too short
+// to pass the usual confidence threshold, so cues ask for the lowest threshold
+// allowed and weak detections are outvoted by their neighbours instead.
+type SubtitleDetectionServiceImpl struct {
+	parser   domain.SubtitleParser
+	service  domain.LanguageDetectionService
+	config   domain.ConfigProvider
+	profiles domain.ConfigResolver
+}
+
+// NewSubtitleDetectionService creates a new subtitle detection service
+func NewSubtitleDetectionService(
+	parser domain.SubtitleParser,
+	service domain.LanguageDetectionService,
+	config domain.ConfigProvider,
+) *SubtitleDetectionServiceImpl {
+	return &SubtitleDetectionServiceImpl{
+		parser:  parser,
+		service: service,
+		config:  config,
+	}
+}
+
+// WithConfigResolver cuts cues by the maximum text length of each tenant's
+// profile instead of the service-wide one
+func (s *SubtitleDetectionServiceImpl) WithConfigResolver(profiles domain.ConfigResolver) *SubtitleDetectionServiceImpl {
+	s.profiles = profiles
+	return s
+}
+
+// DetectSubtitleLanguage detects the dominant language of a track and the cues that disagree with it
+func (s *SubtitleDetectionServiceImpl) DetectSubtitleLanguage(
+	ctx context.Context,
+	request *domain.SubtitleDetectionRequest,
+) (*domain.SubtitleDetectionResponse, error) {
+	startTime := time.Now()
+
+	if request == nil {
+		return nil, domain.ErrInvalidRequest
+	}
+
+	if len(request.Content) == 0 {
+		return nil, fmt.Errorf("validation failed: %w", domain.ErrEmptyText)
+	}
+
+	cues, format, err := s.parser.Parse(request.Content, request.Format)
+	if err != nil {
+		return nil, fmt.Errorf("subtitle parsing failed: %w", err)
+	}
+
+	if len(cues) == 0 {
+		return nil, fmt.Errorf("validation failed: %w: subtitle track contains no text", domain.ErrEmptyText)
+	}
+
+	if len(cues) > maxSubtitleCues {
+		return nil, fmt.Errorf("validation failed: %w: track has %d cues, maximum is %d",
+			domain.ErrTextTooLong, len(cues), maxSubtitleCues)
+	}
+
+	config := tenantConfig(ctx, s.profiles, s.config)
+	texts := cueTexts(cues, config.GetMaxTextLength())
+
+	detections, err := s.detectCues(ctx, request, texts, cueMinConfidence(config))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &domain.SubtitleDetectionResponse{
+		TrackID:          request.TrackID,
+		Format:           format,
+		CueCount:         len(cues),
+		DeclaredLanguage: request.DeclaredLanguage,
+	}
+
+	track := domain.NewLanguageEvidence()
+	for i, detection := range detections {
+		if detection == nil {
+			continue
+		}
+		response.DetectedCueCount++
+		response.Metadata.Provider = detection.Metadata.Provider
+		track.Add(detection, cueWeight(cues[i]))
+	}
+
+	response.LanguageCode, response.Confidence, response.Alternatives = track.Result()
+	if response.DetectedCueCount == 0 {
+		return nil, fmt.Errorf("%w: no cue could be detected", domain.ErrLowConfidence)
+	}
+
+	for i, detection := range detections {
+		if detection == nil {
+			continue
+		}
+
+		smoothed, confidence := smoothCue(cues, detections, i)
+		if domain.SameLanguage(smoothed, response.LanguageCode) {
+			continue
+		}
+
+		response.DisagreeingCues = append(response.DisagreeingCues, domain.CueDetection{
+			Index:                cues[i].Index,
+			Start:                cues[i].Start,
+			End:                  cues[i].End,
+			Text:                 cues[i].Text,
+			LanguageCode:         detection.LanguageCode,
+			Confidence:           detection.Confidence,
+			SmoothedLanguageCode: smoothed,
+			SmoothedConfidence:   confidence,
+		})
+	}
+
+	if request.DeclaredLanguage != "" {
+		response.DeclaredMatches = domain.SameLanguage(request.DeclaredLanguage, response.LanguageCode)
+	}
+
+	response.Metadata.ProcessingTimeMs = time.Since(startTime).Milliseconds()
+	response.Metadata.ServiceVersion = config.GetServiceVersion()
+	response.Metadata.ModelVersion = config.GetModelVersion()
+
+	return response, nil
+}
+
+// cueTexts returns the text detected for each cue. Cues are short; an
+// overlong one is cut down to its first chunk.
+func cueTexts(cues []domain.SubtitleCue, maxTextLength int) []domain.Text {
+	texts := make([]domain.Text, len(cues))
+	for i, cue := range cues {
+		if chunks := splitText(string(cue.Text), maxTextLength); len(chunks) > 0 {
+			texts[i] = domain.Text(chunks[0])
+		}
+	}
+	return texts
+}
+
+// cueMinConfidence returns the lowest confidence threshold a cue may ask for
+func cueMinConfidence(config domain.ConfigProvider) domain.Confidence {
+	return domain.Confidence(max(config.GetMinConfidenceFloor(), subtitleCueMinConfidence))
+}
+
+// detectCues detects the text of every cue with bounded concurrency. Cues
+// that are empty, fail or fall below minConfidence are left nil; only
+// cancellation aborts the track. If no cue was detected because the provider was
+// unavailable, timed out or the tenant's quota ran out, that error is returned instead.
+func (s *SubtitleDetectionServiceImpl) detectCues(
+	ctx context.Context,
+	request *domain.SubtitleDetectionRequest,
+	texts []domain.Text,
+	minConfidence domain.Confidence,
+) ([]*domain.LanguageDetectionResponse, error) {
+	detections := make([]*domain.LanguageDetectionResponse, len(texts))
+	errs := make([]error, len(texts))
+	sem := make(chan struct{}, subtitleConcurrency)
+	var wg sync.WaitGroup
+
+	for i := range texts {
+		if texts[i] == "" {
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := s.service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{
+				Text:       texts[i],
+				DocumentID: request.TrackID,
+				Metadata:   request.Metadata,
+				Options:    &domain.DetectionOptions{MinConfidence: minConfidence},
+			})
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			if resp.LanguageCode == domain.UnknownLanguage {
+				return
+			}
+			detections[i] = resp
+		}(i)
+	}
+
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, detection := range detections {
+		if detection != nil {
+			return detections, nil
+		}
+	}
+	for _, err := range errs {
+		if errors.Is(err, domain.ErrProviderUnavailable) || errors.Is(err, domain.ErrQuotaExceeded) ||
+			errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, fmt.Errorf("no cue could be detected: %w", err)
+		}
+	}
+	return detections, nil
+}
+
+// smoothCue combines a cue's detection with those of its neighbours. The cue
+// itself counts double so a run of cues in another language still stands out.
+func smoothCue(cues []domain.SubtitleCue, detections []*domain.LanguageDetectionResponse, i int) (domain.LanguageCode, domain.Confidence) {
+	evidence := domain.NewLanguageEvidence()
+	for j := max(0, i-subtitleSmoothingWindow); j <= min(len(cues)-1, i+subtitleSmoothingWindow); j++ {
+		weight := cueWeight(cues[j])
+		if j == i {
+			weight *= 2
+		}
+		evidence.Add(detections[j], weight)
+	}
+
+	code, confidence, _ := evidence.Result()
+	return code, confidence
+}
+
+// cueWeight weighs a cue by the length of its text
+func cueWeight(cue domain.SubtitleCue) float64 {
+	return float64(utf8.RuneCountInString(string(cue.Text)))
+}
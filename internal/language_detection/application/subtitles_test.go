@@ -0,0 +1,240 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MockSubtitleParser is a mock implementation of SubtitleParser
+type MockSubtitleParser struct {
+	cues []domain.SubtitleCue
+	err  error
+}
+
+func (m *MockSubtitleParser) Parse(content []byte, format domain.SubtitleFormat) ([]domain.SubtitleCue, domain.SubtitleFormat, error) {
+	return m.cues, domain.SubtitleFormatSRT, m.err
+}
+
+// FuncLanguageDetector is a function-based implementation of LanguageDetector
+type FuncLanguageDetector func(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error)
+
+func (f FuncLanguageDetector) DetectLanguage(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+	return f(ctx, text)
+}
+
+// prefixDetector reads the language from a "xx:" prefix of the text
+func prefixDetector() FuncLanguageDetector {
+	return func(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+		code, _, ok := strings.Cut(string(text), ":")
+		if !ok {
+			return &domain.LanguageDetectionResponse{LanguageCode: domain.UnknownLanguage}, nil
+		}
+		return &domain.LanguageDetectionResponse{
+			LanguageCode: domain.LanguageCode(code),
+			Confidence:   0.3,
+			Metadata:     domain.ProcessingMetadata{Provider: "prefix"},
+		}, nil
+	}
+}
+
+func makeCues(texts ...string) []domain.SubtitleCue {
+	var cues []domain.SubtitleCue
+	for i, text := range texts {
+		cues = append(cues, domain.SubtitleCue{
+			Index: i,
+			Start: time.Duration(i) * time.Second,
+			End:   time.Duration(i+1) * time.Second,
+			Text:  domain.Text(text),
+		})
+	}
+	return cues
+}
+
+func TestSubtitleDetection_DominantAndDisagreeing(t *testing.T) {
+	// A stray French cue is smoothed away; a run of German cues is reported
+	parser := &MockSubtitleParser{cues: makeCues(
+		"en-US: one", "en-US: two", "fr-FR: oui", "en-US: three", "en-US: four",
+		"de-DE: eins", "de-DE: zwei", "de-DE: drei", "en-US: five", "en-US: six",
+		"???",
+	)}
+	// Cues are detected even though they fall below the usual threshold
+	config := &MockConfigProvider{maxTextLength: 5000, minConfidenceThreshold: 0.5, serviceVersion: "1.0.0"}
+	service := NewSubtitleDetectionService(parser, NewLanguageDetectionService(prefixDetector(), config), config)
+
+	resp, err := service.DetectSubtitleLanguage(context.Background(), &domain.SubtitleDetectionRequest{
+		Content:          []byte("ignored"),
+		TrackID:          "track-1",
+		DeclaredLanguage: "de",
+	})
+	if err != nil {
+		t.Fatalf("DetectSubtitleLanguage() error = %v, want nil", err)
+	}
+
+	if resp.LanguageCode != "en-US" {
+		t.Errorf("Expected dominant language en-US, got %s", resp.LanguageCode)
+	}
+
+	if resp.CueCount != 11 || resp.DetectedCueCount != 10 {
+		t.Errorf("Expected 11 cues with 10 detected, got %d and %d", resp.CueCount, resp.DetectedCueCount)
+	}
+
+	if resp.DeclaredMatches {
+		t.Error("Expected declared language 'de' not to match")
+	}
+
+	if resp.Metadata.Provider != "prefix" || resp.Metadata.ServiceVersion != "1.0.0" {
+		t.Errorf("Unexpected metadata: %+v", resp.Metadata)
+	}
+
+	var indexes []int
+	for _, cue := range resp.DisagreeingCues {
+		indexes = append(indexes, cue.Index)
+		if cue.SmoothedLanguageCode != "de-DE" {
+			t.Errorf("Expected cue %d to be smoothed to de-DE, got %s", cue.Index, cue.SmoothedLanguageCode)
+		}
+	}
+	if len(indexes) == 0 || indexes[0] < 5 || indexes[len(indexes)-1] > 7 {
+		t.Errorf("Expected only German cues 5-7 to disagree, got %v", indexes)
+	}
+
+	if len(resp.DisagreeingCues) > 0 && resp.DisagreeingCues[0].Start != time.Duration(indexes[0])*time.Second {
+		t.Errorf("Expected cue timestamps to be kept, got %v", resp.DisagreeingCues[0].Start)
+	}
+}
+
+func TestSubtitleDetection_DeclaredMatches(t *testing.T) {
+	parser := &MockSubtitleParser{cues: makeCues("es-ES: hola", "es-ES: adiós")}
+	config := &MockConfigProvider{maxTextLength: 5000}
+	service := NewSubtitleDetectionService(parser, NewLanguageDetectionService(prefixDetector(), config), config)
+
+	resp, err := service.DetectSubtitleLanguage(context.Background(), &domain.SubtitleDetectionRequest{
+		Content:          []byte("ignored"),
+		DeclaredLanguage: "es-MX",
+	})
+	if err != nil {
+		t.Fatalf("DetectSubtitleLanguage() error = %v, want nil", err)
+	}
+
+	if !resp.DeclaredMatches {
+		t.Error("Expected declared language es-MX to match es-ES")
+	}
+
+	if len(resp.DisagreeingCues) != 0 {
+		t.Errorf("Expected no disagreeing cues, got %v", resp.DisagreeingCues)
+	}
+}
+
+func TestSubtitleDetection_Errors(t *testing.T) {
+	config := &MockConfigProvider{maxTextLength: 5000}
+
+	tests := []struct {
+		name     string
+		parser   *MockSubtitleParser
+		content  []byte
+		expected error
+	}{
+		{"Empty content", &MockSubtitleParser{}, nil, domain.ErrEmptyText},
+		{"Parse failure", &MockSubtitleParser{err: domain.ErrMalformedDocument}, []byte("x"), domain.ErrMalformedDocument},
+		{"No cues", &MockSubtitleParser{}, []byte("x"), domain.ErrEmptyText},
+		{"Nothing detected", &MockSubtitleParser{cues: makeCues("???", "!!!")}, []byte("x"), domain.ErrLowConfidence},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewSubtitleDetectionService(tt.parser, NewLanguageDetectionService(prefixDetector(), config), config)
+			_, err := service.DetectSubtitleLanguage(context.Background(), &domain.SubtitleDetectionRequest{Content: tt.content})
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("DetectSubtitleLanguage() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSubtitleDetection_Tenant(t *testing.T) {
+	parser := &MockSubtitleParser{cues: makeCues("es-ES: hola", "es-ES: adiós", "")}
+	usage, ledger := newTestUsageService(map[string]domain.Quota{"acme": {DailyDocuments: 2}})
+	profiles := MockConfigResolver{
+		domain.DefaultTenant: &MockConfigProvider{maxTextLength: 5000},
+		"acme": &MockDetectionProfile{
+			MockConfigProvider: MockConfigProvider{maxTextLength: 5000},
+			codes:              map[domain.LanguageCode]domain.LanguageCode{"es-ES": "es"},
+		},
+	}
+	config := &MockConfigProvider{maxTextLength: 5000}
+	detection := NewLanguageDetectionService(prefixDetector(), config).
+		WithConfigResolver(profiles).
+		WithUsage(usage)
+	service := NewSubtitleDetectionService(parser, detection, config).
+		WithConfigResolver(profiles)
+	ctx := domain.WithTenant(context.Background(), "acme")
+	request := &domain.SubtitleDetectionRequest{Content: []byte("ignored")}
+
+	resp, err := service.DetectSubtitleLanguage(ctx, request)
+	if err != nil {
+		t.Fatalf("DetectSubtitleLanguage() error = %v, want nil", err)
+	}
+
+	if resp.LanguageCode != "es" {
+		t.Errorf("Expected the tenant's code es, got %s", resp.LanguageCode)
+	}
+
+	// Each cue with text is a detection of its own
+	daily, _ := ledger.GetUsage(ctx, "acme", "2026-04-15")
+	if daily.Documents != 2 || daily.Characters != int64(len([]rune("es-ES: holaes-ES: adiós"))) {
+		t.Errorf("Expected 2 documents of usage, got %+v", daily)
+	}
+
+	if _, err := service.DetectSubtitleLanguage(ctx, request); !errors.Is(err, domain.ErrQuotaExceeded) {
+		t.Errorf("Expected ErrQuotaExceeded, got %v", err)
+	}
+}
+
+func TestSubtitleDetection_DetectorFailures(t *testing.T) {
+	// failing detects with prefixDetector unless fail returns an error for the cue
+	failing := func(fail func(text domain.Text) error) FuncLanguageDetector {
+		return func(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+			if err := fail(text); err != nil {
+				return nil, err
+			}
+			return prefixDetector()(ctx, text)
+		}
+	}
+	config := &MockConfigProvider{maxTextLength: 5000}
+	request := &domain.SubtitleDetectionRequest{Content: []byte("ignored")}
+
+	tests := []struct {
+		name     string
+		fail     func(text domain.Text) error
+		expected error
+	}{
+		{"Provider unavailable", func(domain.Text) error { return domain.ErrProviderUnavailable }, domain.ErrProviderUnavailable},
+		{"Timed out", func(domain.Text) error { return context.DeadlineExceeded }, context.DeadlineExceeded},
+		{"Other failures", func(domain.Text) error { return domain.ErrInternalError }, domain.ErrLowConfidence},
+		{"Some cues detected", func(text domain.Text) error {
+			if strings.HasSuffix(string(text), "adiós") {
+				return domain.ErrProviderUnavailable
+			}
+			return nil
+		}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := &MockSubtitleParser{cues: makeCues("es-ES: hola", "es-ES: adiós", "es-ES: gracias")}
+			service := NewSubtitleDetectionService(parser, NewLanguageDetectionService(failing(tt.fail), config), config)
+
+			resp, err := service.DetectSubtitleLanguage(context.Background(), request)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("DetectSubtitleLanguage() error = %v, want %v", err, tt.expected)
+			}
+			if tt.expected == nil && (resp == nil || resp.DetectedCueCount != 2) {
+				t.Errorf("Expected 2 detected cues, got %+v", resp)
+			}
+		})
+	}
+}
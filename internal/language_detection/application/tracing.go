@@ -0,0 +1,53 @@
+package application
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// tracerName identifies the spans of the application services
+const tracerName = "language-detection-service/application"
+
+// Span attributes describing a detection
+const (
+	attrTenant       = attribute.Key("tenant.id")
+	attrProvider     = attribute.Key("detection.provider")
+	attrTextLength   = attribute.Key("detection.text_length")
+	attrLanguage     = attribute.Key("detection.language")
+	attrConfidence   = attribute.Key("detection.confidence")
+	attrCached       = attribute.Key("detection.cached")
+	attrFailoverFrom = attribute.Key("detection.failover_from")
+	attrFailoverTo   = attribute.Key("detection.failover_to")
+)
+
+// tracerFrom returns the tracer of provider, or of the global provider when
+// none is set
+func tracerFrom(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(tracerName)
+}
+
+// detectionAttributes describes the result of a detection
+func detectionAttributes(response *domain.LanguageDetectionResponse) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attrProvider.String(response.Metadata.Provider),
+		attrLanguage.String(string(response.LanguageCode)),
+		attrConfidence.Float64(float64(response.Confidence)),
+		attrCached.Bool(response.Metadata.Cached),
+	}
+}
+
+// endSpan records err, if any, on span and ends it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,93 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// spanAttributes indexes the attributes of a span by key
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func TestDetectLanguage_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	primary := namedDetector("primary", "", domain.ErrProviderUnavailable)
+	secondary := namedDetector("secondary", "es-ES", nil)
+	service := NewLanguageDetectionService(primary, &MockConfigProvider{maxTextLength: 100}, secondary).
+		WithFailover("secondary").
+		WithTracerProvider(provider)
+
+	ctx := domain.WithTenant(context.Background(), "acme")
+	if _, err := service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{Text: "¿qué tal?"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "LanguageDetectionServiceImpl.DetectLanguage" {
+		t.Errorf("Expected span LanguageDetectionServiceImpl.DetectLanguage, got %s", span.Name())
+	}
+
+	attributes := spanAttributes(span)
+	expected := map[attribute.Key]attribute.Value{
+		attrTenant:     attribute.StringValue("acme"),
+		attrTextLength: attribute.IntValue(9),
+		attrProvider:   attribute.StringValue("secondary"),
+		attrLanguage:   attribute.StringValue("es-ES"),
+		attrConfidence: attribute.Float64Value(0.5),
+		attrCached:     attribute.BoolValue(false),
+	}
+	for key, value := range expected {
+		if attributes[key] != value {
+			t.Errorf("Expected attribute %s = %v, got %v", key, value.Emit(), attributes[key].Emit())
+		}
+	}
+
+	var events []string
+	for _, event := range span.Events() {
+		events = append(events, event.Name)
+	}
+	if len(events) != 2 || events[0] != "request validated" || events[1] != "failover" {
+		t.Errorf("Expected events [request validated failover], got %v", events)
+	}
+}
+
+func TestDetectLanguage_TracingError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	service := NewLanguageDetectionService(&MockLanguageDetector{}, &MockConfigProvider{maxTextLength: 100}).
+		WithTracerProvider(provider)
+
+	if _, err := service.DetectLanguage(context.Background(), &domain.LanguageDetectionRequest{}); err == nil {
+		t.Fatal("Expected a validation error, got nil")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Errorf("Expected an error status, got %v", spans[0].Status())
+	}
+	if len(spans[0].Events()) != 1 || spans[0].Events()[0].Name != "exception" {
+		t.Errorf("Expected the error to be recorded, got %v", spans[0].Events())
+	}
+}
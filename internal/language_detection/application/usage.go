@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// UsageServiceImpl implements the UsageService interface. Quotas are checked
+// before a detection and usage is recorded after it, so concurrent requests
+// of a tenant can overshoot a quota by the requests in flight.
+type UsageServiceImpl struct {
+	ledger domain.UsageLedger
+	quotas map[string]domain.Quota
+	now    func() time.Time
+}
+
+// NewUsageService creates a new usage service. Tenants missing from quotas
+// get the quota of DefaultTenant, or none.
+func NewUsageService(ledger domain.UsageLedger, quotas map[string]domain.Quota) *UsageServiceImpl {
+	return &UsageServiceImpl{
+		ledger: ledger,
+		quotas: quotas,
+		now:    time.Now,
+	}
+}
+
+// CheckQuota returns ErrQuotaExceeded if the tenant cannot afford the usage
+func (s *UsageServiceImpl) CheckQuota(ctx context.Context, tenantID string, usage domain.Usage) error {
+	quota := s.quotaOf(tenantID)
+	if quota == (domain.Quota{}) {
+		return nil
+	}
+
+	now := s.now()
+	daily, err := s.ledger.GetUsage(ctx, tenantID, domain.DayPeriod(now))
+	if err != nil {
+		return fmt.Errorf("failed to read usage: %w", err)
+	}
+	monthly, err := s.ledger.GetUsage(ctx, tenantID, domain.MonthPeriod(now))
+	if err != nil {
+		return fmt.Errorf("failed to read usage: %w", err)
+	}
+
+	if err := quota.Check(daily, monthly, usage); err != nil {
+		return fmt.Errorf("tenant %s: %w", tenantID, err)
+	}
+	return nil
+}
+
+// RecordUsage accounts for a completed detection
+func (s *UsageServiceImpl) RecordUsage(ctx context.Context, tenantID string, usage domain.Usage) error {
+	return s.ledger.RecordUsage(ctx, tenantID, s.now(), usage)
+}
+
+// GetUsageReport returns the usage over a period of one tenant, or of all
+// tenants if tenantID is empty. The period defaults to the current month.
+// Callers other than admins can only read the usage of their own tenant.
+func (s *UsageServiceImpl) GetUsageReport(ctx context.Context, period string, tenantID string) ([]domain.TenantUsage, error) {
+	if err := authorizeReport(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	if period == "" {
+		period = domain.MonthPeriod(s.now())
+	}
+	if err := domain.ValidatePeriod(period); err != nil {
+		return nil, err
+	}
+
+	if tenantID == "" {
+		return s.ledger.ListUsage(ctx, period)
+	}
+
+	usage, err := s.ledger.GetUsage(ctx, tenantID, period)
+	if err != nil {
+		return nil, err
+	}
+	return []domain.TenantUsage{{TenantID: tenantID, Period: period, Usage: usage}}, nil
+}
+
+// authorizeReport checks that the caller may read the usage of tenantID
+func authorizeReport(ctx context.Context, tenantID string) error {
+	if isAdmin(ctx) {
+		return nil
+	}
+	if tenantID == "" {
+		return fmt.Errorf("%w: only admins can report on every tenant", domain.ErrPermissionDenied)
+	}
+	if !canAccessTenant(ctx, tenantID) {
+		return fmt.Errorf("%w: cannot report on tenant %s", domain.ErrPermissionDenied, tenantID)
+	}
+	return nil
+}
+
+// canAccessTenant reports whether the caller may read the data of tenantID:
+// admins may read every tenant, other callers only their own
+func canAccessTenant(ctx context.Context, tenantID string) bool {
+	return isAdmin(ctx) || tenantID == domain.TenantFromContext(ctx)
+}
+
+// isAdmin reports whether the caller is an authenticated admin
+func isAdmin(ctx context.Context) bool {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	return ok && principal.Admin
+}
+
+// quotaOf returns the quota of a tenant
+func (s *UsageServiceImpl) quotaOf(tenantID string) domain.Quota {
+	if quota, ok := s.quotas[tenantID]; ok {
+		return quota
+	}
+	return s.quotas[domain.DefaultTenant]
+}
@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MockUsageLedger is a map-backed implementation of UsageLedger
+type MockUsageLedger struct {
+	mu    sync.Mutex
+	usage map[string]map[string]domain.Usage // period to tenant to usage
+}
+
+func newMockUsageLedger() *MockUsageLedger {
+	return &MockUsageLedger{usage: make(map[string]map[string]domain.Usage)}
+}
+
+func (m *MockUsageLedger) RecordUsage(ctx context.Context, tenantID string, at time.Time, usage domain.Usage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, period := range []string{domain.DayPeriod(at), domain.MonthPeriod(at)} {
+		if m.usage[period] == nil {
+			m.usage[period] = make(map[string]domain.Usage)
+		}
+		m.usage[period][tenantID] = m.usage[period][tenantID].Add(usage)
+	}
+	return nil
+}
+
+func (m *MockUsageLedger) GetUsage(ctx context.Context, tenantID string, period string) (domain.Usage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.usage[period][tenantID], nil
+}
+
+func (m *MockUsageLedger) ListUsage(ctx context.Context, period string) ([]domain.TenantUsage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var report []domain.TenantUsage
+	for tenantID, usage := range m.usage[period] {
+		report = append(report, domain.TenantUsage{TenantID: tenantID, Period: period, Usage: usage})
+	}
+	sort.Slice(report, func(i, j int) bool { return report[i].TenantID < report[j].TenantID })
+	return report, nil
+}
+
+func newTestUsageService(quotas map[string]domain.Quota) (*UsageServiceImpl, *MockUsageLedger) {
+	ledger := newMockUsageLedger()
+	service := NewUsageService(ledger, quotas)
+	service.now = func() time.Time { return time.Date(2026, 4, 15, 12, 0, 0, 0, time.UTC) }
+	return service, ledger
+}
+
+func TestUsageService_CheckQuota(t *testing.T) {
+	service, _ := newTestUsageService(map[string]domain.Quota{
+		domain.DefaultTenant: {DailyDocuments: 1},
+		"acme":               {MonthlyCharacters: 10},
+		"unlimited":          {},
+	})
+	ctx := context.Background()
+	usage := domain.Usage{Documents: 1, Characters: 6}
+
+	tests := []struct {
+		tenantID string
+		exceeded bool
+	}{
+		{"globex", false},
+		{"globex", true},
+		{"acme", false},
+		{"acme", true},
+		{"unlimited", false},
+		{"unlimited", false},
+	}
+
+	for i, tt := range tests {
+		err := service.CheckQuota(ctx, tt.tenantID, usage)
+		if tt.exceeded != errors.Is(err, domain.ErrQuotaExceeded) {
+			t.Errorf("Request %d of %s: expected exceeded %v, got %v", i, tt.tenantID, tt.exceeded, err)
+		}
+		if err == nil {
+			service.RecordUsage(ctx, tt.tenantID, usage)
+		}
+	}
+}
+
+func TestUsageService_GetUsageReport(t *testing.T) {
+	service, _ := newTestUsageService(nil)
+	ctx := domain.WithPrincipal(context.Background(), domain.Principal{ID: "finance", Admin: true})
+
+	service.RecordUsage(ctx, "acme", domain.Usage{Documents: 2, Characters: 10, BillingUnits: 6})
+	service.RecordUsage(ctx, "globex", domain.Usage{Documents: 1, Characters: 5, BillingUnits: 3})
+
+	report, err := service.GetUsageReport(ctx, "", "")
+	if err != nil {
+		t.Fatalf("GetUsageReport() error = %v, want nil", err)
+	}
+	if len(report) != 2 || report[0].Period != "2026-04" || report[0].TenantID != "acme" || report[0].BillingUnits != 6 {
+		t.Errorf("Expected this month's usage of acme and globex, got %+v", report)
+	}
+
+	report, _ = service.GetUsageReport(ctx, "2026-04-15", "globex")
+	if len(report) != 1 || report[0].Documents != 1 {
+		t.Errorf("Expected one day of globex, got %+v", report)
+	}
+
+	if _, err := service.GetUsageReport(ctx, "April", ""); !errors.Is(err, domain.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for a malformed period, got %v", err)
+	}
+}
+
+func TestUsageService_GetUsageReport_Authorization(t *testing.T) {
+	service, _ := newTestUsageService(nil)
+	service.RecordUsage(context.Background(), "acme", domain.Usage{Documents: 1})
+
+	member := domain.WithPrincipal(context.Background(), domain.Principal{ID: "billing", TenantID: "acme"})
+	admin := domain.WithPrincipal(context.Background(), domain.Principal{ID: "finance", TenantID: "acme", Admin: true})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		tenantID string
+		allowed  bool
+	}{
+		{"Own tenant", member, "acme", true},
+		{"Other tenant", member, "globex", false},
+		{"Every tenant", member, "", false},
+		{"Tenant header without credentials", domain.WithTenant(context.Background(), "acme"), "acme", true},
+		{"Every tenant without credentials", context.Background(), "", false},
+		{"Admin on another tenant", admin, "globex", true},
+		{"Admin on every tenant", admin, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.GetUsageReport(tt.ctx, "", tt.tenantID)
+			if tt.allowed && err != nil {
+				t.Errorf("Expected the report, got %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, domain.ErrPermissionDenied) {
+				t.Errorf("Expected ErrPermissionDenied, got %v", err)
+			}
+		})
+	}
+}
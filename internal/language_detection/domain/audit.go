@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// AuditRecord documents one detection: which language was assigned to a text,
+// by which provider and model version
+type AuditRecord struct {
+	Time             time.Time    `json:"time"`
+	RequestID        string       `json:"request_id,omitempty"`
+	DocumentID       string       `json:"document_id,omitempty"`
+	TenantID         string       `json:"tenant,omitempty"`
+	TextHash         string       `json:"text_hash"`
+	TextLength       int          `json:"text_length"`
+	LanguageCode     LanguageCode `json:"language_code"`
+	Confidence       Confidence   `json:"confidence"`
+	Provider         string       `json:"provider"`
+	ModelVersion     string       `json:"model_version"`
+	ServiceVersion   string       `json:"service_version"`
+	ProcessingTimeMs int64        `json:"processing_time_ms"`
+	Cached           bool         `json:"cached,omitempty"`
+	FailoverFrom     string       `json:"failover_from,omitempty"`
+}
+
+// AuditTextHash returns the hex SHA-256 of a text exactly as it was sent, so
+// an audit record can be matched to the document it was made for
+func AuditTextHash(text Text) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// CacheStats reports the effectiveness of a result cache
+type CacheStats struct {
+	Hits      uint64      `json:"hits"`
+	Misses    uint64      `json:"misses"`
+	Evictions uint64      `json:"evictions"`
+	Entries   int         `json:"entries"`
+	Errors    uint64      `json:"errors,omitempty"` // failed calls to an external cache, served as misses
+	Shared    *CacheStats `json:"shared,omitempty"` // the shared tier behind a local cache
+}
+
+// NormalizeText returns the form of a text used to recognise repeated requests:
+// Unicode NFC with runs of whitespace collapsed to a single space and trimmed
+func NormalizeText(text Text) string {
+	return strings.Join(strings.Fields(norm.NFC.String(string(text))), " ")
+}
+
+// CacheKey derives the result cache key of a text and the options that change
+// what the detector returns. Options applied after detection, such as
+// thresholds and alternative filtering, are deliberately left out.
+func CacheKey(text Text, provider string, explain bool) string {
+	hash := sha256.New()
+	hash.Write([]byte(provider))
+	hash.Write([]byte{0})
+	if explain {
+		hash.Write([]byte{1})
+	} else {
+		hash.Write([]byte{0})
+	}
+	hash.Write([]byte(NormalizeText(text)))
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "testing"
+
+func TestNormalizeText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     Text
+		expected string
+	}{
+		{"Collapses whitespace", "  Hello \t\n world  ", "Hello world"},
+		{"Composes accents", "café", "café"},
+		{"Keeps case", "Hello", "Hello"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeText(tt.text); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	key := CacheKey("Hello  world", "", false)
+
+	if CacheKey(" Hello world\n", "", false) != key {
+		t.Error("Expected texts differing only in whitespace to share a key")
+	}
+
+	if CacheKey("Hello world", "fallback", false) == key {
+		t.Error("Expected the provider to change the key")
+	}
+
+	if CacheKey("Hello world", "", true) == key {
+		t.Error("Expected explain to change the key")
+	}
+
+	if CacheKey("Hello world!", "", false) == key {
+		t.Error("Expected different texts to have different keys")
+	}
+}
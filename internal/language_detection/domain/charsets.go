@@ -0,0 +1,47 @@
+package domain
+
+// CharsetUTF8 is the canonical name of UTF-8
+const CharsetUTF8 = "utf-8"
+
+// CharsetCandidate is a possible character set of raw text. Languages lists
+// the base languages the charset is typically used for; it is empty for
+// charsets such as UTF-8 that are not tied to a language.
+type CharsetCandidate struct {
+	Charset    string     `json:"charset"`
+	Confidence Confidence `json:"confidence"`
+	Languages  []string   `json:"languages,omitempty"`
+}
+
+// Suits reports whether the charset is typically used for the given language.
+// A charset that is not tied to any language suits every language.
+func (c CharsetCandidate) Suits(code LanguageCode) bool {
+	if len(c.Languages) == 0 {
+		return true
+	}
+	base := BaseLanguage(code)
+	for _, language := range c.Languages {
+		if language == base {
+			return true
+		}
+	}
+	return false
+}
+
+// RawTextDetectionRequest represents a request to detect the language of text
+// in an unknown or declared character set
+type RawTextDetectionRequest struct {
+	Content    []byte            `json:"content"`
+	Charset    string            `json:"charset,omitempty"` // declared charset, detected when empty
+	DocumentID string            `json:"document_id,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// RawTextDetectionResponse represents the detected charset and language of raw text.
+// CharsetMatchesLanguage reports whether the charset is one typically used for
+// the detected language, so each result helps confirm the other.
+type RawTextDetectionResponse struct {
+	LanguageDetectionResponse
+	Charset                string     `json:"charset"`
+	CharsetConfidence      Confidence `json:"charset_confidence"`
+	CharsetMatchesLanguage bool       `json:"charset_matches_language"`
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "testing"
+
+func TestCharsetCandidate_Suits(t *testing.T) {
+	cyrillic := CharsetCandidate{Charset: "windows-1251", Languages: []string{"ru", "uk", "bg"}}
+	utf8 := CharsetCandidate{Charset: CharsetUTF8}
+
+	if !cyrillic.Suits("ru-RU") {
+		t.Error("Expected windows-1251 to suit ru-RU")
+	}
+
+	if cyrillic.Suits("ja-JP") {
+		t.Error("Expected windows-1251 not to suit ja-JP")
+	}
+
+	if !utf8.Suits("ja-JP") {
+		t.Error("Expected utf-8 to suit every language")
+	}
+}
@@ -0,0 +1,49 @@
+package domain
+
+// Document MIME types accepted by document detection
+const (
+	MimeTypePlainText = "text/plain"
+	MimeTypeDOCX      = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	MimeTypeODT       = "application/vnd.oasis.opendocument.text"
+	MimeTypeEPUB      = "application/epub+zip"
+)
+
+// DocumentSection is a unit of text extracted from a document, such as a
+// chapter of an e-book or the text under a heading
+type DocumentSection struct {
+	Index int    `json:"index"`
+	Name  string `json:"name,omitempty"`
+	Text  Text   `json:"text"`
+}
+
+// DocumentDetectionRequest represents a request to detect the language of a file
+type DocumentDetectionRequest struct {
+	Content    []byte            `json:"content"`
+	MimeType   string            `json:"mime_type"`
+	DocumentID string            `json:"document_id,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// DocumentDetectionResponse represents the overall and per-section languages of a file
+type DocumentDetectionResponse struct {
+	DocumentID     string                `json:"document_id,omitempty"`
+	MimeType       string                `json:"mime_type"`
+	LanguageCode   LanguageCode          `json:"language_code"`
+	Confidence     Confidence            `json:"confidence"`
+	Alternatives   []LanguageAlternative `json:"alternatives,omitempty"`
+	CharacterCount int64                 `json:"character_count"`
+	Sections       []SectionDetection    `json:"sections"`
+	Metadata       ProcessingMetadata    `json:"metadata"`
+}
+
+// SectionDetection represents the detected language of a single document section.
+// Error is set when no part of the section could be detected.
+type SectionDetection struct {
+	Index          int                   `json:"index"`
+	Name           string                `json:"name,omitempty"`
+	CharacterCount int64                 `json:"character_count"`
+	LanguageCode   LanguageCode          `json:"language_code,omitempty"`
+	Confidence     Confidence            `json:"confidence,omitempty"`
+	Alternatives   []LanguageAlternative `json:"alternatives,omitempty"`
+	Error          string                `json:"error,omitempty"`
+}
@@ -14,6 +14,8 @@ type LanguageDetectionRequest struct {
 	Text       Text              `json:"text"`
 	DocumentID string            `json:"document_id,omitempty"`
 	Metadata   map[string]string `json:"metadata,omitempty"`
+	Explain    bool              `json:"explain,omitempty"` // attach an Explanation to the response
+	Options    *DetectionOptions `json:"options,omitempty"`
 }
 
 // LanguageDetectionResponse represents the response from language detection
@@ -23,6 +25,13 @@ type LanguageDetectionResponse struct {
 	Alternatives []LanguageAlternative  `json:"alternatives,omitempty"`
 	DocumentID   string                 `json:"document_id,omitempty"`
 	Metadata     ProcessingMetadata     `json:"metadata"`
+	Explanation  *Explanation           `json:"explanation,omitempty"`
+}
+
+// BatchResult is the response or the error of one text in a batch detection
+type BatchResult struct {
+	Response *LanguageDetectionResponse
+	Err      error
 }
 
 // LanguageAlternative represents an alternative language detection result
@@ -38,4 +47,25 @@ type ProcessingMetadata struct {
 	ModelVersion     string            `json:"model_version"`
 	Provider         string            `json:"provider"`
 	Details          map[string]string `json:"details,omitempty"`
+	Cached           bool              `json:"cached,omitempty"`
+}
+
+// SessionEstimate represents the running language estimate of a streaming session
+type SessionEstimate struct {
+	SessionID      string                `json:"session_id"`
+	LanguageCode   LanguageCode          `json:"language_code"`
+	Confidence     Confidence            `json:"confidence"`
+	Alternatives   []LanguageAlternative `json:"alternatives,omitempty"`
+	FragmentCount  int                   `json:"fragment_count"`
+	CharacterCount int64                 `json:"character_count"`
+}
+
+// LanguageInfo describes a language the service can return
+type LanguageInfo struct {
+	LanguageCode LanguageCode `json:"language_code"`
+	EnglishName  string       `json:"english_name"`
+	NativeName   string       `json:"native_name"`
+	Script       string       `json:"script"`    // ISO 15924 script code
+	Direction    string       `json:"direction"` // "ltr" or "rtl"
+	Providers    []string     `json:"providers,omitempty"`
 }
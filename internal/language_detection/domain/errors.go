@@ -10,4 +10,14 @@ var (
 	ErrLowConfidence       = errors.New("language detection confidence too low")
 	ErrInvalidRequest      = errors.New("invalid request parameters")
 	ErrInternalError       = errors.New("internal language detection error")
+	ErrTooManySessions     = errors.New("too many open streaming sessions")
+	ErrJobNotFound         = errors.New("detection job not found")
+	ErrUnsupportedFormat   = errors.New("unsupported document format")
+	ErrMalformedDocument   = errors.New("malformed document")
+	ErrUnknownCharset      = errors.New("unknown character set")
+	ErrProviderUnavailable = errors.New("language detection provider unavailable")
+	ErrInvalidOptions      = errors.New("invalid detection options")
+	ErrQuotaExceeded       = errors.New("usage quota exceeded")
+	ErrUnauthenticated     = errors.New("unauthenticated")
+	ErrPermissionDenied    = errors.New("permission denied")
 )
@@ -42,6 +42,41 @@ func TestDomainErrors(t *testing.T) {
 			err:      ErrInternalError,
 			expected: "internal language detection error",
 		},
+		{
+			name:     "TooManySessions error",
+			err:      ErrTooManySessions,
+			expected: "too many open streaming sessions",
+		},
+		{
+			name:     "JobNotFound error",
+			err:      ErrJobNotFound,
+			expected: "detection job not found",
+		},
+		{
+			name:     "UnsupportedFormat error",
+			err:      ErrUnsupportedFormat,
+			expected: "unsupported document format",
+		},
+		{
+			name:     "MalformedDocument error",
+			err:      ErrMalformedDocument,
+			expected: "malformed document",
+		},
+		{
+			name:     "UnknownCharset error",
+			err:      ErrUnknownCharset,
+			expected: "unknown character set",
+		},
+		{
+			name:     "ProviderUnavailable error",
+			err:      ErrProviderUnavailable,
+			expected: "language detection provider unavailable",
+		},
+		{
+			name:     "InvalidOptions error",
+			err:      ErrInvalidOptions,
+			expected: "invalid detection options",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,66 @@
+package domain
+
+import "sort"
+
+// LanguageEvidence accumulates weighted detections into a combined result
+type LanguageEvidence struct {
+	weights map[LanguageCode]float64
+}
+
+// NewLanguageEvidence creates an empty evidence accumulator
+func NewLanguageEvidence() *LanguageEvidence {
+	return &LanguageEvidence{weights: make(map[LanguageCode]float64)}
+}
+
+// Add records a detection with the given weight, typically the length of its text.
+// The detected language and its alternatives contribute in proportion to their confidence.
+func (e *LanguageEvidence) Add(response *LanguageDetectionResponse, weight float64) {
+	if response == nil {
+		return
+	}
+	e.add(response.LanguageCode, float64(response.Confidence)*weight)
+	for _, alt := range response.Alternatives {
+		e.add(alt.LanguageCode, float64(alt.Confidence)*weight)
+	}
+}
+
+// Result returns the leading language, its share of the evidence and the
+// remaining languages in descending order. Without evidence it returns UnknownLanguage.
+func (e *LanguageEvidence) Result() (LanguageCode, Confidence, []LanguageAlternative) {
+	var total float64
+	for _, weight := range e.weights {
+		total += weight
+	}
+	if total == 0 {
+		return UnknownLanguage, 0, nil
+	}
+
+	languages := make([]LanguageCode, 0, len(e.weights))
+	for code := range e.weights {
+		languages = append(languages, code)
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		if e.weights[languages[i]] != e.weights[languages[j]] {
+			return e.weights[languages[i]] > e.weights[languages[j]]
+		}
+		return languages[i] < languages[j]
+	})
+
+	var alternatives []LanguageAlternative
+	for _, code := range languages[1:] {
+		alternatives = append(alternatives, LanguageAlternative{
+			LanguageCode: code,
+			Confidence:   Confidence(e.weights[code] / total),
+		})
+	}
+
+	return languages[0], Confidence(e.weights[languages[0]] / total), alternatives
+}
+
+// add records evidence for a language, ignoring undetermined results
+func (e *LanguageEvidence) add(code LanguageCode, weight float64) {
+	if code == "" || code == UnknownLanguage || weight <= 0 {
+		return
+	}
+	e.weights[code] += weight
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "testing"
+
+func TestLanguageEvidence_Result(t *testing.T) {
+	evidence := NewLanguageEvidence()
+
+	evidence.Add(&LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 1.0}, 300)
+	evidence.Add(&LanguageDetectionResponse{
+		LanguageCode: "fr-FR",
+		Confidence:   0.5,
+		Alternatives: []LanguageAlternative{{LanguageCode: "en-US", Confidence: 0.5}},
+	}, 100)
+	evidence.Add(&LanguageDetectionResponse{LanguageCode: "unknown", Confidence: 0.9}, 1000)
+	evidence.Add(nil, 1000)
+
+	code, confidence, alternatives := evidence.Result()
+
+	if code != "en-US" {
+		t.Errorf("Expected en-US, got %s", code)
+	}
+
+	// en-US carries 350 of 400 units of evidence
+	if confidence < 0.874 || confidence > 0.876 {
+		t.Errorf("Expected confidence 0.875, got %f", confidence)
+	}
+
+	if len(alternatives) != 1 || alternatives[0].LanguageCode != "fr-FR" {
+		t.Errorf("Expected fr-FR as only alternative, got %v", alternatives)
+	}
+}
+
+func TestLanguageEvidence_Empty(t *testing.T) {
+	code, confidence, alternatives := NewLanguageEvidence().Result()
+
+	if code != UnknownLanguage || confidence != 0 || alternatives != nil {
+		t.Errorf("Expected unknown result, got %s %f %v", code, confidence, alternatives)
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "sort"
+
+// MaxExplainedFeatures bounds the number of features listed per candidate language
+const MaxExplainedFeatures = 10
+
+// Feature kinds of a FeatureContribution
+const (
+	FeatureKindWord  = "word"
+	FeatureKindNgram = "ngram"
+)
+
+// Explanation describes the evidence behind a detection
+type Explanation struct {
+	Preprocessing []string            `json:"preprocessing,omitempty"` // steps applied to the text, in order
+	Candidates    []CandidateEvidence `json:"candidates,omitempty"`    // highest score first
+	RawOutput     string              `json:"raw_output,omitempty"`    // the provider's own output, usually JSON
+}
+
+// CandidateEvidence is the score of a candidate language and the features that contributed to it
+type CandidateEvidence struct {
+	LanguageCode LanguageCode          `json:"language_code"`
+	Score        float64               `json:"score"`
+	Features     []FeatureContribution `json:"features,omitempty"` // largest contribution first
+}
+
+// FeatureContribution is the part of a candidate's score contributed by a word or n-gram
+type FeatureContribution struct {
+	Feature      string  `json:"feature"`
+	Kind         string  `json:"kind"`
+	Count        int     `json:"count"`
+	Contribution float64 `json:"contribution"`
+}
+
+// SortCandidates orders candidates by descending score, and the features of
+// each candidate by descending contribution, keeping at most
+// MaxExplainedFeatures features per candidate. Ties are broken by name so
+// explanations are stable.
+func SortCandidates(candidates []CandidateEvidence) {
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].LanguageCode < candidates[j].LanguageCode
+	})
+
+	for i := range candidates {
+		features := candidates[i].Features
+		sort.Slice(features, func(a, b int) bool {
+			if features[a].Contribution != features[b].Contribution {
+				return features[a].Contribution > features[b].Contribution
+			}
+			return features[a].Feature < features[b].Feature
+		})
+		if len(features) > MaxExplainedFeatures {
+			candidates[i].Features = features[:MaxExplainedFeatures]
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSortCandidates(t *testing.T) {
+	var features []FeatureContribution
+	for i := 0; i < MaxExplainedFeatures+5; i++ {
+		features = append(features, FeatureContribution{
+			Feature:      fmt.Sprintf("w%02d", i),
+			Kind:         FeatureKindWord,
+			Count:        1,
+			Contribution: float64(i),
+		})
+	}
+
+	candidates := []CandidateEvidence{
+		{LanguageCode: "fr-FR", Score: 0.2},
+		{LanguageCode: "en-US", Score: 0.5, Features: features},
+		{LanguageCode: "de-DE", Score: 0.2},
+	}
+	SortCandidates(candidates)
+
+	order := []LanguageCode{"en-US", "de-DE", "fr-FR"}
+	for i, code := range order {
+		if candidates[i].LanguageCode != code {
+			t.Errorf("Expected candidate %d to be %s, got %s", i, code, candidates[i].LanguageCode)
+		}
+	}
+
+	if len(candidates[0].Features) != MaxExplainedFeatures {
+		t.Fatalf("Expected %d features, got %d", MaxExplainedFeatures, len(candidates[0].Features))
+	}
+
+	if candidates[0].Features[0].Feature != "w14" {
+		t.Errorf("Expected the largest contribution first, got %s", candidates[0].Features[0].Feature)
+	}
+}
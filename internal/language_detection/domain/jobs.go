@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// JobStatus represents the lifecycle state of a detection job
+type JobStatus string
+
+// Detection job states
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+)
+
+// Job represents an asynchronous detection job over a set of documents
+type Job struct {
+	ID                 string            `json:"id"`
+	TenantID           string            `json:"tenant_id,omitempty"`
+	Status             JobStatus         `json:"status"`
+	TotalDocuments     int               `json:"total_documents"`
+	ProcessedDocuments int               `json:"processed_documents"`
+	FailedDocuments    int               `json:"failed_documents"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	CreatedAt          time.Time         `json:"created_at"`
+	UpdatedAt          time.Time         `json:"updated_at"`
+	CompletedAt        time.Time         `json:"completed_at,omitempty"`
+}
+
+// Done reports whether every document of the job has a result
+func (j *Job) Done() bool {
+	return j.ProcessedDocuments >= j.TotalDocuments
+}
+
+// JobDocument is a document of a job together with its position in the submission
+type JobDocument struct {
+	Index   int                      `json:"index"`
+	Request LanguageDetectionRequest `json:"request"`
+}
+
+// JobResult holds the outcome of a single job document
+type JobResult struct {
+	Index      int                        `json:"index"`
+	DocumentID string                     `json:"document_id,omitempty"`
+	Response   *LanguageDetectionResponse `json:"response,omitempty"`
+	Error      string                     `json:"error,omitempty"`
+}
+
+// JobResultPage is a page of job results
+type JobResultPage struct {
+	Results       []JobResult `json:"results"`
+	NextPageToken string      `json:"next_page_token,omitempty"`
+}
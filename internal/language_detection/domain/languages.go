@@ -0,0 +1,85 @@
+package domain
+
+import "strings"
+
+// Writing directions reported in LanguageInfo
+const (
+	DirectionLTR = "ltr"
+	DirectionRTL = "rtl"
+)
+
+// UnknownLanguage is returned when no language could be determined
+const UnknownLanguage LanguageCode = "unknown"
+
+// languageReference holds reference data keyed by lowercase language code.
+// Entries keyed by a bare language subtag cover all regional variants.
+var languageReference = map[string]LanguageInfo{
+	"af":    {EnglishName: "Afrikaans", NativeName: "Afrikaans", Script: "Latn", Direction: DirectionLTR},
+	"am":    {EnglishName: "Amharic", NativeName: "አማርኛ", Script: "Ethi", Direction: DirectionLTR},
+	"ar":    {EnglishName: "Arabic", NativeName: "العربية", Script: "Arab", Direction: DirectionRTL},
+	"bg":    {EnglishName: "Bulgarian", NativeName: "Български", Script: "Cyrl", Direction: DirectionLTR},
+	"bn":    {EnglishName: "Bengali", NativeName: "বাংলা", Script: "Beng", Direction: DirectionLTR},
+	"cs":    {EnglishName: "Czech", NativeName: "Čeština", Script: "Latn", Direction: DirectionLTR},
+	"da":    {EnglishName: "Danish", NativeName: "Dansk", Script: "Latn", Direction: DirectionLTR},
+	"de":    {EnglishName: "German", NativeName: "Deutsch", Script: "Latn", Direction: DirectionLTR},
+	"el":    {EnglishName: "Greek", NativeName: "Ελληνικά", Script: "Grek", Direction: DirectionLTR},
+	"en":    {EnglishName: "English", NativeName: "English", Script: "Latn", Direction: DirectionLTR},
+	"es":    {EnglishName: "Spanish", NativeName: "Español", Script: "Latn", Direction: DirectionLTR},
+	"fa":    {EnglishName: "Persian", NativeName: "فارسی", Script: "Arab", Direction: DirectionRTL},
+	"fi":    {EnglishName: "Finnish", NativeName: "Suomi", Script: "Latn", Direction: DirectionLTR},
+	"fr":    {EnglishName: "French", NativeName: "Français", Script: "Latn", Direction: DirectionLTR},
+	"he":    {EnglishName: "Hebrew", NativeName: "עברית", Script: "Hebr", Direction: DirectionRTL},
+	"hi":    {EnglishName: "Hindi", NativeName: "हिन्दी", Script: "Deva", Direction: DirectionLTR},
+	"hu":    {EnglishName: "Hungarian", NativeName: "Magyar", Script: "Latn", Direction: DirectionLTR},
+	"hy":    {EnglishName: "Armenian", NativeName: "Հայերեն", Script: "Armn", Direction: DirectionLTR},
+	"id":    {EnglishName: "Indonesian", NativeName: "Bahasa Indonesia", Script: "Latn", Direction: DirectionLTR},
+	"it":    {EnglishName: "Italian", NativeName: "Italiano", Script: "Latn", Direction: DirectionLTR},
+	"ja":    {EnglishName: "Japanese", NativeName: "日本語", Script: "Jpan", Direction: DirectionLTR},
+	"ka":    {EnglishName: "Georgian", NativeName: "ქართული", Script: "Geor", Direction: DirectionLTR},
+	"ko":    {EnglishName: "Korean", NativeName: "한국어", Script: "Kore", Direction: DirectionLTR},
+	"nl":    {EnglishName: "Dutch", NativeName: "Nederlands", Script: "Latn", Direction: DirectionLTR},
+	"no":    {EnglishName: "Norwegian", NativeName: "Norsk", Script: "Latn", Direction: DirectionLTR},
+	"pl":    {EnglishName: "Polish", NativeName: "Polski", Script: "Latn", Direction: DirectionLTR},
+	"pt":    {EnglishName: "Portuguese", NativeName: "Português", Script: "Latn", Direction: DirectionLTR},
+	"ro":    {EnglishName: "Romanian", NativeName: "Română", Script: "Latn", Direction: DirectionLTR},
+	"ru":    {EnglishName: "Russian", NativeName: "Русский", Script: "Cyrl", Direction: DirectionLTR},
+	"sv":    {EnglishName: "Swedish", NativeName: "Svenska", Script: "Latn", Direction: DirectionLTR},
+	"th":    {EnglishName: "Thai", NativeName: "ไทย", Script: "Thai", Direction: DirectionLTR},
+	"tr":    {EnglishName: "Turkish", NativeName: "Türkçe", Script: "Latn", Direction: DirectionLTR},
+	"uk":    {EnglishName: "Ukrainian", NativeName: "Українська", Script: "Cyrl", Direction: DirectionLTR},
+	"ur":    {EnglishName: "Urdu", NativeName: "اردو", Script: "Arab", Direction: DirectionRTL},
+	"vi":    {EnglishName: "Vietnamese", NativeName: "Tiếng Việt", Script: "Latn", Direction: DirectionLTR},
+	"zh":    {EnglishName: "Chinese", NativeName: "中文", Script: "Hans", Direction: DirectionLTR},
+	"zh-cn": {EnglishName: "Chinese (Simplified)", NativeName: "简体中文", Script: "Hans", Direction: DirectionLTR},
+	"zh-tw": {EnglishName: "Chinese (Traditional)", NativeName: "繁體中文", Script: "Hant", Direction: DirectionLTR},
+}
+
+// LookupLanguageInfo returns reference information for a language code.
+// Regional codes fall back to their base language, e.g. "pt-BR" to "pt".
+func LookupLanguageInfo(code LanguageCode) (LanguageInfo, bool) {
+	key := strings.ToLower(string(code))
+
+	info, ok := languageReference[key]
+	if !ok {
+		base, _, _ := strings.Cut(key, "-")
+		info, ok = languageReference[base]
+	}
+	if !ok {
+		return LanguageInfo{LanguageCode: code}, false
+	}
+
+	info.LanguageCode = code
+	return info, true
+}
+
+// BaseLanguage returns the lowercase primary subtag of a code, e.g. "pt" for "pt-BR"
+func BaseLanguage(code LanguageCode) string {
+	base, _, _ := strings.Cut(strings.ToLower(string(code)), "-")
+	base, _, _ = strings.Cut(base, "_")
+	return base
+}
+
+// SameLanguage reports whether two codes name the same language, ignoring region
+func SameLanguage(a, b LanguageCode) bool {
+	return BaseLanguage(a) != "" && BaseLanguage(a) == BaseLanguage(b)
+}
@@ -0,0 +1,72 @@
+package domain
+
+import "testing"
+
+func TestLookupLanguageInfo(t *testing.T) {
+	tests := []struct {
+		name              string
+		code              LanguageCode
+		expectedFound     bool
+		expectedName      string
+		expectedScript    string
+		expectedDirection string
+	}{
+		{"Exact regional entry", "zh-TW", true, "Chinese (Traditional)", "Hant", DirectionLTR},
+		{"Regional fallback to base", "en-US", true, "English", "Latn", DirectionLTR},
+		{"Other region of same base", "pt-BR", true, "Portuguese", "Latn", DirectionLTR},
+		{"Right-to-left language", "ar-SA", true, "Arabic", "Arab", DirectionRTL},
+		{"Bare language code", "ja", true, "Japanese", "Jpan", DirectionLTR},
+		{"Case insensitive", "DE-de", true, "German", "Latn", DirectionLTR},
+		{"Unknown language", "xx-YY", false, "", "", ""},
+		{"Undetermined marker", UnknownLanguage, false, "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, found := LookupLanguageInfo(tt.code)
+
+			if found != tt.expectedFound {
+				t.Fatalf("LookupLanguageInfo(%s) found = %v, want %v", tt.code, found, tt.expectedFound)
+			}
+
+			if info.LanguageCode != tt.code {
+				t.Errorf("Expected language code %s, got %s", tt.code, info.LanguageCode)
+			}
+
+			if info.EnglishName != tt.expectedName {
+				t.Errorf("Expected English name %q, got %q", tt.expectedName, info.EnglishName)
+			}
+
+			if info.Script != tt.expectedScript {
+				t.Errorf("Expected script %q, got %q", tt.expectedScript, info.Script)
+			}
+
+			if info.Direction != tt.expectedDirection {
+				t.Errorf("Expected direction %q, got %q", tt.expectedDirection, info.Direction)
+			}
+
+			if found && info.NativeName == "" {
+				t.Error("Expected native name, got empty string")
+			}
+		})
+	}
+}
+
+func TestSameLanguage(t *testing.T) {
+	tests := []struct {
+		a, b     LanguageCode
+		expected bool
+	}{
+		{"en-US", "en-GB", true},
+		{"en", "EN-us", true},
+		{"pt_BR", "pt-PT", true},
+		{"en-US", "es-ES", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := SameLanguage(tt.a, tt.b); got != tt.expected {
+			t.Errorf("SameLanguage(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
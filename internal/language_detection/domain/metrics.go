@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// NopMetrics discards every observation
+type NopMetrics struct{}
+
+func (NopMetrics) RequestHandled(transport, method, code string) {}
+
+func (NopMetrics) DetectionCompleted(provider string, language LanguageCode, confidence Confidence, textLength int) {
+}
+
+func (NopMetrics) ProviderCalled(provider string, duration time.Duration, err error) {}
+
+func (NopMetrics) FailedOver(from, to string) {}
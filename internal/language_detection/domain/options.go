@@ -0,0 +1,31 @@
+package domain
+
+import "sort"
+
+// DetectionOptions tune a single detection. The zero value uses the service defaults.
+type DetectionOptions struct {
+	MaxAlternatives     int        `json:"max_alternatives,omitempty"`      // 0 returns every alternative
+	MinAlternativeScore Confidence `json:"min_alternative_score,omitempty"` // drop weaker alternatives
+	MinConfidence       Confidence `json:"min_confidence,omitempty"`        // 0 uses the configured threshold
+	Provider            string     `json:"provider,omitempty"`              // preferred provider, empty uses the default
+	TimeoutMs           int64      `json:"timeout_ms,omitempty"`            // 0 applies no extra deadline
+}
+
+// FilterAlternatives keeps the alternatives scoring at least MinAlternativeScore,
+// strongest first, and at most MaxAlternatives of them
+func (o DetectionOptions) FilterAlternatives(alternatives []LanguageAlternative) []LanguageAlternative {
+	var kept []LanguageAlternative
+	for _, alt := range alternatives {
+		if alt.Confidence >= o.MinAlternativeScore {
+			kept = append(kept, alt)
+		}
+	}
+
+	sort.SliceStable(kept, func(i, j int) bool {
+		return kept[i].Confidence > kept[j].Confidence
+	})
+	if o.MaxAlternatives > 0 && len(kept) > o.MaxAlternatives {
+		kept = kept[:o.MaxAlternatives]
+	}
+	return kept
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "testing"
+
+func TestDetectionOptions_FilterAlternatives(t *testing.T) {
+	alternatives := []LanguageAlternative{
+		{LanguageCode: "it-IT", Confidence: 0.1},
+		{LanguageCode: "pt-PT", Confidence: 0.3},
+		{LanguageCode: "fr-FR", Confidence: 0.2},
+		{LanguageCode: "de-DE", Confidence: 0.05},
+	}
+
+	tests := []struct {
+		name     string
+		options  DetectionOptions
+		expected []LanguageCode
+	}{
+		{"Defaults keep all, strongest first", DetectionOptions{}, []LanguageCode{"pt-PT", "fr-FR", "it-IT", "de-DE"}},
+		{"Top two", DetectionOptions{MaxAlternatives: 2}, []LanguageCode{"pt-PT", "fr-FR"}},
+		{"Minimum score", DetectionOptions{MinAlternativeScore: 0.1}, []LanguageCode{"pt-PT", "fr-FR", "it-IT"}},
+		{"Both", DetectionOptions{MaxAlternatives: 1, MinAlternativeScore: 0.5}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.options.FilterAlternatives(alternatives)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Expected %d alternatives, got %v", len(tt.expected), got)
+			}
+			for i, code := range tt.expected {
+				if got[i].LanguageCode != code {
+					t.Errorf("Expected alternative %d to be %s, got %s", i, code, got[i].LanguageCode)
+				}
+			}
+		})
+	}
+
+	if alternatives[0].LanguageCode != "it-IT" {
+		t.Error("Expected the input alternatives to be left unchanged")
+	}
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // LanguageDetector defines the port for language detection services
 type LanguageDetector interface {
@@ -8,6 +11,19 @@ type LanguageDetector interface {
 	DetectLanguage(ctx context.Context, text Text) (*LanguageDetectionResponse, error)
 }
 
+// ExplainingDetector is implemented by detectors that can report the evidence behind a detection
+type ExplainingDetector interface {
+	// ExplainLanguage detects the language like DetectLanguage and attaches an Explanation
+	ExplainLanguage(ctx context.Context, text Text) (*LanguageDetectionResponse, error)
+}
+
+// BatchDetector is implemented by detectors that can detect several texts in one provider call
+type BatchDetector interface {
+	// DetectLanguages returns one result per text, in order. The error is set
+	// only when the whole call failed.
+	DetectLanguages(ctx context.Context, texts []Text) ([]BatchResult, error)
+}
+
 // LanguageDetectionService defines the application service port
 type LanguageDetectionService interface {
 	// DetectLanguage performs language detection with business logic
@@ -30,4 +46,196 @@ type ConfigProvider interface {
 	
 	// GetModelVersion returns the model version
 	GetModelVersion() string
+
+	// GetMaxAlternatives returns the most alternatives a request may ask for, 0 for no limit
+	GetMaxAlternatives() int
+
+	// GetMinConfidenceFloor returns the lowest confidence threshold a request may ask for
+	GetMinConfidenceFloor() float32
+
+	// GetMaxRequestTimeout returns the longest timeout a request may ask for, 0 for no limit
+	GetMaxRequestTimeout() time.Duration
+}
+
+// ConfigResolver returns the configuration of a tenant
+type ConfigResolver interface {
+	// ConfigFor returns the configuration of a tenant, or the default one for unknown tenants
+	ConfigFor(tenantID string) ConfigProvider
+}
+
+// DetectionProfile is implemented by tenant configurations that also choose
+// the provider and rename the detected languages
+type DetectionProfile interface {
+	ConfigProvider
+
+	// GetPreferredProvider returns the provider used when a request names none, or ""
+	GetPreferredProvider() string
+
+	// MapLanguageCode returns the code the tenant uses for a detected language
+	MapLanguageCode(code LanguageCode) LanguageCode
+}
+
+// Metrics records what the service is doing. Implementations must be safe for
+// concurrent use.
+type Metrics interface {
+	// RequestHandled counts a finished request by transport, method and status code
+	RequestHandled(transport, method, code string)
+
+	// DetectionCompleted records the result of a detection, whether it came from a provider or the cache
+	DetectionCompleted(provider string, language LanguageCode, confidence Confidence, textLength int)
+
+	// ProviderCalled records one call to a detection provider
+	ProviderCalled(provider string, duration time.Duration, err error)
+
+	// FailedOver counts a detection moving from an unavailable provider to the next one
+	FailedOver(from, to string)
+}
+
+// ResultCache stores detector results by CacheKey
+type ResultCache interface {
+	// Get returns the cached response of a key, if it is present and fresh
+	Get(ctx context.Context, key string) (*LanguageDetectionResponse, bool)
+
+	// Set stores the response of a key
+	Set(ctx context.Context, key string, response *LanguageDetectionResponse)
+}
+
+// CacheStatsReporter is implemented by caches that count their hits and misses
+type CacheStatsReporter interface {
+	// CacheStats returns the counters accumulated since the cache was created
+	CacheStats() CacheStats
+}
+
+// CoalescingReporter is implemented by services that share one detection between identical concurrent requests
+type CoalescingReporter interface {
+	// CoalescedRequests returns how many requests have joined a detection already in flight
+	CoalescedRequests() uint64
+}
+
+// LanguageProvider is implemented by detectors that can report which languages they produce
+type LanguageProvider interface {
+	// ProviderName returns the name reported in ProcessingMetadata.Provider
+	ProviderName() string
+
+	// ProducibleLanguages returns the language codes the detector can return
+	ProducibleLanguages() []LanguageCode
+}
+
+// LanguageCatalogService defines the port for querying the supported languages
+type LanguageCatalogService interface {
+	// ListSupportedLanguages returns the languages the service can return
+	ListSupportedLanguages(ctx context.Context) ([]LanguageInfo, error)
+
+	// GetLanguageInfo returns the description of a single supported language
+	GetLanguageInfo(ctx context.Context, code LanguageCode) (*LanguageInfo, error)
+}
+
+// JobStore defines the port for persisting detection jobs
+type JobStore interface {
+	// CreateJob stores a new job together with its documents
+	CreateJob(ctx context.Context, job *Job, documents []LanguageDetectionRequest) error
+
+	// GetJob returns a job by ID
+	GetJob(ctx context.Context, id string) (*Job, error)
+
+	// UpdateJobStatus changes the status of a job
+	UpdateJobStatus(ctx context.Context, id string, status JobStatus) error
+
+	// ListUnfinishedJobs returns the jobs that are not completed, oldest first
+	ListUnfinishedJobs(ctx context.Context) ([]*Job, error)
+
+	// PendingDocuments returns up to limit documents without a result, starting at index from
+	PendingDocuments(ctx context.Context, id string, from int, limit int) ([]JobDocument, error)
+
+	// SaveResult records the result of a document and updates the job counters
+	SaveResult(ctx context.Context, id string, result JobResult) error
+
+	// ListResults returns a page of results ordered by document index
+	ListResults(ctx context.Context, id string, pageToken string, pageSize int) (*JobResultPage, error)
+}
+
+// JobService defines the port for asynchronous detection jobs
+type JobService interface {
+	// SubmitJob creates a job for the given documents and queues it for processing
+	SubmitJob(ctx context.Context, documents []LanguageDetectionRequest, metadata map[string]string) (*Job, error)
+
+	// GetJob returns the current state of a job
+	GetJob(ctx context.Context, id string) (*Job, error)
+
+	// GetJobResults returns a page of job results
+	GetJobResults(ctx context.Context, id string, pageToken string, pageSize int) (*JobResultPage, error)
+}
+
+// UsageLedger defines the port for persisting tenant usage
+type UsageLedger interface {
+	// RecordUsage adds usage to the daily and monthly totals of a tenant at the given time
+	RecordUsage(ctx context.Context, tenantID string, at time.Time, usage Usage) error
+
+	// GetUsage returns the usage of a tenant over a period, zero if there is none
+	GetUsage(ctx context.Context, tenantID string, period string) (Usage, error)
+
+	// ListUsage returns the usage of every tenant over a period, ordered by tenant
+	ListUsage(ctx context.Context, period string) ([]TenantUsage, error)
+}
+
+// UsageService defines the port for enforcing quotas and reporting usage
+type UsageService interface {
+	// CheckQuota returns ErrQuotaExceeded if the tenant cannot afford the usage
+	CheckQuota(ctx context.Context, tenantID string, usage Usage) error
+
+	// RecordUsage accounts for a completed detection
+	RecordUsage(ctx context.Context, tenantID string, usage Usage) error
+
+	// GetUsageReport returns the usage over a period of one tenant, or of all tenants if tenantID is empty
+	GetUsageReport(ctx context.Context, period string, tenantID string) ([]TenantUsage, error)
+}
+
+// TextExtractor defines the port for extracting text sections from document files
+type TextExtractor interface {
+	// Extract returns the non-empty text sections of a document in reading order
+	Extract(ctx context.Context, content []byte, mimeType string) ([]DocumentSection, error)
+
+	// SupportedMimeTypes returns the MIME types the extractor understands
+	SupportedMimeTypes() []string
+}
+
+// DocumentDetectionService defines the port for detecting the language of document files
+type DocumentDetectionService interface {
+	// DetectDocumentLanguage detects the language of each section and of the document as a whole
+	DetectDocumentLanguage(ctx context.Context, request *DocumentDetectionRequest) (*DocumentDetectionResponse, error)
+}
+
+// SubtitleParser defines the port for reading cues from subtitle files
+type SubtitleParser interface {
+	// Parse returns the cues of a subtitle file in file order and the format it was read as.
+	// An empty format is inferred from the content.
+	Parse(content []byte, format SubtitleFormat) ([]SubtitleCue, SubtitleFormat, error)
+}
+
+// SubtitleDetectionService defines the port for detecting the language of subtitle tracks
+type SubtitleDetectionService interface {
+	// DetectSubtitleLanguage detects the dominant language of a track and the cues that disagree with it
+	DetectSubtitleLanguage(ctx context.Context, request *SubtitleDetectionRequest) (*SubtitleDetectionResponse, error)
+}
+
+// CharsetDecoder defines the port for detecting character sets and transcoding to UTF-8
+type CharsetDecoder interface {
+	// DetectCharsets returns the plausible charsets of content, most likely first
+	DetectCharsets(content []byte) []CharsetCandidate
+
+	// Decode transcodes content from the named charset to UTF-8
+	Decode(content []byte, charset string) (Text, error)
+}
+
+// RawTextDetectionService defines the port for detecting the charset and language of raw bytes
+type RawTextDetectionService interface {
+	// DetectRawLanguage decodes raw text to UTF-8 and detects its language
+	DetectRawLanguage(ctx context.Context, request *RawTextDetectionRequest) (*RawTextDetectionResponse, error)
+}
+
+// AuditSink defines the port for recording every detection for compliance.
+// Implementations must not block the caller.
+type AuditSink interface {
+	// RecordDetection queues the record of a finished detection
+	RecordDetection(ctx context.Context, record AuditRecord)
 }
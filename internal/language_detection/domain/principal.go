@@ -0,0 +1,36 @@
+package domain
+
+import "context"
+
+// Authentication methods of a principal
+const (
+	AuthMethodAPIKey     = "api_key"
+	AuthMethodJWT        = "jwt"
+	AuthMethodClientCert = "client_certificate"
+)
+
+// ScopeAdmin is the token scope that makes a caller an admin
+const ScopeAdmin = "admin"
+
+// Principal is an authenticated caller. Admins may act across tenants.
+type Principal struct {
+	ID       string `json:"id"`
+	TenantID string `json:"tenant_id"`
+	Method   string `json:"method"`
+	Admin    bool   `json:"admin,omitempty"`
+}
+
+// principalKey is the context key of the principal
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the authenticated caller and its tenant
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	ctx = context.WithValue(ctx, principalKey{}, principal)
+	return WithTenant(ctx, principal.TenantID)
+}
+
+// PrincipalFromContext returns the authenticated caller of a request, if any
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWithPrincipal(t *testing.T) {
+	if _, ok := PrincipalFromContext(context.Background()); ok {
+		t.Error("Expected no principal in an empty context")
+	}
+
+	principal := Principal{ID: "billing", TenantID: "acme", Method: AuthMethodAPIKey}
+	ctx := WithPrincipal(context.Background(), principal)
+
+	got, ok := PrincipalFromContext(ctx)
+	if !ok || got != principal {
+		t.Errorf("Expected principal %+v, got %+v", principal, got)
+	}
+	if tenant := TenantFromContext(ctx); tenant != "acme" {
+		t.Errorf("Expected the principal's tenant acme, got %s", tenant)
+	}
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// maxRequestIDLength bounds the request IDs accepted from callers
+const maxRequestIDLength = 128
+
+// requestIDKey is the context key of the request ID
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the ID of the request
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the ID of a request, or "" if it has none
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// ResolveRequestID returns the request ID sent by a caller when it is short
+// and printable ASCII, or a new random ID otherwise
+func ResolveRequestID(sent string) string {
+	if sent != "" && len(sent) <= maxRequestIDLength {
+		valid := true
+		for i := 0; i < len(sent); i++ {
+			if sent[i] < 0x21 || sent[i] > 0x7e {
+				valid = false
+				break
+			}
+		}
+		if valid {
+			return sent
+		}
+	}
+
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestWithRequestID(t *testing.T) {
+	if id := RequestIDFromContext(context.Background()); id != "" {
+		t.Errorf("Expected no request ID in an empty context, got %s", id)
+	}
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	if id := RequestIDFromContext(ctx); id != "req-1" {
+		t.Errorf("Expected request ID req-1, got %s", id)
+	}
+}
+
+func TestResolveRequestID(t *testing.T) {
+	if id := ResolveRequestID("req-1"); id != "req-1" {
+		t.Errorf("Expected the sent request ID req-1, got %s", id)
+	}
+
+	for _, sent := range []string{"", "req 1", "req-1\n{\"level\":\"ERROR\"}", strings.Repeat("a", maxRequestIDLength+1)} {
+		id := ResolveRequestID(sent)
+		if id == sent || len(id) != 32 {
+			t.Errorf("Expected a new 32 character request ID for %q, got %q", sent, id)
+		}
+	}
+
+	if ResolveRequestID("") == ResolveRequestID("") {
+		t.Error("Expected new request IDs to differ")
+	}
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// sessionFirmnessChars controls how quickly a session estimate firms up.
+// With this many characters observed the estimate keeps half of its raw share.
+const sessionFirmnessChars = 100
+
+// SessionTracker accumulates the detections of streaming sessions into
+// running language estimates
+type SessionTracker struct {
+	mu          sync.Mutex
+	sessions    map[string]*sessionState
+	maxSessions int
+}
+
+// sessionState holds the accumulated evidence of a single session
+type sessionState struct {
+	evidence  *LanguageEvidence
+	fragments int
+	chars     int64
+}
+
+// NewSessionTracker creates a tracker holding at most maxSessions open sessions
+func NewSessionTracker(maxSessions int) *SessionTracker {
+	return &SessionTracker{
+		sessions:    make(map[string]*sessionState),
+		maxSessions: maxSessions,
+	}
+}
+
+// Observe folds a fragment detection into its session and returns the updated estimate
+func (t *SessionTracker) Observe(sessionID string, text Text, response *LanguageDetectionResponse) (*SessionEstimate, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.sessions[sessionID]
+	if !ok {
+		if t.maxSessions > 0 && len(t.sessions) >= t.maxSessions {
+			return nil, ErrTooManySessions
+		}
+		state = &sessionState{evidence: NewLanguageEvidence()}
+		t.sessions[sessionID] = state
+	}
+
+	length := utf8.RuneCountInString(string(text))
+	state.fragments++
+	state.chars += int64(length)
+
+	// Longer fragments carry more evidence than short ones
+	state.evidence.Add(response, float64(length))
+
+	return state.estimate(sessionID), nil
+}
+
+// Estimate returns the current estimate of a session
+func (t *SessionTracker) Estimate(sessionID string) (*SessionEstimate, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.sessions[sessionID]
+	if !ok {
+		return nil, false
+	}
+	return state.estimate(sessionID), true
+}
+
+// Close releases the state of a session
+func (t *SessionTracker) Close(sessionID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.sessions, sessionID)
+}
+
+// Len returns the number of open sessions
+func (t *SessionTracker) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.sessions)
+}
+
+// estimate converts the accumulated evidence into a session estimate
+func (s *sessionState) estimate(sessionID string) *SessionEstimate {
+	code, share, alternatives := s.evidence.Result()
+
+	// The share of the evidence is damped while little text has been seen
+	firmness := Confidence(float64(s.chars) / float64(s.chars+sessionFirmnessChars))
+	for i := range alternatives {
+		alternatives[i].Confidence *= firmness
+	}
+
+	return &SessionEstimate{
+		SessionID:      sessionID,
+		LanguageCode:   code,
+		Confidence:     share * firmness,
+		Alternatives:   alternatives,
+		FragmentCount:  s.fragments,
+		CharacterCount: s.chars,
+	}
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSessionTracker_Observe(t *testing.T) {
+	tracker := NewSessionTracker(10)
+
+	first, err := tracker.Observe("session-1", "Hello there", &LanguageDetectionResponse{
+		LanguageCode: "en-US",
+		Confidence:   0.9,
+		Alternatives: []LanguageAlternative{
+			{LanguageCode: "de-DE", Confidence: 0.1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Observe() error = %v, want nil", err)
+	}
+
+	if first.LanguageCode != "en-US" {
+		t.Errorf("Expected language 'en-US', got %s", first.LanguageCode)
+	}
+
+	if first.FragmentCount != 1 {
+		t.Errorf("Expected fragment count 1, got %d", first.FragmentCount)
+	}
+
+	if first.CharacterCount != 11 {
+		t.Errorf("Expected character count 11, got %d", first.CharacterCount)
+	}
+
+	if len(first.Alternatives) != 1 || first.Alternatives[0].LanguageCode != "de-DE" {
+		t.Errorf("Expected alternative 'de-DE', got %v", first.Alternatives)
+	}
+
+	second, err := tracker.Observe("session-1", "How are you doing today, my friend?", &LanguageDetectionResponse{
+		LanguageCode: "en-US",
+		Confidence:   0.95,
+	})
+	if err != nil {
+		t.Fatalf("Observe() error = %v, want nil", err)
+	}
+
+	if second.Confidence <= first.Confidence {
+		t.Errorf("Expected confidence to firm up, got %.3f after %.3f", second.Confidence, first.Confidence)
+	}
+
+	if second.Confidence >= 1 {
+		t.Errorf("Expected confidence below 1, got %.3f", second.Confidence)
+	}
+}
+
+func TestSessionTracker_MajorityWins(t *testing.T) {
+	tracker := NewSessionTracker(10)
+
+	observations := []struct {
+		text string
+		lang LanguageCode
+	}{
+		{"Bonjour tout le monde", "fr-FR"},
+		{"Hello", "en-US"},
+		{"Merci beaucoup pour votre attention", "fr-FR"},
+	}
+
+	var estimate *SessionEstimate
+	for _, obs := range observations {
+		var err error
+		estimate, err = tracker.Observe("s", Text(obs.text), &LanguageDetectionResponse{
+			LanguageCode: obs.lang,
+			Confidence:   0.9,
+		})
+		if err != nil {
+			t.Fatalf("Observe() error = %v, want nil", err)
+		}
+	}
+
+	if estimate.LanguageCode != "fr-FR" {
+		t.Errorf("Expected language 'fr-FR', got %s", estimate.LanguageCode)
+	}
+}
+
+func TestSessionTracker_UnknownOnly(t *testing.T) {
+	tracker := NewSessionTracker(10)
+
+	estimate, err := tracker.Observe("s", "??", &LanguageDetectionResponse{
+		LanguageCode: "unknown",
+		Confidence:   0,
+	})
+	if err != nil {
+		t.Fatalf("Observe() error = %v, want nil", err)
+	}
+
+	if estimate.LanguageCode != "unknown" {
+		t.Errorf("Expected language 'unknown', got %s", estimate.LanguageCode)
+	}
+
+	if estimate.Confidence != 0 {
+		t.Errorf("Expected confidence 0, got %.3f", estimate.Confidence)
+	}
+}
+
+func TestSessionTracker_SessionsAreIsolated(t *testing.T) {
+	tracker := NewSessionTracker(10)
+
+	tracker.Observe("a", "Hola a todos", &LanguageDetectionResponse{LanguageCode: "es-ES", Confidence: 0.9})
+	tracker.Observe("b", "Guten Morgen", &LanguageDetectionResponse{LanguageCode: "de-DE", Confidence: 0.9})
+
+	a, ok := tracker.Estimate("a")
+	if !ok {
+		t.Fatal("Expected session 'a' to exist")
+	}
+	if a.LanguageCode != "es-ES" {
+		t.Errorf("Expected session 'a' language 'es-ES', got %s", a.LanguageCode)
+	}
+
+	b, ok := tracker.Estimate("b")
+	if !ok {
+		t.Fatal("Expected session 'b' to exist")
+	}
+	if b.LanguageCode != "de-DE" {
+		t.Errorf("Expected session 'b' language 'de-DE', got %s", b.LanguageCode)
+	}
+}
+
+func TestSessionTracker_Close(t *testing.T) {
+	tracker := NewSessionTracker(10)
+
+	tracker.Observe("s", "Hello world", &LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.9})
+	tracker.Close("s")
+
+	if _, ok := tracker.Estimate("s"); ok {
+		t.Error("Expected session to be released after Close")
+	}
+
+	if tracker.Len() != 0 {
+		t.Errorf("Expected no open sessions, got %d", tracker.Len())
+	}
+}
+
+func TestSessionTracker_MaxSessions(t *testing.T) {
+	tracker := NewSessionTracker(1)
+
+	if _, err := tracker.Observe("a", "Hello", nil); err != nil {
+		t.Fatalf("Observe() error = %v, want nil", err)
+	}
+
+	// Existing sessions keep working at the limit
+	if _, err := tracker.Observe("a", "world", nil); err != nil {
+		t.Fatalf("Observe() error = %v, want nil", err)
+	}
+
+	_, err := tracker.Observe("b", "Hello", nil)
+	if !errors.Is(err, ErrTooManySessions) {
+		t.Errorf("Expected ErrTooManySessions, got %v", err)
+	}
+}
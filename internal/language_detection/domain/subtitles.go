@@ -0,0 +1,61 @@
+package domain
+
+import "time"
+
+// SubtitleFormat identifies a subtitle file format
+type SubtitleFormat string
+
+// Supported subtitle formats
+const (
+	SubtitleFormatSRT    SubtitleFormat = "srt"
+	SubtitleFormatWebVTT SubtitleFormat = "vtt"
+)
+
+// SubtitleCue is a single timed piece of subtitle text
+type SubtitleCue struct {
+	Index int           `json:"index"`
+	Start time.Duration `json:"start"`
+	End   time.Duration `json:"end"`
+	Text  Text          `json:"text"`
+}
+
+// SubtitleDetectionRequest represents a request to detect the language of a subtitle track.
+// An empty Format is inferred from the content. DeclaredLanguage, when set, is
+// checked against the detected track language.
+type SubtitleDetectionRequest struct {
+	Content          []byte            `json:"content"`
+	Format           SubtitleFormat    `json:"format,omitempty"`
+	TrackID          string            `json:"track_id,omitempty"`
+	DeclaredLanguage LanguageCode      `json:"declared_language,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+}
+
+// SubtitleDetectionResponse represents the dominant language of a subtitle track
+// and the cues that disagree with it
+type SubtitleDetectionResponse struct {
+	TrackID          string                `json:"track_id,omitempty"`
+	Format           SubtitleFormat        `json:"format"`
+	LanguageCode     LanguageCode          `json:"language_code"`
+	Confidence       Confidence            `json:"confidence"`
+	Alternatives     []LanguageAlternative `json:"alternatives,omitempty"`
+	CueCount         int                   `json:"cue_count"`
+	DetectedCueCount int                   `json:"detected_cue_count"`
+	DeclaredLanguage LanguageCode          `json:"declared_language,omitempty"`
+	DeclaredMatches  bool                  `json:"declared_matches"`
+	DisagreeingCues  []CueDetection        `json:"disagreeing_cues,omitempty"`
+	Metadata         ProcessingMetadata    `json:"metadata"`
+}
+
+// CueDetection represents the detected language of a single cue. LanguageCode is
+// the detection of the cue alone; SmoothedLanguageCode also takes the
+// neighbouring cues into account.
+type CueDetection struct {
+	Index                int           `json:"index"`
+	Start                time.Duration `json:"start"`
+	End                  time.Duration `json:"end"`
+	Text                 Text          `json:"text"`
+	LanguageCode         LanguageCode  `json:"language_code"`
+	Confidence           Confidence    `json:"confidence"`
+	SmoothedLanguageCode LanguageCode  `json:"smoothed_language_code"`
+	SmoothedConfidence   Confidence    `json:"smoothed_confidence"`
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultTenant is the tenant of requests that do not name one
+	DefaultTenant = "default"
+
+	// BillingUnitCharacters is the size of the units AWS Comprehend bills in
+	BillingUnitCharacters = 100
+
+	// MinBillingUnits is the least AWS Comprehend bills for a request
+	MinBillingUnits = 3
+)
+
+// Usage periods are written as a day (2006-01-02) or a month (2006-01)
+const (
+	dayLayout   = "2006-01-02"
+	monthLayout = "2006-01"
+)
+
+// Usage counts the detections of a tenant over a period
+type Usage struct {
+	Documents    int64 `json:"documents"`
+	Characters   int64 `json:"characters"`
+	BillingUnits int64 `json:"billing_units"`
+}
+
+// Add returns the sum of two usages
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		Documents:    u.Documents + other.Documents,
+		Characters:   u.Characters + other.Characters,
+		BillingUnits: u.BillingUnits + other.BillingUnits,
+	}
+}
+
+// TextUsage returns the usage of detecting a single text
+func TextUsage(text Text) Usage {
+	characters := int64(utf8.RuneCountInString(string(text)))
+	units := (characters + BillingUnitCharacters - 1) / BillingUnitCharacters
+	if units < MinBillingUnits {
+		units = MinBillingUnits
+	}
+	return Usage{Documents: 1, Characters: characters, BillingUnits: units}
+}
+
+// Quota limits the usage of a tenant per day and per month. Zero fields are unlimited.
+type Quota struct {
+	DailyDocuments    int64 `json:"daily_documents,omitempty"`
+	DailyCharacters   int64 `json:"daily_characters,omitempty"`
+	MonthlyDocuments  int64 `json:"monthly_documents,omitempty"`
+	MonthlyCharacters int64 `json:"monthly_characters,omitempty"`
+}
+
+// Check returns ErrQuotaExceeded if adding usage to the daily and monthly totals would exceed the quota
+func (q Quota) Check(daily, monthly, usage Usage) error {
+	limits := []struct {
+		name  string
+		limit int64
+		total int64
+	}{
+		{"daily document", q.DailyDocuments, daily.Documents + usage.Documents},
+		{"daily character", q.DailyCharacters, daily.Characters + usage.Characters},
+		{"monthly document", q.MonthlyDocuments, monthly.Documents + usage.Documents},
+		{"monthly character", q.MonthlyCharacters, monthly.Characters + usage.Characters},
+	}
+
+	for _, l := range limits {
+		if l.limit > 0 && l.total > l.limit {
+			return fmt.Errorf("%w: %s quota of %d reached", ErrQuotaExceeded, l.name, l.limit)
+		}
+	}
+	return nil
+}
+
+// TenantUsage is the usage of a tenant over a period
+type TenantUsage struct {
+	TenantID string `json:"tenant_id"`
+	Period   string `json:"period"`
+	Usage
+}
+
+// DayPeriod returns the daily usage period of a time, in UTC
+func DayPeriod(t time.Time) string {
+	return t.UTC().Format(dayLayout)
+}
+
+// MonthPeriod returns the monthly usage period of a time, in UTC
+func MonthPeriod(t time.Time) string {
+	return t.UTC().Format(monthLayout)
+}
+
+// ValidatePeriod checks that a period is a day or a month
+func ValidatePeriod(period string) error {
+	if _, err := time.Parse(dayLayout, period); err == nil {
+		return nil
+	}
+	if _, err := time.Parse(monthLayout, period); err == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: period %q is neither YYYY-MM-DD nor YYYY-MM", ErrInvalidRequest, period)
+}
+
+// tenantKey is the context key of the tenant ID
+type tenantKey struct{}
+
+// WithTenant returns a context carrying the tenant the request is made for
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// TenantFromContext returns the tenant of a request, or DefaultTenant
+func TenantFromContext(ctx context.Context) string {
+	if tenantID, ok := ctx.Value(tenantKey{}).(string); ok && tenantID != "" {
+		return tenantID
+	}
+	return DefaultTenant
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTextUsage(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     Text
+		expected Usage
+	}{
+		{"Minimum charge", "hola", Usage{Documents: 1, Characters: 4, BillingUnits: 3}},
+		{"Counts characters, not bytes", Text(strings.Repeat("é", 301)), Usage{Documents: 1, Characters: 301, BillingUnits: 4}},
+		{"Exact units", Text(strings.Repeat("a", 500)), Usage{Documents: 1, Characters: 500, BillingUnits: 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TextUsage(tt.text); got != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQuota_Check(t *testing.T) {
+	quota := Quota{DailyDocuments: 10, MonthlyCharacters: 1000}
+	usage := Usage{Documents: 1, Characters: 100}
+
+	if err := quota.Check(Usage{Documents: 9}, Usage{Characters: 900}, usage); err != nil {
+		t.Errorf("Expected usage up to the quota to pass, got %v", err)
+	}
+
+	err := quota.Check(Usage{Documents: 10}, Usage{}, usage)
+	if !errors.Is(err, ErrQuotaExceeded) || !strings.Contains(err.Error(), "daily document") {
+		t.Errorf("Expected the daily document quota to be exceeded, got %v", err)
+	}
+
+	err = quota.Check(Usage{}, Usage{Characters: 901}, usage)
+	if !errors.Is(err, ErrQuotaExceeded) || !strings.Contains(err.Error(), "monthly character") {
+		t.Errorf("Expected the monthly character quota to be exceeded, got %v", err)
+	}
+
+	if err := (Quota{}).Check(Usage{Documents: 1 << 40}, Usage{}, usage); err != nil {
+		t.Errorf("Expected an empty quota to be unlimited, got %v", err)
+	}
+}
+
+func TestPeriods(t *testing.T) {
+	at := time.Date(2026, 3, 31, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*3600))
+
+	if got := DayPeriod(at); got != "2026-04-01" {
+		t.Errorf("Expected day 2026-04-01 in UTC, got %s", got)
+	}
+	if got := MonthPeriod(at); got != "2026-04" {
+		t.Errorf("Expected month 2026-04 in UTC, got %s", got)
+	}
+
+	for _, period := range []string{"2026-04", "2026-04-01"} {
+		if err := ValidatePeriod(period); err != nil {
+			t.Errorf("Expected %s to be valid, got %v", period, err)
+		}
+	}
+	for _, period := range []string{"", "2026", "2026-13", "04/2026"} {
+		if err := ValidatePeriod(period); !errors.Is(err, ErrInvalidRequest) {
+			t.Errorf("Expected %q to be invalid, got %v", period, err)
+		}
+	}
+}
+
+func TestTenantContext(t *testing.T) {
+	if got := TenantFromContext(context.Background()); got != DefaultTenant {
+		t.Errorf("Expected the default tenant, got %s", got)
+	}
+
+	if got := TenantFromContext(WithTenant(context.Background(), "acme")); got != "acme" {
+		t.Errorf("Expected tenant acme, got %s", got)
+	}
+}
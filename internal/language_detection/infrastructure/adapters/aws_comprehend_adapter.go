@@ -2,21 +2,38 @@ package adapters
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/comprehend"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"language-detection-service/internal/language_detection/domain"
 )
 
+// comprehendAPI is the part of the Comprehend client used by the adapter
+type comprehendAPI interface {
+	DetectDominantLanguageWithContext(ctx aws.Context, input *comprehend.DetectDominantLanguageInput, opts ...request.Option) (*comprehend.DetectDominantLanguageOutput, error)
+	BatchDetectDominantLanguageWithContext(ctx aws.Context, input *comprehend.BatchDetectDominantLanguageInput, opts ...request.Option) (*comprehend.BatchDetectDominantLanguageOutput, error)
+}
+
 // AWSComprehendAdapter implements the LanguageDetector interface using AWS Comprehend
 type AWSComprehendAdapter struct {
-	client     *comprehend.Comprehend
+	client     comprehendAPI
 	region     string
 	maxRetries int
+	metrics    domain.Metrics
+	tracing    trace.TracerProvider
 }
 
 // NewAWSComprehendAdapter creates a new AWS Comprehend adapter
@@ -37,30 +54,105 @@ func NewAWSComprehendAdapter(region string, maxRetries int) (*AWSComprehendAdapt
 	}, nil
 }
 
+// WithMetrics records the latency and outcome of every Comprehend call
+func (a *AWSComprehendAdapter) WithMetrics(metrics domain.Metrics) *AWSComprehendAdapter {
+	a.metrics = metrics
+	return a
+}
+
+// WithTracerProvider creates the spans of Comprehend calls with the given
+// provider instead of the global one
+func (a *AWSComprehendAdapter) WithTracerProvider(provider trace.TracerProvider) *AWSComprehendAdapter {
+	a.tracing = provider
+	return a
+}
+
+// observe records a Comprehend call that started at start
+func (a *AWSComprehendAdapter) observe(start time.Time, err error) {
+	if a.metrics != nil {
+		a.metrics.ProviderCalled(a.ProviderName(), time.Since(start), err)
+	}
+}
+
+const (
+	// comprehendMaxBytes is the document size limit of DetectDominantLanguage
+	comprehendMaxBytes = 5000
+
+	// ComprehendMaxBatchSize is the most documents BatchDetectDominantLanguage accepts
+	ComprehendMaxBatchSize = 25
+)
+
 // DetectLanguage detects language using AWS Comprehend
 func (a *AWSComprehendAdapter) DetectLanguage(
 	ctx context.Context,
 	text domain.Text,
 ) (*domain.LanguageDetectionResponse, error) {
+	response, _, err := a.detect(ctx, text)
+	return response, err
+}
+
+// ExplainLanguage detects language like DetectLanguage and attaches the
+// scores and raw output returned by Comprehend
+func (a *AWSComprehendAdapter) ExplainLanguage(
+	ctx context.Context,
+	text domain.Text,
+) (*domain.LanguageDetectionResponse, error) {
+	response, result, err := a.detect(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &domain.Explanation{}
+	if len(text) > comprehendMaxBytes {
+		explanation.Preprocessing = append(explanation.Preprocessing,
+			fmt.Sprintf("truncated to the first %d bytes", comprehendMaxBytes))
+	}
+
+	if result != nil {
+		for _, lang := range result.Languages {
+			if lang.LanguageCode == nil || lang.Score == nil {
+				continue
+			}
+			explanation.Candidates = append(explanation.Candidates, domain.CandidateEvidence{
+				LanguageCode: a.convertLanguageCode(*lang.LanguageCode),
+				Score:        *lang.Score,
+			})
+		}
+		domain.SortCandidates(explanation.Candidates)
+
+		if raw, err := json.Marshal(result); err == nil {
+			explanation.RawOutput = string(raw)
+		}
+	}
+
+	response.Explanation = explanation
+	return response, nil
+}
+
+// detect calls Comprehend and converts its result. The raw result is nil when
+// the text is too short to be sent.
+func (a *AWSComprehendAdapter) detect(
+	ctx context.Context,
+	text domain.Text,
+) (response *domain.LanguageDetectionResponse, result *comprehend.DetectDominantLanguageOutput, err error) {
+	ctx, span := tracerFrom(a.tracing).Start(ctx, "AWSComprehendAdapter.DetectDominantLanguage",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attrProvider.String(a.ProviderName()),
+			attrTextLength.Int(utf8.RuneCountInString(string(text))),
+		))
+	defer func() { endSpan(span, response, err) }()
+
 	textStr := string(text)
 
 	// Truncate text if too long (Comprehend has a 5000 character limit per document)
-	if len(textStr) > 5000 {
-		textStr = textStr[:5000]
+	if len(textStr) > comprehendMaxBytes {
+		textStr = textStr[:comprehendMaxBytes]
 	}
 
 	// If text is empty or too short, return unknown
 	if len(strings.TrimSpace(textStr)) < 3 {
-		return &domain.LanguageDetectionResponse{
-			LanguageCode: domain.LanguageCode("unknown"),
-			Confidence:   0,
-			Metadata: domain.ProcessingMetadata{
-				Provider: "aws-comprehend",
-				Details: map[string]string{
-					"reason": "text_too_short",
-				},
-			},
-		}, nil
+		return a.unknown("text_too_short"), nil, nil
 	}
 
 	// Call AWS Comprehend
@@ -68,44 +160,125 @@ func (a *AWSComprehendAdapter) DetectLanguage(
 		Text: aws.String(textStr),
 	}
 
-	result, err := a.client.DetectDominantLanguageWithContext(ctx, input)
+	start := time.Now()
+	result, err = a.client.DetectDominantLanguageWithContext(ctx, input, traceAttempts(span))
+	if err != nil {
+		err = classifyAWSError(ctx, err)
+	}
+	a.observe(start, err)
 	if err != nil {
-		return nil, fmt.Errorf("AWS Comprehend error: %w", err)
+		return nil, nil, err
 	}
 
-	// Get the most confident language
-	if len(result.Languages) == 0 {
-		return &domain.LanguageDetectionResponse{
-			LanguageCode: domain.LanguageCode("unknown"),
-			Confidence:   0,
-			Metadata: domain.ProcessingMetadata{
-				Provider: "aws-comprehend",
-				Details: map[string]string{
-					"reason": "no_languages_detected",
-				},
+	return a.convertLanguages(result.Languages), result, nil
+}
+
+// DetectLanguages detects several texts with one BatchDetectDominantLanguage
+// call of at most ComprehendMaxBatchSize documents. Texts too short to send
+// are answered without calling Comprehend, and documents Comprehend rejects
+// fail on their own.
+func (a *AWSComprehendAdapter) DetectLanguages(ctx context.Context, texts []domain.Text) ([]domain.BatchResult, error) {
+	if len(texts) > ComprehendMaxBatchSize {
+		return nil, fmt.Errorf("%w: batch of %d texts exceeds %d", domain.ErrInvalidRequest, len(texts), ComprehendMaxBatchSize)
+	}
+
+	results := make([]domain.BatchResult, len(texts))
+	var sent []int // index in texts of each document sent
+	var documents []*string
+	for i, text := range texts {
+		textStr := string(text)
+		if len(textStr) > comprehendMaxBytes {
+			textStr = textStr[:comprehendMaxBytes]
+		}
+
+		if len(strings.TrimSpace(textStr)) < 3 {
+			results[i].Response = a.unknown("text_too_short")
+			continue
+		}
+		sent = append(sent, i)
+		documents = append(documents, aws.String(textStr))
+	}
+
+	if len(documents) == 0 {
+		return results, nil
+	}
+
+	ctx, span := tracerFrom(a.tracing).Start(ctx, "AWSComprehendAdapter.BatchDetectDominantLanguage",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attrProvider.String(a.ProviderName()),
+			attrBatchSize.Int(len(documents)),
+		))
+	defer span.End()
+
+	start := time.Now()
+	output, err := a.client.BatchDetectDominantLanguageWithContext(ctx, &comprehend.BatchDetectDominantLanguageInput{
+		TextList: documents,
+	}, traceAttempts(span))
+	if err != nil {
+		err = classifyAWSError(ctx, err)
+	}
+	a.observe(start, err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	for _, item := range output.ResultList {
+		if item.Index == nil || int(*item.Index) >= len(sent) {
+			continue
+		}
+		results[sent[*item.Index]].Response = a.convertLanguages(item.Languages)
+	}
+
+	for _, item := range output.ErrorList {
+		if item.Index == nil || int(*item.Index) >= len(sent) {
+			continue
+		}
+		results[sent[*item.Index]].Err = classifyBatchItemError(item)
+	}
+
+	for i := range results {
+		if results[i].Response == nil && results[i].Err == nil {
+			results[i].Err = fmt.Errorf("%w: AWS Comprehend returned no result for the document", domain.ErrInternalError)
+		}
+	}
+
+	return results, nil
+}
+
+// unknown returns the response for a text Comprehend cannot detect
+func (a *AWSComprehendAdapter) unknown(reason string) *domain.LanguageDetectionResponse {
+	return &domain.LanguageDetectionResponse{
+		LanguageCode: domain.LanguageCode("unknown"),
+		Confidence:   0,
+		Metadata: domain.ProcessingMetadata{
+			Provider: "aws-comprehend",
+			Details: map[string]string{
+				"reason": reason,
 			},
-		}, nil
+		},
+	}
+}
+
+// convertLanguages converts the languages Comprehend scored for a document
+func (a *AWSComprehendAdapter) convertLanguages(languages []*comprehend.DominantLanguage) *domain.LanguageDetectionResponse {
+	// Get the most confident language
+	if len(languages) == 0 {
+		return a.unknown("no_languages_detected")
 	}
 
 	// Find the language with highest confidence
 	var dominantLang *comprehend.DominantLanguage
-	for _, lang := range result.Languages {
+	for _, lang := range languages {
 		if dominantLang == nil || *lang.Score > *dominantLang.Score {
 			dominantLang = lang
 		}
 	}
 
 	if dominantLang == nil || dominantLang.LanguageCode == nil {
-		return &domain.LanguageDetectionResponse{
-			LanguageCode: domain.LanguageCode("unknown"),
-			Confidence:   0,
-			Metadata: domain.ProcessingMetadata{
-				Provider: "aws-comprehend",
-				Details: map[string]string{
-					"reason": "invalid_response",
-				},
-			},
-		}, nil
+		return a.unknown("invalid_response")
 	}
 
 	// Convert AWS language code to our format
@@ -114,7 +287,7 @@ func (a *AWSComprehendAdapter) DetectLanguage(
 
 	// Create alternatives from other detected languages
 	var alternatives []domain.LanguageAlternative
-	for _, lang := range result.Languages {
+	for _, lang := range languages {
 		if lang.LanguageCode != nil && *lang.LanguageCode != *dominantLang.LanguageCode {
 			alternatives = append(alternatives, domain.LanguageAlternative{
 				LanguageCode: a.convertLanguageCode(*lang.LanguageCode),
@@ -131,44 +304,95 @@ func (a *AWSComprehendAdapter) DetectLanguage(
 			Provider: "aws-comprehend",
 			Details: map[string]string{
 				"region":       a.region,
-				"total_langs":  fmt.Sprintf("%d", len(result.Languages)),
+				"total_langs":  fmt.Sprintf("%d", len(languages)),
 				"aws_lang_code": *dominantLang.LanguageCode,
 			},
 		},
-	}, nil
+	}
+}
+
+// classifyAWSError wraps a Comprehend failure with the matching domain error,
+// so callers can tell outages they may retry from requests that cannot succeed
+func classifyAWSError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return fmt.Errorf("AWS Comprehend error: %w", ctxErr)
+	}
+
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch aerr.Code() {
+		case comprehend.ErrCodeTextSizeLimitExceededException:
+			return fmt.Errorf("%w: AWS Comprehend error: %w", domain.ErrTextTooLong, err)
+		case comprehend.ErrCodeInvalidRequestException:
+			return fmt.Errorf("%w: AWS Comprehend error: %w", domain.ErrInvalidRequest, err)
+		case comprehend.ErrCodeInternalServerException,
+			comprehend.ErrCodeTooManyRequestsException,
+			"NoCredentialProviders", "ExpiredTokenException":
+			return fmt.Errorf("%w: AWS Comprehend error: %w", domain.ErrProviderUnavailable, err)
+		}
+	}
+
+	if request.IsErrorThrottle(err) || request.IsErrorRetryable(err) {
+		return fmt.Errorf("%w: AWS Comprehend error: %w", domain.ErrProviderUnavailable, err)
+	}
+
+	return fmt.Errorf("AWS Comprehend error: %w", err)
+}
+
+// classifyBatchItemError wraps the failure of one document in a batch with the matching domain error
+func classifyBatchItemError(item *comprehend.BatchItemError) error {
+	code := aws.StringValue(item.ErrorCode)
+	sentinel := domain.ErrInvalidRequest
+	switch {
+	case strings.Contains(code, "INTERNAL"):
+		sentinel = domain.ErrProviderUnavailable
+	case strings.Contains(code, "SIZE_LIMIT"):
+		sentinel = domain.ErrTextTooLong
+	}
+	return fmt.Errorf("%w: AWS Comprehend error: %s: %s", sentinel, code, aws.StringValue(item.ErrorMessage))
+}
+
+// awsLanguageCodes maps AWS language codes to our standard format
+var awsLanguageCodes = map[string]domain.LanguageCode{
+	"en":    "en-US",
+	"es":    "es-ES",
+	"fr":    "fr-FR",
+	"de":    "de-DE",
+	"it":    "it-IT",
+	"pt":    "pt-PT",
+	"ru":    "ru-RU",
+	"ja":    "ja-JP",
+	"ko":    "ko-KR",
+	"zh":    "zh-CN",
+	"zh-tw": "zh-CN",
+	"zh-cn": "zh-CN",
+	"ar":    "ar-SA",
+	"hi":    "hi-IN",
+}
+
+// ProviderName returns the provider name reported in processing metadata
+func (a *AWSComprehendAdapter) ProviderName() string {
+	return "aws-comprehend"
+}
+
+// ProducibleLanguages returns the mapped languages AWS Comprehend can detect
+func (a *AWSComprehendAdapter) ProducibleLanguages() []domain.LanguageCode {
+	seen := make(map[domain.LanguageCode]bool)
+	var languages []domain.LanguageCode
+	for _, code := range awsLanguageCodes {
+		if !seen[code] {
+			seen[code] = true
+			languages = append(languages, code)
+		}
+	}
+	sort.Slice(languages, func(i, j int) bool { return languages[i] < languages[j] })
+	return languages
 }
 
 // convertLanguageCode converts AWS language codes to our standard format
 func (a *AWSComprehendAdapter) convertLanguageCode(awsCode string) domain.LanguageCode {
-	langCode := strings.ToLower(awsCode)
-	
-	// Map AWS language codes to our expected format
-	switch langCode {
-	case "en":
-		return "en-US"
-	case "es":
-		return "es-ES"
-	case "fr":
-		return "fr-FR"
-	case "de":
-		return "de-DE"
-	case "it":
-		return "it-IT"
-	case "pt":
-		return "pt-PT"
-	case "ru":
-		return "ru-RU"
-	case "ja":
-		return "ja-JP"
-	case "ko":
-		return "ko-KR"
-	case "zh", "zh-tw", "zh-cn":
-		return "zh-CN"
-	case "ar":
-		return "ar-SA"
-	case "hi":
-		return "hi-IN"
-	default:
-		return domain.LanguageCode(awsCode)
+	if code, ok := awsLanguageCodes[strings.ToLower(awsCode)]; ok {
+		return code
 	}
+	return domain.LanguageCode(awsCode)
 }
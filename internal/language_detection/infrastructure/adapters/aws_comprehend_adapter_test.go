@@ -2,7 +2,14 @@ package adapters
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/comprehend"
 
 	"language-detection-service/internal/language_detection/domain"
 )
@@ -180,3 +187,195 @@ func TestAWSComprehendAdapter_DetectLanguage_TextTooShort(t *testing.T) {
 		t.Errorf("Expected reason 'text_too_short', got %s", response.Metadata.Details["reason"])
 	}
 }
+
+func TestAWSComprehendAdapter_ProducibleLanguages(t *testing.T) {
+	adapter := &AWSComprehendAdapter{}
+
+	if adapter.ProviderName() != "aws-comprehend" {
+		t.Errorf("Expected provider name 'aws-comprehend', got %s", adapter.ProviderName())
+	}
+
+	languages := adapter.ProducibleLanguages()
+
+	// Several AWS codes map to zh-CN, but it must be listed once
+	seen := make(map[domain.LanguageCode]bool)
+	for _, lang := range languages {
+		if seen[lang] {
+			t.Errorf("Language %s listed more than once", lang)
+		}
+		seen[lang] = true
+	}
+
+	for _, lang := range []domain.LanguageCode{"en-US", "zh-CN", "ar-SA", "hi-IN"} {
+		if !seen[lang] {
+			t.Errorf("Expected producible language %s not found", lang)
+		}
+	}
+
+	if len(languages) != 12 {
+		t.Errorf("Expected 12 producible languages, got %d", len(languages))
+	}
+}
+
+func TestClassifyAWSError(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		err      error
+		expected error
+	}{
+		{"Throttled", context.Background(), awserr.New("TooManyRequestsException", "slow down", nil), domain.ErrProviderUnavailable},
+		{"Internal failure", context.Background(), awserr.New("InternalServerException", "oops", nil), domain.ErrProviderUnavailable},
+		{"Missing credentials", context.Background(), awserr.New("NoCredentialProviders", "no creds", nil), domain.ErrProviderUnavailable},
+		{"Network failure", context.Background(), awserr.New("RequestError", "send request failed", errors.New("connection refused")), domain.ErrProviderUnavailable},
+		{"Text too long", context.Background(), awserr.New("TextSizeLimitExceededException", "too long", nil), domain.ErrTextTooLong},
+		{"Invalid request", context.Background(), awserr.New("InvalidRequestException", "bad", nil), domain.ErrInvalidRequest},
+		{"Cancelled", cancelled, awserr.New("RequestCanceled", "canceled", nil), context.Canceled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := classifyAWSError(tt.ctx, tt.err)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("Expected error %v, got %v", tt.expected, err)
+			}
+		})
+	}
+
+	err := classifyAWSError(context.Background(), awserr.New("AccessDeniedException", "denied", nil))
+	if errors.Is(err, domain.ErrProviderUnavailable) {
+		t.Errorf("Expected access denied not to be retryable, got %v", err)
+	}
+}
+
+func TestAWSComprehendAdapter_ExplainLanguage_TextTooShort(t *testing.T) {
+	adapter, err := NewAWSComprehendAdapter("us-east-1", 3)
+	if err != nil {
+		t.Skip("Skipping test due to missing AWS credentials")
+	}
+
+	response, err := adapter.ExplainLanguage(context.Background(), domain.Text("hi"))
+	if err != nil {
+		t.Fatalf("Expected no error for short text, got %v", err)
+	}
+
+	if response.Explanation == nil {
+		t.Fatal("Expected explanation, got nil")
+	}
+
+	if len(response.Explanation.Candidates) != 0 || response.Explanation.RawOutput != "" {
+		t.Errorf("Expected no candidates without a Comprehend call, got %+v", response.Explanation)
+	}
+}
+
+// MockComprehendClient answers batch calls with fixed results
+type MockComprehendClient struct {
+	batchInput  *comprehend.BatchDetectDominantLanguageInput
+	batchOutput *comprehend.BatchDetectDominantLanguageOutput
+	err         error
+}
+
+func (m *MockComprehendClient) DetectDominantLanguageWithContext(ctx aws.Context, input *comprehend.DetectDominantLanguageInput, opts ...request.Option) (*comprehend.DetectDominantLanguageOutput, error) {
+	return nil, m.err
+}
+
+func (m *MockComprehendClient) BatchDetectDominantLanguageWithContext(ctx aws.Context, input *comprehend.BatchDetectDominantLanguageInput, opts ...request.Option) (*comprehend.BatchDetectDominantLanguageOutput, error) {
+	m.batchInput = input
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.batchOutput, nil
+}
+
+func TestAWSComprehendAdapter_DetectLanguages(t *testing.T) {
+	client := &MockComprehendClient{
+		batchOutput: &comprehend.BatchDetectDominantLanguageOutput{
+			ResultList: []*comprehend.BatchDetectDominantLanguageItemResult{{
+				Index: aws.Int64(1),
+				Languages: []*comprehend.DominantLanguage{
+					{LanguageCode: aws.String("fr"), Score: aws.Float64(0.9)},
+					{LanguageCode: aws.String("en"), Score: aws.Float64(0.1)},
+				},
+			}},
+			ErrorList: []*comprehend.BatchItemError{{
+				Index:        aws.Int64(0),
+				ErrorCode:    aws.String("INVALID_REQUEST"),
+				ErrorMessage: aws.String("unsupported"),
+			}},
+		},
+	}
+	adapter := &AWSComprehendAdapter{client: client}
+
+	results, err := adapter.DetectLanguages(context.Background(), []domain.Text{"Hello there", "Hi", "Bonjour le monde"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(client.batchInput.TextList) != 2 {
+		t.Errorf("Expected the short text not to be sent, got %d documents", len(client.batchInput.TextList))
+	}
+
+	if !errors.Is(results[0].Err, domain.ErrInvalidRequest) {
+		t.Errorf("Expected the rejected document to fail with ErrInvalidRequest, got %v", results[0].Err)
+	}
+
+	if results[1].Response == nil || results[1].Response.Metadata.Details["reason"] != "text_too_short" {
+		t.Errorf("Expected an unknown result for the short text, got %+v", results[1].Response)
+	}
+
+	if results[2].Response == nil || results[2].Response.LanguageCode != "fr-FR" || len(results[2].Response.Alternatives) != 1 {
+		t.Errorf("Expected fr-FR with one alternative, got %+v", results[2].Response)
+	}
+}
+
+func TestAWSComprehendAdapter_DetectLanguages_Errors(t *testing.T) {
+	client := &MockComprehendClient{
+		err: awserr.New(comprehend.ErrCodeTooManyRequestsException, "slow down", nil),
+	}
+	adapter := &AWSComprehendAdapter{client: client}
+
+	if _, err := adapter.DetectLanguages(context.Background(), []domain.Text{"Hello there"}); !errors.Is(err, domain.ErrProviderUnavailable) {
+		t.Errorf("Expected ErrProviderUnavailable, got %v", err)
+	}
+
+	texts := make([]domain.Text, ComprehendMaxBatchSize+1)
+	if _, err := adapter.DetectLanguages(context.Background(), texts); !errors.Is(err, domain.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for an oversized batch, got %v", err)
+	}
+}
+
+// MockMetrics records the provider calls reported to it
+type MockMetrics struct {
+	domain.NopMetrics
+	calls []error
+}
+
+func (m *MockMetrics) ProviderCalled(provider string, duration time.Duration, err error) {
+	m.calls = append(m.calls, err)
+}
+
+func TestAWSComprehendAdapter_Metrics(t *testing.T) {
+	client := &MockComprehendClient{
+		err: awserr.New(comprehend.ErrCodeTooManyRequestsException, "slow down", nil),
+	}
+	metrics := &MockMetrics{}
+	adapter := (&AWSComprehendAdapter{client: client}).WithMetrics(metrics)
+
+	adapter.DetectLanguage(context.Background(), "Hello there")
+	adapter.DetectLanguages(context.Background(), []domain.Text{"Hello there"})
+
+	// Texts too short to send are not counted as calls
+	adapter.DetectLanguage(context.Background(), "Hi")
+
+	if len(metrics.calls) != 2 {
+		t.Fatalf("Expected 2 provider calls, got %d", len(metrics.calls))
+	}
+	for _, err := range metrics.calls {
+		if !errors.Is(err, domain.ErrProviderUnavailable) {
+			t.Errorf("Expected calls to fail with ErrProviderUnavailable, got %v", err)
+		}
+	}
+}
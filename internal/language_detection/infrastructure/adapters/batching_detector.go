@@ -0,0 +1,199 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// BatchableDetector is a detector that can also detect several texts in one call
+type BatchableDetector interface {
+	domain.LanguageDetector
+	domain.BatchDetector
+}
+
+// BatchingDetector groups single detections that arrive close together into
+// one batch call. A batch is sent when it holds maxBatch texts or when its
+// first text has waited maxWait. Each caller stops waiting as soon as its own
+// context ends; the batch call itself runs until the latest caller deadline.
+type BatchingDetector struct {
+	detector BatchableDetector
+	maxBatch int
+	maxWait  time.Duration
+
+	mu         sync.Mutex
+	pending    []*batchItem
+	generation uint64 // identifies the pending batch, so a late timer cannot send the next one
+}
+
+// batchItem is a text waiting to be sent and the channel its result is delivered on
+type batchItem struct {
+	ctx    context.Context
+	text   domain.Text
+	result chan domain.BatchResult
+}
+
+// NewBatchingDetector creates a new batching detector
+func NewBatchingDetector(detector BatchableDetector, maxBatch int, maxWait time.Duration) *BatchingDetector {
+	return &BatchingDetector{
+		detector: detector,
+		maxBatch: maxBatch,
+		maxWait:  maxWait,
+	}
+}
+
+// DetectLanguage queues the text for the next batch and waits for its result
+func (b *BatchingDetector) DetectLanguage(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	item := &batchItem{ctx: ctx, text: text, result: make(chan domain.BatchResult, 1)}
+	b.enqueue(item)
+
+	select {
+	case result := <-item.result:
+		return result.Response, result.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// ExplainLanguage is not batched; it goes straight to the detector
+func (b *BatchingDetector) ExplainLanguage(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+	if explainer, ok := b.detector.(domain.ExplainingDetector); ok {
+		return explainer.ExplainLanguage(ctx, text)
+	}
+	return b.detector.DetectLanguage(ctx, text)
+}
+
+// ProviderName returns the provider name of the wrapped detector
+func (b *BatchingDetector) ProviderName() string {
+	return providerName(b.detector)
+}
+
+// ProducibleLanguages returns the languages of the wrapped detector
+func (b *BatchingDetector) ProducibleLanguages() []domain.LanguageCode {
+	if provider, ok := b.detector.(domain.LanguageProvider); ok {
+		return provider.ProducibleLanguages()
+	}
+	return nil
+}
+
+// enqueue adds an item to the pending batch, sending the batch once it is full
+func (b *BatchingDetector) enqueue(item *batchItem) {
+	b.mu.Lock()
+	b.pending = append(b.pending, item)
+
+	if len(b.pending) >= b.maxBatch {
+		batch := b.takePending()
+		b.mu.Unlock()
+		go b.send(batch)
+		return
+	}
+
+	if len(b.pending) == 1 {
+		generation := b.generation
+		time.AfterFunc(b.maxWait, func() { b.flush(generation) })
+	}
+	b.mu.Unlock()
+}
+
+// flush sends the pending batch if it is still the one the timer was started for
+func (b *BatchingDetector) flush(generation uint64) {
+	b.mu.Lock()
+	if generation != b.generation || len(b.pending) == 0 {
+		b.mu.Unlock()
+		return
+	}
+	batch := b.takePending()
+	b.mu.Unlock()
+
+	b.send(batch)
+}
+
+// takePending removes and returns the pending batch; the caller holds the lock
+func (b *BatchingDetector) takePending() []*batchItem {
+	batch := b.pending
+	b.pending = nil
+	b.generation++
+	return batch
+}
+
+// send detects the texts of a batch whose callers are still waiting
+func (b *BatchingDetector) send(batch []*batchItem) {
+	var live []*batchItem
+	for _, item := range batch {
+		if err := item.ctx.Err(); err != nil {
+			item.result <- domain.BatchResult{Err: err}
+			continue
+		}
+		live = append(live, item)
+	}
+
+	switch len(live) {
+	case 0:
+		return
+	case 1:
+		response, err := b.detector.DetectLanguage(live[0].ctx, live[0].text)
+		live[0].result <- domain.BatchResult{Response: response, Err: err}
+		return
+	}
+
+	// The batch call is traced under the first caller, so mark it on every caller's span
+	for _, item := range live {
+		trace.SpanFromContext(item.ctx).AddEvent("batched", trace.WithAttributes(attrBatchSize.Int(len(live))))
+	}
+
+	ctx, cancel := batchContext(live)
+	defer cancel()
+
+	texts := make([]domain.Text, len(live))
+	for i, item := range live {
+		texts[i] = item.text
+	}
+
+	results, err := b.detector.DetectLanguages(ctx, texts)
+	if err == nil && len(results) != len(live) {
+		err = fmt.Errorf("%w: batch of %d texts returned %d results", domain.ErrInternalError, len(live), len(results))
+	}
+	for i, item := range live {
+		if err != nil {
+			item.result <- domain.BatchResult{Err: err}
+			continue
+		}
+		item.result <- results[i]
+	}
+}
+
+// batchContext returns the context of a call made for several callers. It is
+// not cancelled by any single caller and expires at the latest caller
+// deadline, or never if one of the callers has none.
+func batchContext(items []*batchItem) (context.Context, context.CancelFunc) {
+	base := context.WithoutCancel(items[0].ctx)
+
+	var latest time.Time
+	for _, item := range items {
+		deadline, ok := item.ctx.Deadline()
+		if !ok {
+			return context.WithCancel(base)
+		}
+		if deadline.After(latest) {
+			latest = deadline
+		}
+	}
+	return context.WithDeadline(base, latest)
+}
+
+// providerName returns the provider name of a detector, or "" if it does not report one
+func providerName(detector domain.LanguageDetector) string {
+	if provider, ok := detector.(domain.LanguageProvider); ok {
+		return provider.ProviderName()
+	}
+	return ""
+}
@@ -0,0 +1,184 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MockBatchDetector echoes each text as its language code and records the batches it receives
+type MockBatchDetector struct {
+	mu      sync.Mutex
+	batches [][]domain.Text
+	singles int
+	err     error
+}
+
+func (m *MockBatchDetector) DetectLanguage(ctx context.Context, text domain.Text) (*domain.LanguageDetectionResponse, error) {
+	m.mu.Lock()
+	m.singles++
+	m.mu.Unlock()
+	return &domain.LanguageDetectionResponse{LanguageCode: domain.LanguageCode(text)}, nil
+}
+
+func (m *MockBatchDetector) DetectLanguages(ctx context.Context, texts []domain.Text) ([]domain.BatchResult, error) {
+	m.mu.Lock()
+	m.batches = append(m.batches, texts)
+	m.mu.Unlock()
+
+	if m.err != nil {
+		return nil, m.err
+	}
+	results := make([]domain.BatchResult, len(texts))
+	for i, text := range texts {
+		results[i].Response = &domain.LanguageDetectionResponse{LanguageCode: domain.LanguageCode(text)}
+	}
+	return results, nil
+}
+
+func (m *MockBatchDetector) ProviderName() string {
+	return "mock"
+}
+
+func (m *MockBatchDetector) ProducibleLanguages() []domain.LanguageCode {
+	return nil
+}
+
+// detectConcurrently runs one detection per text at the same time
+func detectConcurrently(ctx context.Context, detector domain.LanguageDetector, texts ...domain.Text) ([]*domain.LanguageDetectionResponse, []error) {
+	responses := make([]*domain.LanguageDetectionResponse, len(texts))
+	errs := make([]error, len(texts))
+
+	var wg sync.WaitGroup
+	for i, text := range texts {
+		wg.Add(1)
+		go func(i int, text domain.Text) {
+			defer wg.Done()
+			responses[i], errs[i] = detector.DetectLanguage(ctx, text)
+		}(i, text)
+	}
+	wg.Wait()
+	return responses, errs
+}
+
+func TestBatchingDetector_SendsFullBatch(t *testing.T) {
+	mock := &MockBatchDetector{}
+	detector := NewBatchingDetector(mock, 3, time.Hour)
+
+	responses, errs := detectConcurrently(context.Background(), detector, "en", "fr", "de")
+
+	for i, code := range []domain.LanguageCode{"en", "fr", "de"} {
+		if errs[i] != nil || responses[i].LanguageCode != code {
+			t.Errorf("Expected %s, got %+v (%v)", code, responses[i], errs[i])
+		}
+	}
+
+	if len(mock.batches) != 1 || len(mock.batches[0]) != 3 {
+		t.Errorf("Expected one batch of 3, got %v", mock.batches)
+	}
+}
+
+func TestBatchingDetector_SendsAfterMaxWait(t *testing.T) {
+	mock := &MockBatchDetector{}
+	detector := NewBatchingDetector(mock, 25, 20*time.Millisecond)
+
+	start := time.Now()
+	_, errs := detectConcurrently(context.Background(), detector, "en", "fr")
+
+	if errs[0] != nil || errs[1] != nil {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Expected the batch to wait 20ms, took %v", elapsed)
+	}
+
+	if len(mock.batches) != 1 || len(mock.batches[0]) != 2 {
+		t.Errorf("Expected one batch of 2, got %v", mock.batches)
+	}
+
+	// A lone text is detected on its own
+	if _, err := detector.DetectLanguage(context.Background(), "es"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if mock.singles != 1 {
+		t.Errorf("Expected 1 single detection, got %d", mock.singles)
+	}
+}
+
+func TestBatchingDetector_HonoursCallerContext(t *testing.T) {
+	mock := &MockBatchDetector{}
+	detector := NewBatchingDetector(mock, 25, 50*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var timedOutErr error
+	var elapsed time.Duration
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		start := time.Now()
+		_, timedOutErr = detector.DetectLanguage(ctx, "en")
+		elapsed = time.Since(start)
+	}()
+
+	_, errs := detectConcurrently(context.Background(), detector, "fr", "de")
+	wg.Wait()
+
+	if !errors.Is(timedOutErr, context.DeadlineExceeded) || elapsed >= 50*time.Millisecond {
+		t.Errorf("Expected the caller to give up at its own deadline, got %v after %v", timedOutErr, elapsed)
+	}
+
+	if errs[0] != nil || errs[1] != nil {
+		t.Errorf("Expected the other callers to succeed, got %v", errs)
+	}
+
+	if len(mock.batches) != 1 || len(mock.batches[0]) != 2 {
+		t.Errorf("Expected the expired text to be left out of the batch, got %v", mock.batches)
+	}
+}
+
+func TestBatchingDetector_BatchError(t *testing.T) {
+	mock := &MockBatchDetector{err: domain.ErrProviderUnavailable}
+	detector := NewBatchingDetector(mock, 2, time.Hour)
+
+	_, errs := detectConcurrently(context.Background(), detector, "en", "fr")
+
+	for _, err := range errs {
+		if !errors.Is(err, domain.ErrProviderUnavailable) {
+			t.Errorf("Expected ErrProviderUnavailable for every caller, got %v", err)
+		}
+	}
+}
+
+func TestBatchContext(t *testing.T) {
+	early, cancelEarly := context.WithTimeout(context.Background(), time.Second)
+	defer cancelEarly()
+	late, cancelLate := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelLate()
+
+	ctx, cancel := batchContext([]*batchItem{{ctx: early}, {ctx: late}})
+	defer cancel()
+
+	lateDeadline, _ := late.Deadline()
+	if deadline, ok := ctx.Deadline(); !ok || !deadline.Equal(lateDeadline) {
+		t.Errorf("Expected the latest deadline %v, got %v", lateDeadline, deadline)
+	}
+
+	cancelEarly()
+	if ctx.Err() != nil {
+		t.Error("Expected one caller's cancellation not to cancel the batch")
+	}
+
+	ctx, cancel = batchContext([]*batchItem{{ctx: early}, {ctx: context.Background()}})
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("Expected no deadline when a caller has none")
+	}
+}
@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"log/slog"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/config"
+)
+
+// NewDetector creates the language detector selected by the configuration.
+// When AWS Comprehend is selected but cannot be set up, it falls back to
+// pattern-based detection. Comprehend calls are batched when a batch wait is
+// configured. Provider calls are recorded in metrics.
+func NewDetector(cfg *config.Config, metrics domain.Metrics) domain.LanguageDetector {
+	if !cfg.UseAWSComprehend {
+		slog.Info("Using fallback pattern-based language detection")
+		return NewFallbackAdapter().WithMetrics(metrics)
+	}
+
+	detector, err := NewAWSComprehendAdapter(cfg.AWSRegion, 3)
+	if err != nil {
+		slog.Warn("Failed to create AWS Comprehend adapter, falling back to pattern-based detection", "error", err)
+		return NewFallbackAdapter().WithMetrics(metrics)
+	}
+
+	detector.WithMetrics(metrics)
+
+	slog.Info("Using AWS Comprehend for language detection", "region", cfg.AWSRegion)
+	if cfg.BatchMaxWaitMs > 0 {
+		slog.Info("Batching Comprehend calls", "max_size", cfg.BatchMaxSize, "max_wait_ms", cfg.BatchMaxWaitMs)
+		return NewBatchingDetector(detector, cfg.BatchMaxSize, time.Duration(cfg.BatchMaxWaitMs)*time.Millisecond)
+	}
+	return detector
+}
@@ -0,0 +1,16 @@
+package adapters
+
+import (
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/config"
+)
+
+func TestNewDetector_Fallback(t *testing.T) {
+	detector := NewDetector(&config.Config{UseAWSComprehend: false}, domain.NopMetrics{})
+
+	if _, ok := detector.(*FallbackAdapter); !ok {
+		t.Errorf("Expected FallbackAdapter, got %T", detector)
+	}
+}
@@ -2,8 +2,14 @@ package adapters
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/trace"
 
 	"language-detection-service/internal/language_detection/domain"
 )
@@ -11,6 +17,8 @@ import (
 // FallbackAdapter implements the LanguageDetector interface using pattern matching
 type FallbackAdapter struct {
 	patterns map[string][]string
+	metrics  domain.Metrics
+	tracing  trace.TracerProvider
 }
 
 // NewFallbackAdapter creates a new fallback adapter
@@ -64,11 +72,36 @@ func NewFallbackAdapter() *FallbackAdapter {
 	}
 }
 
+// WithMetrics records the latency of every detection
+func (f *FallbackAdapter) WithMetrics(metrics domain.Metrics) *FallbackAdapter {
+	f.metrics = metrics
+	return f
+}
+
+// WithTracerProvider creates the detection spans with the given provider
+// instead of the global one
+func (f *FallbackAdapter) WithTracerProvider(provider trace.TracerProvider) *FallbackAdapter {
+	f.tracing = provider
+	return f
+}
+
 // DetectLanguage detects language using pattern matching
 func (f *FallbackAdapter) DetectLanguage(
 	ctx context.Context,
 	text domain.Text,
-) (*domain.LanguageDetectionResponse, error) {
+) (response *domain.LanguageDetectionResponse, err error) {
+	_, span := tracerFrom(f.tracing).Start(ctx, "FallbackAdapter.DetectLanguage", trace.WithAttributes(
+		attrProvider.String(f.ProviderName()),
+		attrTextLength.Int(utf8.RuneCountInString(string(text))),
+	))
+	defer func() { endSpan(span, response, err) }()
+
+	if f.metrics != nil {
+		defer func(start time.Time) {
+			f.metrics.ProviderCalled(f.ProviderName(), time.Since(start), nil)
+		}(time.Now())
+	}
+
 	textStr := strings.ToLower(strings.TrimSpace(string(text)))
 
 	if len(textStr) < 3 {
@@ -159,6 +192,88 @@ func (f *FallbackAdapter) DetectLanguage(
 	}, nil
 }
 
+// ExplainLanguage detects language like DetectLanguage and explains which
+// pattern words matched for each language
+func (f *FallbackAdapter) ExplainLanguage(
+	ctx context.Context,
+	text domain.Text,
+) (*domain.LanguageDetectionResponse, error) {
+	response, err := f.DetectLanguage(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Explanation = f.explain(text)
+	return response, nil
+}
+
+// explain lists the matched words and score of every language
+func (f *FallbackAdapter) explain(text domain.Text) *domain.Explanation {
+	words := strings.Fields(strings.ToLower(strings.TrimSpace(string(text))))
+	explanation := &domain.Explanation{
+		Preprocessing: []string{
+			"trimmed surrounding whitespace",
+			"lowercased",
+			fmt.Sprintf("split into %d words at whitespace", len(words)),
+			"stripped non-alphanumeric ASCII characters around each word",
+		},
+	}
+	if len(words) == 0 {
+		return explanation
+	}
+
+	totalWords := float64(len(words))
+	scores := make(map[string]float64, len(f.patterns))
+	for lang, patterns := range f.patterns {
+		patternSet := make(map[string]bool, len(patterns))
+		for _, pattern := range patterns {
+			patternSet[pattern] = true
+		}
+
+		counts := make(map[string]int)
+		for _, word := range words {
+			if clean := cleanWord(word); patternSet[clean] {
+				counts[clean]++
+			}
+		}
+
+		candidate := domain.CandidateEvidence{LanguageCode: domain.LanguageCode(lang)}
+		for word, count := range counts {
+			contribution := float64(count) / totalWords
+			candidate.Score += contribution
+			candidate.Features = append(candidate.Features, domain.FeatureContribution{
+				Feature:      word,
+				Kind:         domain.FeatureKindWord,
+				Count:        count,
+				Contribution: contribution,
+			})
+		}
+		scores[lang] = candidate.Score
+		explanation.Candidates = append(explanation.Candidates, candidate)
+	}
+	domain.SortCandidates(explanation.Candidates)
+
+	if raw, err := json.Marshal(map[string]any{"total_words": len(words), "scores": scores}); err == nil {
+		explanation.RawOutput = string(raw)
+	}
+	return explanation
+}
+
+// ProviderName returns the provider name reported in processing metadata
+func (f *FallbackAdapter) ProviderName() string {
+	return "fallback"
+}
+
+// ProducibleLanguages returns the languages the pattern tables can detect
+func (f *FallbackAdapter) ProducibleLanguages() []domain.LanguageCode {
+	languages := make([]domain.LanguageCode, 0, len(f.patterns))
+	for lang := range f.patterns {
+		languages = append(languages, domain.LanguageCode(lang))
+	}
+	sort.Slice(languages, func(i, j int) bool { return languages[i] < languages[j] })
+	return languages
+}
+
 // calculateLanguageScore calculates the score for a language based on word patterns
 func (f *FallbackAdapter) calculateLanguageScore(words []string, patterns []string) float32 {
 	score := float32(0)
@@ -171,15 +286,17 @@ func (f *FallbackAdapter) calculateLanguageScore(words []string, patterns []stri
 
 	// Count matching words
 	for _, word := range words {
-		// Clean word (remove punctuation)
-		cleanWord := strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
-			return !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'))
-		}))
-
-		if patternSet[cleanWord] {
+		if patternSet[cleanWord(word)] {
 			score++
 		}
 	}
 
 	return score
 }
+
+// cleanWord lowercases a word and removes the punctuation around it
+func cleanWord(word string) string {
+	return strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'))
+	}))
+}
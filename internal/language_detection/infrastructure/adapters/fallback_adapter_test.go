@@ -2,6 +2,7 @@ package adapters
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"language-detection-service/internal/language_detection/domain"
@@ -427,3 +428,63 @@ func TestFallbackAdapter_ContextCancellation(t *testing.T) {
 		t.Fatal("Expected response, got nil")
 	}
 }
+
+func TestFallbackAdapter_ProducibleLanguages(t *testing.T) {
+	adapter := NewFallbackAdapter()
+
+	if adapter.ProviderName() != "fallback" {
+		t.Errorf("Expected provider name 'fallback', got %s", adapter.ProviderName())
+	}
+
+	expected := []domain.LanguageCode{"de-DE", "en-US", "es-ES", "fr-FR"}
+	languages := adapter.ProducibleLanguages()
+
+	if len(languages) != len(expected) {
+		t.Fatalf("Expected %d languages, got %d: %v", len(expected), len(languages), languages)
+	}
+
+	for i, lang := range expected {
+		if languages[i] != lang {
+			t.Errorf("Expected language %s at index %d, got %s", lang, i, languages[i])
+		}
+	}
+}
+
+func TestFallbackAdapter_ExplainLanguage(t *testing.T) {
+	adapter := NewFallbackAdapter()
+
+	response, err := adapter.ExplainLanguage(context.Background(), domain.Text("The cat and the dog, and THE bird."))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if response.LanguageCode != "en-US" {
+		t.Errorf("Expected language code en-US, got %s", response.LanguageCode)
+	}
+
+	explanation := response.Explanation
+	if explanation == nil {
+		t.Fatal("Expected explanation, got nil")
+	}
+
+	if len(explanation.Preprocessing) == 0 {
+		t.Error("Expected preprocessing steps, got none")
+	}
+
+	if len(explanation.Candidates) != 4 {
+		t.Fatalf("Expected 4 candidates, got %d", len(explanation.Candidates))
+	}
+
+	best := explanation.Candidates[0]
+	if best.LanguageCode != "en-US" || float32(best.Score) != float32(response.Confidence) {
+		t.Errorf("Expected en-US first with the response confidence, got %+v", best)
+	}
+
+	if len(best.Features) == 0 || best.Features[0].Feature != "the" || best.Features[0].Count != 3 {
+		t.Errorf("Expected 'the' to contribute most with 3 matches, got %+v", best.Features)
+	}
+
+	if !strings.Contains(explanation.RawOutput, `"total_words":8`) {
+		t.Errorf("Expected raw output with the word count, got %s", explanation.RawOutput)
+	}
+}
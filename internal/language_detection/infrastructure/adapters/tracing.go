@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"github.com/aws/aws-sdk-go/aws/request"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// tracerName identifies the spans of the detection adapters
+const tracerName = "language-detection-service/adapters"
+
+// Span attributes describing a provider call
+const (
+	attrProvider   = attribute.Key("detection.provider")
+	attrTextLength = attribute.Key("detection.text_length")
+	attrLanguage   = attribute.Key("detection.language")
+	attrConfidence = attribute.Key("detection.confidence")
+	attrBatchSize  = attribute.Key("detection.batch_size")
+	attrAttempt    = attribute.Key("aws.attempt")
+	attrRetryCount = attribute.Key("aws.retry_count")
+)
+
+// tracerFrom returns the tracer of provider, or of the global provider when
+// none is set
+func tracerFrom(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(tracerName)
+}
+
+// endSpan records the detected language, or err, on span and ends it
+func endSpan(span trace.Span, response *domain.LanguageDetectionResponse, err error) {
+	if response != nil {
+		span.SetAttributes(
+			attrLanguage.String(string(response.LanguageCode)),
+			attrConfidence.Float64(float64(response.Confidence)),
+		)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// traceAttempts records every failed attempt of an AWS request, and how often
+// it was retried, on span
+func traceAttempts(span trace.Span) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Error != nil {
+				span.AddEvent("attempt failed", trace.WithAttributes(
+					attrAttempt.Int(r.RetryCount+1),
+					attribute.String("error", r.Error.Error()),
+				))
+			}
+		})
+		r.Handlers.Complete.PushBack(func(r *request.Request) {
+			span.SetAttributes(attrRetryCount.Int(r.RetryCount))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// spanAttributes indexes the attributes of a span by key
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func TestFallbackAdapter_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	adapter := NewFallbackAdapter().WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	response, err := adapter.DetectLanguage(context.Background(), "Hola, ¿cómo estás? Estoy muy bien, gracias")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Name() != "FallbackAdapter.DetectLanguage" {
+		t.Fatalf("Expected one FallbackAdapter.DetectLanguage span, got %v", spans)
+	}
+
+	attributes := spanAttributes(spans[0])
+	if attributes[attrProvider].AsString() != "fallback" {
+		t.Errorf("Expected provider fallback, got %v", attributes[attrProvider].Emit())
+	}
+	if attributes[attrTextLength].AsInt64() != 42 {
+		t.Errorf("Expected text length 42, got %v", attributes[attrTextLength].Emit())
+	}
+	if attributes[attrLanguage].AsString() != string(response.LanguageCode) {
+		t.Errorf("Expected language %s, got %v", response.LanguageCode, attributes[attrLanguage].Emit())
+	}
+	if attributes[attrConfidence].AsFloat64() != float64(response.Confidence) {
+		t.Errorf("Expected confidence %v, got %v", response.Confidence, attributes[attrConfidence].Emit())
+	}
+}
+
+func TestAWSComprehendAdapter_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	client := &MockComprehendClient{
+		err: awserr.New(comprehend.ErrCodeTooManyRequestsException, "slow down", nil),
+	}
+	adapter := (&AWSComprehendAdapter{client: client}).
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	adapter.DetectLanguage(context.Background(), "Hello there")
+	adapter.DetectLanguages(context.Background(), []domain.Text{"Hello there", "Hi"})
+
+	spans := recorder.Ended()
+	expected := []string{"AWSComprehendAdapter.DetectDominantLanguage", "AWSComprehendAdapter.BatchDetectDominantLanguage"}
+	if len(spans) != len(expected) {
+		t.Fatalf("Expected %d spans, got %d", len(expected), len(spans))
+	}
+	for i, span := range spans {
+		if span.Name() != expected[i] {
+			t.Errorf("Expected span %s, got %s", expected[i], span.Name())
+		}
+		if span.Status().Code != codes.Error {
+			t.Errorf("Expected an error status on %s, got %v", span.Name(), span.Status())
+		}
+	}
+
+	if size := spanAttributes(spans[1])[attrBatchSize].AsInt64(); size != 1 {
+		t.Errorf("Expected a batch of 1 document sent, got %d", size)
+	}
+}
+
+func TestTraceAttempts(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	_, span := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test").Start(context.Background(), "call")
+
+	r := &request.Request{}
+	traceAttempts(span)(r)
+
+	r.Error = errors.New("throttled")
+	r.Handlers.Retry.Run(r)
+	r.RetryCount = 1
+	r.Error = nil
+	r.Handlers.Complete.Run(r)
+	span.End()
+
+	ended := recorder.Ended()[0]
+	if events := ended.Events(); len(events) != 1 || events[0].Name != "attempt failed" {
+		t.Errorf("Expected one failed attempt event, got %v", events)
+	}
+	if retries := spanAttributes(ended)[attrRetryCount].AsInt64(); retries != 1 {
+		t.Errorf("Expected retry count 1, got %d", retries)
+	}
+}
@@ -0,0 +1,200 @@
+package audit
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rotatedTimeFormat names rotated files after the moment they were closed
+const rotatedTimeFormat = "20060102T150405.000Z"
+
+// RotatingFile is an append-only file that is moved aside once it grows past
+// a size or has been open for an interval. Rotated files are named after the
+// time they were closed, next to the active file, and optionally gzipped in
+// the background. Rotation happens between writes, so a single write is never split.
+type RotatingFile struct {
+	path     string
+	maxSize  int64
+	maxAge   time.Duration
+	compress bool
+	now      func() time.Time
+
+	mu     sync.Mutex
+	file   *os.File
+	size   int64
+	opened time.Time
+
+	compressing sync.WaitGroup
+}
+
+// NewRotatingFile opens or creates the file at path. A zero maxSize or maxAge
+// disables that kind of rotation.
+func NewRotatingFile(path string, maxSize int64, maxAge time.Duration, compress bool) (*RotatingFile, error) {
+	r := &RotatingFile{
+		path:     path,
+		maxSize:  maxSize,
+		maxAge:   maxAge,
+		compress: compress,
+		now:      time.Now,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write appends p to the active file, rotating it first when it is full or too old
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if r.size > 0 && r.due(len(p)) {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close closes the active file without rotating it and waits for rotated
+// files to be compressed
+func (r *RotatingFile) Close() error {
+	defer r.compressing.Wait()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// due reports whether the active file must be rotated before writing n more bytes
+func (r *RotatingFile) due(n int) bool {
+	if r.maxSize > 0 && r.size+int64(n) > r.maxSize {
+		return true
+	}
+	return r.maxAge > 0 && r.now().Sub(r.opened) >= r.maxAge
+}
+
+// open opens the active file for appending
+func (r *RotatingFile) open() error {
+	if dir := filepath.Dir(r.path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create audit directory: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat audit file: %w", err)
+	}
+
+	r.file = file
+	r.size = info.Size()
+	r.opened = r.now()
+	return nil
+}
+
+// rotate moves the active file aside and opens a new one. Records keep going
+// to the active file when it cannot be moved. Rotated files are compressed
+// off the write path, and one that cannot be compressed is kept as it is.
+func (r *RotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file: %w", err)
+	}
+	r.file = nil
+
+	rotated := r.rotatedName()
+	renameErr := os.Rename(r.path, rotated)
+	if err := r.open(); err != nil {
+		return err
+	}
+	if renameErr != nil {
+		slog.Warn("Failed to rotate audit file", "file", r.path, "error", renameErr)
+		return nil
+	}
+
+	if r.compress {
+		r.compressing.Add(1)
+		go func() {
+			defer r.compressing.Done()
+			if err := gzipFile(rotated); err != nil {
+				slog.Warn("Failed to compress audit file", "file", rotated, "error", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// rotatedName returns an unused name for the active file once it is closed,
+// like detections-20240102T150405.000Z.jsonl for detections.jsonl
+func (r *RotatingFile) rotatedName() string {
+	ext := filepath.Ext(r.path)
+	base := strings.TrimSuffix(r.path, ext)
+	stamp := r.now().UTC().Format(rotatedTimeFormat)
+
+	name := fmt.Sprintf("%s-%s%s", base, stamp, ext)
+	for i := 1; exists(name) || exists(name+".gz"); i++ {
+		name = fmt.Sprintf("%s-%s-%d%s", base, stamp, i, ext)
+	}
+	return name
+}
+
+// exists reports whether a file exists at path
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// gzipFile replaces a file with its gzipped copy
+func gzipFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open rotated audit file: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to create compressed audit file: %w", err)
+	}
+
+	zw := gzip.NewWriter(dst)
+	zw.Name = filepath.Base(path)
+	_, err = io.Copy(zw, src)
+	if closeErr := zw.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path + ".gz")
+		return fmt.Errorf("failed to compress audit file: %w", err)
+	}
+
+	return os.Remove(path)
+}
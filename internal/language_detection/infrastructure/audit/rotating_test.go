@@ -0,0 +1,189 @@
+package audit
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rotatedFiles returns the rotated files next to path, oldest first
+func rotatedFiles(t *testing.T, path string) []string {
+	t.Helper()
+	matches, err := filepath.Glob(strings.TrimSuffix(path, ".jsonl") + "-*")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	sort.Strings(matches)
+	return matches
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return string(content)
+}
+
+func TestRotatingFile_RotatesBySize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "detections.jsonl")
+	file, err := NewRotatingFile(path, 10, 0, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer file.Close()
+
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	file.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	for _, line := range []string{"first\n", "second\n", "third\n"} {
+		if _, err := file.Write([]byte(line)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	rotated := rotatedFiles(t, path)
+	if len(rotated) != 2 {
+		t.Fatalf("Expected 2 rotated files, got %v", rotated)
+	}
+	if got := readFile(t, rotated[0]) + readFile(t, rotated[1]); got != "first\nsecond\n" {
+		t.Errorf("Expected the first two lines in the rotated files, got %q", got)
+	}
+	if got := readFile(t, path); got != "third\n" {
+		t.Errorf("Expected the last line in the active file, got %q", got)
+	}
+}
+
+func TestRotatingFile_OversizedWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "detections.jsonl")
+	file, err := NewRotatingFile(path, 4, 0, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer file.Close()
+
+	// A write larger than the limit still goes whole into an empty file
+	if _, err := file.Write([]byte("too long\n")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if rotated := rotatedFiles(t, path); len(rotated) != 0 {
+		t.Errorf("Expected no rotation of an empty file, got %v", rotated)
+	}
+	if got := readFile(t, path); got != "too long\n" {
+		t.Errorf("Expected the whole line, got %q", got)
+	}
+}
+
+func TestRotatingFile_RotatesByAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "detections.jsonl")
+	file, err := NewRotatingFile(path, 0, time.Hour, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer file.Close()
+
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	file.now = func() time.Time { return now }
+	file.opened = now
+
+	file.Write([]byte("old\n"))
+	now = now.Add(30 * time.Minute)
+	file.Write([]byte("recent\n"))
+	if rotated := rotatedFiles(t, path); len(rotated) != 0 {
+		t.Fatalf("Expected no rotation within the interval, got %v", rotated)
+	}
+
+	now = now.Add(30 * time.Minute)
+	file.Write([]byte("new\n"))
+
+	rotated := rotatedFiles(t, path)
+	if len(rotated) != 1 || filepath.Base(rotated[0]) != "detections-20240102T160405.000Z.jsonl" {
+		t.Fatalf("Expected one file named after the rotation time, got %v", rotated)
+	}
+	if got := readFile(t, rotated[0]); got != "old\nrecent\n" {
+		t.Errorf("Expected the earlier lines in the rotated file, got %q", got)
+	}
+	if got := readFile(t, path); got != "new\n" {
+		t.Errorf("Expected the new line in the active file, got %q", got)
+	}
+}
+
+func TestRotatingFile_Compress(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "detections.jsonl")
+	file, err := NewRotatingFile(path, 8, 0, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	file.Write([]byte("first\n"))
+	file.Write([]byte("second\n"))
+
+	// Compression runs in the background; Close waits for it
+	if err := file.Close(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	rotated := rotatedFiles(t, path)
+	if len(rotated) != 1 || !strings.HasSuffix(rotated[0], ".jsonl.gz") {
+		t.Fatalf("Expected one gzipped file, got %v", rotated)
+	}
+
+	compressed, err := os.Open(rotated[0])
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer compressed.Close()
+	reader, err := gzip.NewReader(compressed)
+	if err != nil {
+		t.Fatalf("Expected a gzip file, got %v", err)
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(content) != "first\n" {
+		t.Errorf("Expected the first line, got %q", content)
+	}
+}
+
+func TestRotatingFile_AppendsToExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "detections.jsonl")
+	if err := os.WriteFile(path, []byte("before restart\n"), 0o640); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	file, err := NewRotatingFile(path, 20, 0, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer file.Close()
+
+	// The existing content counts towards the size limit
+	file.Write([]byte("after restart\n"))
+
+	rotated := rotatedFiles(t, path)
+	if len(rotated) != 1 || readFile(t, rotated[0]) != "before restart\n" {
+		t.Errorf("Expected the earlier content to be rotated, got %v", rotated)
+	}
+}
+
+func TestRotatingFile_WriteAfterClose(t *testing.T) {
+	file, err := NewRotatingFile(filepath.Join(t.TempDir(), "detections.jsonl"), 0, 0, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	file.Close()
+
+	if _, err := file.Write([]byte("late\n")); err == nil {
+		t.Error("Expected an error, got nil")
+	}
+}
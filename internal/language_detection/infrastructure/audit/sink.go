@@ -0,0 +1,100 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// Sink writes audit records as JSON lines from a background goroutine. Records
+// wait in a bounded queue; when it is full they are dropped and counted
+// rather than delaying the request that produced them.
+type Sink struct {
+	writer  io.WriteCloser
+	records chan domain.AuditRecord
+	done    chan struct{}
+	dropped atomic.Uint64
+	written atomic.Uint64
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewSink starts writing records to writer, queueing up to bufferSize of them
+func NewSink(writer io.WriteCloser, bufferSize int) *Sink {
+	s := &Sink{
+		writer:  writer,
+		records: make(chan domain.AuditRecord, bufferSize),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// RecordDetection queues a record without blocking
+func (s *Sink) RecordDetection(ctx context.Context, record domain.AuditRecord) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		s.dropped.Add(1)
+		return
+	}
+
+	select {
+	case s.records <- record:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// Dropped returns how many records were discarded because the queue was full or the sink closed
+func (s *Sink) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Written returns how many records were written
+func (s *Sink) Written() uint64 {
+	return s.written.Load()
+}
+
+// Close writes the queued records and closes the writer
+func (s *Sink) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.records)
+	s.mu.Unlock()
+
+	<-s.done
+	return s.writer.Close()
+}
+
+// run writes queued records until the sink is closed. Each record is written
+// with a single call, so a rotating writer never splits one across files.
+func (s *Sink) run() {
+	defer close(s.done)
+
+	for record := range s.records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			slog.Error("Failed to encode audit record", "error", err)
+			continue
+		}
+		line = append(line, '\n')
+
+		if _, err := s.writer.Write(line); err != nil {
+			slog.Error("Failed to write audit record", "document_id", record.DocumentID, "error", err)
+			continue
+		}
+		s.written.Add(1)
+	}
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// MockWriter collects writes in memory and can be held to simulate a slow disk
+type MockWriter struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+	writes int
+	hold   chan struct{}
+	closed bool
+}
+
+func (m *MockWriter) Write(p []byte) (int, error) {
+	if m.hold != nil {
+		<-m.hold
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.writes++
+	return m.buffer.Write(p)
+}
+
+func (m *MockWriter) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	return nil
+}
+
+func TestSink_WritesJSONLines(t *testing.T) {
+	writer := &MockWriter{}
+	sink := NewSink(writer, 10)
+
+	sink.RecordDetection(context.Background(), domain.AuditRecord{DocumentID: "doc-1", LanguageCode: "fr-FR", Provider: "fallback"})
+	sink.RecordDetection(context.Background(), domain.AuditRecord{DocumentID: "doc-2", LanguageCode: "es-ES", Provider: "aws-comprehend"})
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !writer.closed {
+		t.Error("Expected the writer to be closed")
+	}
+	if writer.writes != 2 {
+		t.Errorf("Expected one write per record, got %d", writer.writes)
+	}
+
+	var records []domain.AuditRecord
+	scanner := bufio.NewScanner(&writer.buffer)
+	for scanner.Scan() {
+		var record domain.AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Expected a JSON line, got %q: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 || records[0].DocumentID != "doc-1" || records[1].LanguageCode != "es-ES" {
+		t.Errorf("Expected both records in order, got %+v", records)
+	}
+	if sink.Written() != 2 || sink.Dropped() != 0 {
+		t.Errorf("Expected 2 written and none dropped, got %d and %d", sink.Written(), sink.Dropped())
+	}
+}
+
+func TestSink_DropsWhenFull(t *testing.T) {
+	writer := &MockWriter{hold: make(chan struct{})}
+	sink := NewSink(writer, 2)
+
+	// The first record is held in the writer and two fill the queue; the
+	// rest must be dropped without blocking
+	for i := 0; i < 10; i++ {
+		sink.RecordDetection(context.Background(), domain.AuditRecord{DocumentID: "doc"})
+	}
+	close(writer.hold)
+	sink.Close()
+
+	if sink.Written()+sink.Dropped() != 10 {
+		t.Errorf("Expected every record to be written or dropped, got %d and %d", sink.Written(), sink.Dropped())
+	}
+	if sink.Dropped() < 7 {
+		t.Errorf("Expected at least 7 dropped records, got %d", sink.Dropped())
+	}
+}
+
+func TestSink_RecordAfterClose(t *testing.T) {
+	writer := &MockWriter{}
+	sink := NewSink(writer, 10)
+	sink.Close()
+
+	sink.RecordDetection(context.Background(), domain.AuditRecord{DocumentID: "late"})
+
+	if sink.Dropped() != 1 || writer.writes != 0 {
+		t.Errorf("Expected the late record to be dropped, got %d dropped and %d writes", sink.Dropped(), writer.writes)
+	}
+	if err := sink.Close(); err != nil {
+		t.Errorf("Expected a second close to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// APIKeyEntry describes one API key in the key file. Only the SHA-256 hash of
+// the key is stored.
+type APIKeyEntry struct {
+	ID     string `json:"id"`
+	Tenant string `json:"tenant"`
+	SHA256 string `json:"sha256"`
+	Admin  bool   `json:"admin,omitempty"`
+}
+
+// APIKeys authenticates callers by static API key
+type APIKeys struct {
+	byHash map[[sha256.Size]byte]domain.Principal
+}
+
+// LoadAPIKeys reads a JSON array of APIKeyEntry from a file
+func LoadAPIKeys(path string) (*APIKeys, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API key file: %w", err)
+	}
+
+	var entries []APIKeyEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse API key file: %w", err)
+	}
+	return NewAPIKeys(entries)
+}
+
+// NewAPIKeys creates an API key set from its entries
+func NewAPIKeys(entries []APIKeyEntry) (*APIKeys, error) {
+	keys := &APIKeys{byHash: make(map[[sha256.Size]byte]domain.Principal, len(entries))}
+	for i, entry := range entries {
+		if entry.ID == "" {
+			return nil, fmt.Errorf("API key %d has no id", i)
+		}
+
+		hash, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(entry.SHA256), "sha256:"))
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("API key %q: sha256 must be a hex-encoded SHA-256 hash", entry.ID)
+		}
+
+		var sum [sha256.Size]byte
+		copy(sum[:], hash)
+		if existing, ok := keys.byHash[sum]; ok {
+			return nil, fmt.Errorf("API key %q has the same hash as %q", entry.ID, existing.ID)
+		}
+		keys.byHash[sum] = domain.Principal{ID: entry.ID, TenantID: entry.Tenant, Method: domain.AuthMethodAPIKey, Admin: entry.Admin}
+	}
+	return keys, nil
+}
+
+// Authenticate returns the principal owning key
+func (k *APIKeys) Authenticate(key string) (domain.Principal, error) {
+	principal, ok := k.byHash[sha256.Sum256([]byte(key))]
+	if !ok {
+		return domain.Principal{}, fmt.Errorf("%w: unknown API key", domain.ErrUnauthenticated)
+	}
+	return principal, nil
+}
+
+// Len returns the number of keys
+func (k *APIKeys) Len() int {
+	return len(k.byHash)
+}
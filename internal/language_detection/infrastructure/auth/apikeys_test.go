@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestLoadAPIKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	content := `[
+		{"id": "billing", "tenant": "acme", "sha256": "` + hashKey("secret-1") + `"},
+		{"id": "ops", "sha256": "sha256:` + hashKey("secret-2") + `", "admin": true}
+	]`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+
+	keys, err := LoadAPIKeys(path)
+	if err != nil {
+		t.Fatalf("LoadAPIKeys() error = %v, want nil", err)
+	}
+	if keys.Len() != 2 {
+		t.Errorf("Expected 2 keys, got %d", keys.Len())
+	}
+
+	principal, err := keys.Authenticate("secret-1")
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v, want nil", err)
+	}
+	expected := domain.Principal{ID: "billing", TenantID: "acme", Method: domain.AuthMethodAPIKey}
+	if principal != expected {
+		t.Errorf("Expected principal %+v, got %+v", expected, principal)
+	}
+
+	if principal, err := keys.Authenticate("secret-2"); err != nil || principal.ID != "ops" || !principal.Admin {
+		t.Errorf("Expected the prefixed hash to match admin ops, got %+v (%v)", principal, err)
+	}
+
+	if _, err := keys.Authenticate("wrong"); !errors.Is(err, domain.ErrUnauthenticated) {
+		t.Errorf("Expected ErrUnauthenticated for an unknown key, got %v", err)
+	}
+}
+
+func TestNewAPIKeys_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []APIKeyEntry
+	}{
+		{"Missing id", []APIKeyEntry{{SHA256: hashKey("a")}}},
+		{"Not hex", []APIKeyEntry{{ID: "a", SHA256: "not-a-hash"}}},
+		{"Short hash", []APIKeyEntry{{ID: "a", SHA256: "abcd"}}},
+		{"Duplicate key", []APIKeyEntry{{ID: "a", SHA256: hashKey("x")}, {ID: "b", SHA256: hashKey("x")}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewAPIKeys(tt.entries); err == nil {
+				t.Error("Expected an error, got nil")
+			}
+		})
+	}
+}
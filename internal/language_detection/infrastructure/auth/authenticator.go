@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// Authenticator authenticates callers by API key or bearer token
+type Authenticator struct {
+	apiKeys *APIKeys
+	tokens  *JWTVerifier
+}
+
+// NewAuthenticator creates an authenticator. Either method may be nil to
+// disable it.
+func NewAuthenticator(apiKeys *APIKeys, tokens *JWTVerifier) *Authenticator {
+	return &Authenticator{apiKeys: apiKeys, tokens: tokens}
+}
+
+// Authenticate returns the caller presenting an API key, a bearer token or a
+// client certificate, in that order of precedence. The certificate must have
+// been verified during the TLS handshake.
+func (a *Authenticator) Authenticate(apiKey, bearerToken string, clientCert *x509.Certificate) (domain.Principal, error) {
+	switch {
+	case apiKey != "":
+		if a.apiKeys == nil {
+			return domain.Principal{}, fmt.Errorf("%w: API keys are not accepted", domain.ErrUnauthenticated)
+		}
+		return a.apiKeys.Authenticate(apiKey)
+	case bearerToken != "":
+		if a.tokens == nil {
+			return domain.Principal{}, fmt.Errorf("%w: bearer tokens are not accepted", domain.ErrUnauthenticated)
+		}
+		return a.tokens.Verify(bearerToken)
+	case clientCert != nil:
+		return CertificatePrincipal(clientCert), nil
+	default:
+		return domain.Principal{}, fmt.Errorf("%w: missing credentials", domain.ErrUnauthenticated)
+	}
+}
+
+// CertificatePrincipal identifies the holder of a verified client certificate
+// by its subject common name. The first organization of the subject names the
+// tenant.
+func CertificatePrincipal(cert *x509.Certificate) domain.Principal {
+	principal := domain.Principal{ID: cert.Subject.CommonName, Method: domain.AuthMethodClientCert}
+	if len(cert.Subject.Organization) > 0 {
+		principal.TenantID = cert.Subject.Organization[0]
+	}
+	return principal
+}
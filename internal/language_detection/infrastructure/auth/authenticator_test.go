@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	keys, err := NewAPIKeys([]APIKeyEntry{{ID: "billing", Tenant: "acme", SHA256: hashKey("secret")}})
+	if err != nil {
+		t.Fatalf("NewAPIKeys() error = %v, want nil", err)
+	}
+	issuer := newTestIssuer(t, "key-1")
+	verifier := newTestVerifier(t, issuer)
+	token := issuer.sign(validClaims(), map[string]any{"tenant": "globex"})
+
+	both := NewAuthenticator(keys, verifier)
+	if principal, err := both.Authenticate("secret", token, nil); err != nil || principal.Method != domain.AuthMethodAPIKey {
+		t.Errorf("Expected the API key to take precedence, got %+v (%v)", principal, err)
+	}
+	if principal, err := both.Authenticate("", token, nil); err != nil || principal.TenantID != "globex" {
+		t.Errorf("Expected the token's tenant globex, got %+v (%v)", principal, err)
+	}
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing", Organization: []string{"acme"}}}
+	principal, err := NewAuthenticator(nil, nil).Authenticate("", "", cert)
+	expected := domain.Principal{ID: "billing", TenantID: "acme", Method: domain.AuthMethodClientCert}
+	if err != nil || principal != expected {
+		t.Errorf("Expected principal %+v from the client certificate, got %+v (%v)", expected, principal, err)
+	}
+	if principal, err := both.Authenticate("secret", "", cert); err != nil || principal.Method != domain.AuthMethodAPIKey {
+		t.Errorf("Expected the API key to take precedence over the certificate, got %+v (%v)", principal, err)
+	}
+
+	tests := []struct {
+		name          string
+		authenticator *Authenticator
+		apiKey        string
+		token         string
+	}{
+		{"Missing credentials", both, "", ""},
+		{"Wrong API key", both, "wrong", token},
+		{"API keys disabled", NewAuthenticator(nil, verifier), "secret", ""},
+		{"Tokens disabled", NewAuthenticator(keys, nil), "", token},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.authenticator.Authenticate(tt.apiKey, tt.token, nil); !errors.Is(err, domain.ErrUnauthenticated) {
+				t.Errorf("Expected ErrUnauthenticated, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// clockSkew is the leeway allowed when checking token lifetimes
+const clockSkew = time.Minute
+
+// signatureAlgorithms are the accepted JWT signature algorithms
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTVerifier authenticates callers by JWTs signed with the keys of a JWKS
+type JWTVerifier struct {
+	keys        jose.JSONWebKeySet
+	issuer      string
+	audience    string
+	tenantClaim string
+	now         func() time.Time
+}
+
+// LoadJWTVerifier reads the verification keys from a local JWKS file. Empty
+// issuer and audience are not checked. tenantClaim names the claim holding
+// the tenant of the caller.
+func LoadJWTVerifier(path, issuer, audience, tenantClaim string) (*JWTVerifier, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file: %w", err)
+	}
+	return NewJWTVerifier(keys, issuer, audience, tenantClaim)
+}
+
+// NewJWTVerifier creates a verifier for the public keys of a key set
+func NewJWTVerifier(keys jose.JSONWebKeySet, issuer, audience, tenantClaim string) (*JWTVerifier, error) {
+	if len(keys.Keys) == 0 {
+		return nil, fmt.Errorf("JWKS contains no keys")
+	}
+
+	public := jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(keys.Keys))}
+	for _, key := range keys.Keys {
+		if !key.IsPublic() {
+			key = key.Public()
+		}
+		if !key.Valid() {
+			return nil, fmt.Errorf("JWKS key %q is not a valid public key", key.KeyID)
+		}
+		public.Keys = append(public.Keys, key)
+	}
+
+	return &JWTVerifier{
+		keys:        public,
+		issuer:      issuer,
+		audience:    audience,
+		tenantClaim: tenantClaim,
+		now:         time.Now,
+	}, nil
+}
+
+// Verify checks the signature and claims of a token and returns its subject
+func (v *JWTVerifier) Verify(token string) (domain.Principal, error) {
+	parsed, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return domain.Principal{}, fmt.Errorf("%w: malformed token", domain.ErrUnauthenticated)
+	}
+
+	key, err := v.keyFor(parsed)
+	if err != nil {
+		return domain.Principal{}, err
+	}
+
+	var claims jwt.Claims
+	var custom map[string]any
+	if err := parsed.Claims(key, &claims, &custom); err != nil {
+		return domain.Principal{}, fmt.Errorf("%w: invalid token signature", domain.ErrUnauthenticated)
+	}
+
+	expected := jwt.Expected{Issuer: v.issuer, Time: v.now()}
+	if v.audience != "" {
+		expected.AnyAudience = jwt.Audience{v.audience}
+	}
+	if err := claims.ValidateWithLeeway(expected, clockSkew); err != nil {
+		return domain.Principal{}, fmt.Errorf("%w: %v", domain.ErrUnauthenticated, err)
+	}
+	if claims.Expiry == nil {
+		return domain.Principal{}, fmt.Errorf("%w: token has no expiry", domain.ErrUnauthenticated)
+	}
+	if claims.Subject == "" {
+		return domain.Principal{}, fmt.Errorf("%w: token has no subject", domain.ErrUnauthenticated)
+	}
+
+	tenant, _ := custom[v.tenantClaim].(string)
+	scope, _ := custom["scope"].(string)
+	return domain.Principal{
+		ID:       claims.Subject,
+		TenantID: tenant,
+		Method:   domain.AuthMethodJWT,
+		Admin:    slices.Contains(strings.Fields(scope), domain.ScopeAdmin),
+	}, nil
+}
+
+// keyFor returns the key named by the token header, or the only key of the set
+func (v *JWTVerifier) keyFor(token *jwt.JSONWebToken) (jose.JSONWebKey, error) {
+	var kid string
+	if len(token.Headers) > 0 {
+		kid = token.Headers[0].KeyID
+	}
+
+	if kid == "" {
+		if len(v.keys.Keys) == 1 {
+			return v.keys.Keys[0], nil
+		}
+		return jose.JSONWebKey{}, fmt.Errorf("%w: token has no key ID", domain.ErrUnauthenticated)
+	}
+
+	keys := v.keys.Key(kid)
+	if len(keys) == 0 {
+		return jose.JSONWebKey{}, fmt.Errorf("%w: unknown signing key %q", domain.ErrUnauthenticated, kid)
+	}
+	return keys[0], nil
+}
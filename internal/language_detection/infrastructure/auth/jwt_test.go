@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// testIssuer signs tokens for the verifier under test
+type testIssuer struct {
+	t   *testing.T
+	key *ecdsa.PrivateKey
+	kid string
+}
+
+func newTestIssuer(t *testing.T, kid string) *testIssuer {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	return &testIssuer{t: t, key: key, kid: kid}
+}
+
+func (i *testIssuer) jwk() jose.JSONWebKey {
+	return jose.JSONWebKey{Key: &i.key.PublicKey, KeyID: i.kid, Algorithm: string(jose.ES256), Use: "sig"}
+}
+
+func (i *testIssuer) sign(claims jwt.Claims, extra map[string]any) string {
+	i.t.Helper()
+
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if i.kid != "" {
+		opts = opts.WithHeader("kid", i.kid)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: i.key}, opts)
+	if err != nil {
+		i.t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	token, err := jwt.Signed(signer).Claims(claims).Claims(extra).Serialize()
+	if err != nil {
+		i.t.Fatalf("Failed to sign token: %v", err)
+	}
+	return token
+}
+
+var testNow = time.Date(2026, 4, 15, 12, 0, 0, 0, time.UTC)
+
+func validClaims() jwt.Claims {
+	return jwt.Claims{
+		Subject:  "billing",
+		Issuer:   "https://auth.example.com",
+		Audience: jwt.Audience{"language-detection"},
+		Expiry:   jwt.NewNumericDate(testNow.Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(testNow),
+	}
+}
+
+func newTestVerifier(t *testing.T, issuers ...*testIssuer) *JWTVerifier {
+	t.Helper()
+
+	var keys jose.JSONWebKeySet
+	for _, issuer := range issuers {
+		keys.Keys = append(keys.Keys, issuer.jwk())
+	}
+	verifier, err := NewJWTVerifier(keys, "https://auth.example.com", "language-detection", "tenant")
+	if err != nil {
+		t.Fatalf("NewJWTVerifier() error = %v, want nil", err)
+	}
+	verifier.now = func() time.Time { return testNow }
+	return verifier
+}
+
+func TestJWTVerifier_Verify(t *testing.T) {
+	issuer := newTestIssuer(t, "key-1")
+	verifier := newTestVerifier(t, issuer, newTestIssuer(t, "key-2"))
+
+	principal, err := verifier.Verify(issuer.sign(validClaims(), map[string]any{"tenant": "acme"}))
+	if err != nil {
+		t.Fatalf("Verify() error = %v, want nil", err)
+	}
+
+	expected := domain.Principal{ID: "billing", TenantID: "acme", Method: domain.AuthMethodJWT}
+	if principal != expected {
+		t.Errorf("Expected principal %+v, got %+v", expected, principal)
+	}
+
+	// The admin scope makes the caller an admin
+	principal, err = verifier.Verify(issuer.sign(validClaims(), map[string]any{"tenant": "acme", "scope": "detect admin"}))
+	if err != nil || !principal.Admin {
+		t.Errorf("Expected an admin principal, got %+v (%v)", principal, err)
+	}
+}
+
+func TestJWTVerifier_Rejects(t *testing.T) {
+	issuer := newTestIssuer(t, "key-1")
+	verifier := newTestVerifier(t, issuer)
+
+	expired := validClaims()
+	expired.Expiry = jwt.NewNumericDate(testNow.Add(-time.Hour))
+	noExpiry := validClaims()
+	noExpiry.Expiry = nil
+	wrongAudience := validClaims()
+	wrongAudience.Audience = jwt.Audience{"other"}
+	wrongIssuer := validClaims()
+	wrongIssuer.Issuer = "https://evil.example.com"
+	noSubject := validClaims()
+	noSubject.Subject = ""
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"Malformed", "not.a.token"},
+		{"Expired", issuer.sign(expired, nil)},
+		{"No expiry", issuer.sign(noExpiry, nil)},
+		{"Wrong audience", issuer.sign(wrongAudience, nil)},
+		{"Wrong issuer", issuer.sign(wrongIssuer, nil)},
+		{"No subject", issuer.sign(noSubject, nil)},
+		{"Unknown key", newTestIssuer(t, "key-9").sign(validClaims(), nil)},
+		{"Forged signature", newTestIssuer(t, "key-1").sign(validClaims(), nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verifier.Verify(tt.token); !errors.Is(err, domain.ErrUnauthenticated) {
+				t.Errorf("Expected ErrUnauthenticated, got %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadJWTVerifier(t *testing.T) {
+	issuer := newTestIssuer(t, "")
+	data, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{issuer.jwk()}})
+	if err != nil {
+		t.Fatalf("Failed to encode JWKS: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Failed to write JWKS: %v", err)
+	}
+
+	verifier, err := LoadJWTVerifier(path, "", "", "tenant")
+	if err != nil {
+		t.Fatalf("LoadJWTVerifier() error = %v, want nil", err)
+	}
+
+	// Without a key ID the only key of the set is used
+	claims := jwt.Claims{Subject: "ops", Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))}
+	principal, err := verifier.Verify(issuer.sign(claims, nil))
+	if err != nil {
+		t.Fatalf("Verify() error = %v, want nil", err)
+	}
+	if principal.ID != "ops" || principal.TenantID != "" {
+		t.Errorf("Expected principal ops without a tenant, got %+v", principal)
+	}
+
+	if _, err := LoadJWTVerifier(filepath.Join(t.TempDir(), "missing.json"), "", "", "tenant"); err == nil {
+		t.Error("Expected an error for a missing JWKS file")
+	}
+}
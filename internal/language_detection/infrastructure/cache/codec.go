@@ -0,0 +1,160 @@
+package cache
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// codecVersion is the first byte of every encoded response. Entries with
+// another version are treated as missing, so the format can change without
+// flushing a shared cache.
+const codecVersion = 1
+
+// errCorruptEntry is returned for entries that cannot be decoded
+var errCorruptEntry = errors.New("corrupt cache entry")
+
+// EncodeResponse serialises the cacheable part of a response compactly:
+// the language, confidence, alternatives, provider, details and explanation.
+// Per-request fields such as the document ID and processing time are left out.
+func EncodeResponse(response *domain.LanguageDetectionResponse) ([]byte, error) {
+	buf := []byte{codecVersion}
+	buf = appendString(buf, string(response.LanguageCode))
+	buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(response.Confidence)))
+
+	buf = binary.AppendUvarint(buf, uint64(len(response.Alternatives)))
+	for _, alt := range response.Alternatives {
+		buf = appendString(buf, string(alt.LanguageCode))
+		buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(alt.Confidence)))
+	}
+
+	buf = appendString(buf, response.Metadata.Provider)
+	buf = binary.AppendUvarint(buf, uint64(len(response.Metadata.Details)))
+	for key, value := range response.Metadata.Details {
+		buf = appendString(buf, key)
+		buf = appendString(buf, value)
+	}
+
+	// Explanations are rare and deeply nested, so they are stored as JSON
+	var explanation []byte
+	if response.Explanation != nil {
+		var err error
+		if explanation, err = json.Marshal(response.Explanation); err != nil {
+			return nil, fmt.Errorf("encoding explanation: %w", err)
+		}
+	}
+	buf = appendString(buf, string(explanation))
+
+	return buf, nil
+}
+
+// DecodeResponse reverses EncodeResponse
+func DecodeResponse(data []byte) (*domain.LanguageDetectionResponse, error) {
+	if len(data) == 0 || data[0] != codecVersion {
+		return nil, fmt.Errorf("%w: unknown version", errCorruptEntry)
+	}
+
+	r := &reader{data: data[1:]}
+	response := &domain.LanguageDetectionResponse{
+		LanguageCode: domain.LanguageCode(r.string()),
+		Confidence:   domain.Confidence(r.float32()),
+	}
+
+	if n := r.count(); n > 0 {
+		response.Alternatives = make([]domain.LanguageAlternative, 0, n)
+		for i := 0; i < n; i++ {
+			response.Alternatives = append(response.Alternatives, domain.LanguageAlternative{
+				LanguageCode: domain.LanguageCode(r.string()),
+				Confidence:   domain.Confidence(r.float32()),
+			})
+		}
+	}
+
+	response.Metadata.Provider = r.string()
+	if n := r.count(); n > 0 {
+		response.Metadata.Details = make(map[string]string, n)
+		for i := 0; i < n; i++ {
+			key := r.string()
+			response.Metadata.Details[key] = r.string()
+		}
+	}
+
+	explanation := r.string()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if len(r.data) > 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", errCorruptEntry, len(r.data))
+	}
+
+	if explanation != "" {
+		response.Explanation = &domain.Explanation{}
+		if err := json.Unmarshal([]byte(explanation), response.Explanation); err != nil {
+			return nil, fmt.Errorf("%w: %v", errCorruptEntry, err)
+		}
+	}
+
+	return response, nil
+}
+
+// appendString appends a length-prefixed string
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// reader decodes the fields of an entry, remembering the first error
+type reader struct {
+	data []byte
+	err  error
+}
+
+func (r *reader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = fmt.Errorf("%w: truncated length", errCorruptEntry)
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+// count reads a length that cannot exceed the remaining bytes
+func (r *reader) count() int {
+	n := r.uvarint()
+	if n > uint64(len(r.data)) {
+		r.err = fmt.Errorf("%w: length %d exceeds entry", errCorruptEntry, n)
+		return 0
+	}
+	return int(n)
+}
+
+func (r *reader) string() string {
+	n := r.count()
+	if r.err != nil {
+		return ""
+	}
+	s := string(r.data[:n])
+	r.data = r.data[n:]
+	return s
+}
+
+func (r *reader) float32() float32 {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.data) < 4 {
+		r.err = fmt.Errorf("%w: truncated number", errCorruptEntry)
+		return 0
+	}
+	v := math.Float32frombits(binary.LittleEndian.Uint32(r.data))
+	r.data = r.data[4:]
+	return v
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func TestCodec_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *domain.LanguageDetectionResponse
+	}{
+		{"Minimal", &domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.75}},
+		{"Full", &domain.LanguageDetectionResponse{
+			LanguageCode: "es-ES",
+			Confidence:   0.5,
+			Alternatives: []domain.LanguageAlternative{
+				{LanguageCode: "pt-PT", Confidence: 0.25},
+				{LanguageCode: "it-IT", Confidence: 0.125},
+			},
+			Metadata: domain.ProcessingMetadata{
+				Provider: "fallback",
+				Details:  map[string]string{"matched_words": "3"},
+			},
+			Explanation: &domain.Explanation{
+				Preprocessing: []string{"lowercase"},
+				Candidates: []domain.CandidateEvidence{{
+					LanguageCode: "es-ES",
+					Score:        0.5,
+					Features:     []domain.FeatureContribution{{Feature: "hola", Kind: domain.FeatureKindWord, Count: 1, Contribution: 0.5}},
+				}},
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := EncodeResponse(tt.response)
+			if err != nil {
+				t.Fatalf("EncodeResponse() error = %v", err)
+			}
+
+			got, err := DecodeResponse(data)
+			if err != nil {
+				t.Fatalf("DecodeResponse() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.response) {
+				t.Errorf("Expected %+v, got %+v", tt.response, got)
+			}
+		})
+	}
+}
+
+func TestCodec_OmitsRequestFields(t *testing.T) {
+	data, _ := EncodeResponse(&domain.LanguageDetectionResponse{
+		LanguageCode: "en-US",
+		DocumentID:   "doc-1",
+		Metadata:     domain.ProcessingMetadata{ProcessingTimeMs: 12, ServiceVersion: "1.0.0"},
+	})
+
+	got, err := DecodeResponse(data)
+	if err != nil {
+		t.Fatalf("DecodeResponse() error = %v", err)
+	}
+
+	if got.DocumentID != "" || got.Metadata.ProcessingTimeMs != 0 || got.Metadata.ServiceVersion != "" {
+		t.Errorf("Expected per-request fields to be dropped, got %+v", got)
+	}
+}
+
+func TestCodec_Corrupt(t *testing.T) {
+	valid, _ := EncodeResponse(&domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.9})
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"Empty", nil},
+		{"Unknown version", append([]byte{9}, valid[1:]...)},
+		{"Truncated", valid[:len(valid)-3]},
+		{"Trailing bytes", append(append([]byte(nil), valid...), 0)},
+		{"Oversized length", []byte{codecVersion, 0xff, 0x01}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeResponse(tt.data); !errors.Is(err, errCorruptEntry) {
+				t.Errorf("Expected errCorruptEntry, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// LRUCache is an in-process result cache holding at most size entries.
+// The least recently used entry is evicted first, and entries older than
+// the TTL are treated as missing.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	order   *list.List // most recently used first
+	stats   domain.CacheStats
+	now     func() time.Time
+}
+
+// lruEntry is a cached response and the time it expires
+type lruEntry struct {
+	key       string
+	response  *domain.LanguageDetectionResponse
+	expiresAt time.Time
+}
+
+// NewLRUCache creates a new LRU cache. A TTL of 0 keeps entries until they are evicted.
+func NewLRUCache(size int, ttl time.Duration) *LRUCache {
+	return &LRUCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached response of a key, if it is present and fresh
+func (c *LRUCache) Get(ctx context.Context, key string) (*domain.LanguageDetectionResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+
+	entry := element.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		c.stats.Misses++
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	c.stats.Hits++
+	return entry.response, true
+}
+
+// Set stores the response of a key, evicting the least recently used entry when full
+func (c *LRUCache) Set(ctx context.Context, key string, response *domain.LanguageDetectionResponse) {
+	if c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = c.now().Add(c.ttl)
+	}
+
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.response, entry.expiresAt = response, expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, response: response, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+		c.stats.Evictions++
+	}
+}
+
+// CacheStats returns the counters accumulated since the cache was created
+func (c *LRUCache) CacheStats() domain.CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = c.order.Len()
+	return stats
+}
+
+// remove drops an entry; the caller holds the lock
+func (c *LRUCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*lruEntry).key)
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func response(code domain.LanguageCode) *domain.LanguageDetectionResponse {
+	return &domain.LanguageDetectionResponse{LanguageCode: code, Confidence: 0.9}
+}
+
+func TestLRUCache_GetSet(t *testing.T) {
+	cache := NewLRUCache(2, time.Minute)
+	ctx := context.Background()
+
+	if _, ok := cache.Get(ctx, "a"); ok {
+		t.Error("Expected a miss on an empty cache")
+	}
+
+	cache.Set(ctx, "a", response("en-US"))
+	got, ok := cache.Get(ctx, "a")
+	if !ok || got.LanguageCode != "en-US" {
+		t.Errorf("Expected a hit for en-US, got %v (%v)", got, ok)
+	}
+
+	stats := cache.CacheStats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Entries != 1 {
+		t.Errorf("Expected 1 hit, 1 miss and 1 entry, got %+v", stats)
+	}
+}
+
+func TestLRUCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewLRUCache(2, 0)
+	ctx := context.Background()
+
+	cache.Set(ctx, "a", response("en-US"))
+	cache.Set(ctx, "b", response("fr-FR"))
+	cache.Get(ctx, "a")
+	cache.Set(ctx, "c", response("de-DE"))
+
+	if _, ok := cache.Get(ctx, "b"); ok {
+		t.Error("Expected b to be evicted")
+	}
+	if _, ok := cache.Get(ctx, "a"); !ok {
+		t.Error("Expected a to be kept after being used")
+	}
+	if _, ok := cache.Get(ctx, "c"); !ok {
+		t.Error("Expected c to be kept")
+	}
+
+	if stats := cache.CacheStats(); stats.Evictions != 1 || stats.Entries != 2 {
+		t.Errorf("Expected 1 eviction and 2 entries, got %+v", stats)
+	}
+}
+
+func TestLRUCache_Expires(t *testing.T) {
+	cache := NewLRUCache(10, time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	cache.Set(ctx, "a", response("en-US"))
+
+	now = now.Add(59 * time.Second)
+	if _, ok := cache.Get(ctx, "a"); !ok {
+		t.Error("Expected a hit before the TTL")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := cache.Get(ctx, "a"); ok {
+		t.Error("Expected a miss once the TTL has passed")
+	}
+
+	if stats := cache.CacheStats(); stats.Entries != 0 {
+		t.Errorf("Expected the expired entry to be removed, got %+v", stats)
+	}
+}
+
+func TestLRUCache_ZeroSize(t *testing.T) {
+	cache := NewLRUCache(0, time.Minute)
+	ctx := context.Background()
+
+	cache.Set(ctx, "a", response("en-US"))
+	if _, ok := cache.Get(ctx, "a"); ok {
+		t.Error("Expected a zero-size cache to store nothing")
+	}
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+const (
+	// redisKeyPrefix namespaces result entries in a shared Redis
+	redisKeyPrefix = "language-detection:result:"
+
+	// redisRetryInterval is how long Redis is skipped after a failed call, so
+	// an unreachable cache does not add its timeout to every request
+	redisRetryInterval = time.Second
+)
+
+// RedisCache is a result cache shared by all replicas. It fails open: when
+// Redis is unreachable, lookups are misses and stores are dropped.
+type RedisCache struct {
+	client  redis.UniversalClient
+	ttl     time.Duration
+	timeout time.Duration
+
+	mu        sync.Mutex
+	stats     domain.CacheStats
+	downUntil time.Time
+	now       func() time.Time
+}
+
+// NewRedisCache creates a new Redis cache whose entries expire after ttl.
+// Each call gives up after timeout.
+func NewRedisCache(client redis.UniversalClient, ttl, timeout time.Duration) *RedisCache {
+	return &RedisCache{
+		client:  client,
+		ttl:     ttl,
+		timeout: timeout,
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached response of a key, if Redis is reachable and holds it
+func (c *RedisCache) Get(ctx context.Context, key string) (*domain.LanguageDetectionResponse, bool) {
+	if !c.available() {
+		c.count(func(stats *domain.CacheStats) { stats.Misses++ })
+		return nil, false
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	data, err := c.client.Get(callCtx, redisKeyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		c.count(func(stats *domain.CacheStats) { stats.Misses++ })
+		return nil, false
+	}
+	if err != nil {
+		c.fail(ctx, "get", err)
+		return nil, false
+	}
+
+	response, err := DecodeResponse(data)
+	if err != nil {
+		c.count(func(stats *domain.CacheStats) { stats.Misses++; stats.Errors++ })
+		return nil, false
+	}
+
+	c.count(func(stats *domain.CacheStats) { stats.Hits++ })
+	return response, true
+}
+
+// Set stores the response of a key with the cache TTL
+func (c *RedisCache) Set(ctx context.Context, key string, response *domain.LanguageDetectionResponse) {
+	if !c.available() {
+		return
+	}
+
+	data, err := EncodeResponse(response)
+	if err != nil {
+		c.count(func(stats *domain.CacheStats) { stats.Errors++ })
+		return
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := c.client.Set(callCtx, redisKeyPrefix+key, data, c.ttl).Err(); err != nil {
+		c.fail(ctx, "set", err)
+	}
+}
+
+// CacheStats returns the counters accumulated since the cache was created.
+// Redis does not report the number of entries per prefix, so Entries is 0.
+func (c *RedisCache) CacheStats() domain.CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// available reports whether Redis should be tried, or is skipped after a recent failure
+func (c *RedisCache) available() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return !c.now().Before(c.downUntil)
+}
+
+// fail records a failed call and skips Redis for a while. Lookups that fail
+// count as misses. Calls that failed because the caller gave up say nothing
+// about Redis and do not make the other callers skip it.
+func (c *RedisCache) fail(ctx context.Context, op string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if op == "get" {
+		c.stats.Misses++
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	c.stats.Errors++
+
+	if !c.now().Before(c.downUntil) {
+		slog.Warn("Result cache unavailable", "operation", op, "retry_in", redisRetryInterval.String(), "error", err)
+	}
+	c.downUntil = c.now().Add(redisRetryInterval)
+}
+
+// count updates the counters under the lock
+func (c *RedisCache) count(update func(stats *domain.CacheStats)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	update(&c.stats)
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestRedisCache(t *testing.T) (*RedisCache, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1, ContextTimeoutEnabled: true})
+	t.Cleanup(func() { client.Close() })
+
+	return NewRedisCache(client, time.Minute, time.Second), server
+}
+
+func TestRedisCache_GetSet(t *testing.T) {
+	cache, server := newTestRedisCache(t)
+	ctx := context.Background()
+
+	if _, ok := cache.Get(ctx, "a"); ok {
+		t.Error("Expected a miss on an empty cache")
+	}
+
+	cache.Set(ctx, "a", response("en-US"))
+	got, ok := cache.Get(ctx, "a")
+	if !ok || got.LanguageCode != "en-US" {
+		t.Errorf("Expected a hit for en-US, got %v (%v)", got, ok)
+	}
+
+	if ttl := server.TTL(redisKeyPrefix + "a"); ttl != time.Minute {
+		t.Errorf("Expected entry TTL 1m, got %v", ttl)
+	}
+
+	server.FastForward(time.Minute)
+	if _, ok := cache.Get(ctx, "a"); ok {
+		t.Error("Expected a miss once the TTL has passed")
+	}
+
+	if stats := cache.CacheStats(); stats.Hits != 1 || stats.Misses != 2 || stats.Errors != 0 {
+		t.Errorf("Expected 1 hit and 2 misses, got %+v", stats)
+	}
+}
+
+func TestRedisCache_CorruptEntry(t *testing.T) {
+	cache, server := newTestRedisCache(t)
+	server.Set(redisKeyPrefix+"a", "not an entry")
+
+	if _, ok := cache.Get(context.Background(), "a"); ok {
+		t.Error("Expected a corrupt entry to be a miss")
+	}
+
+	if stats := cache.CacheStats(); stats.Errors != 1 {
+		t.Errorf("Expected 1 error, got %+v", stats)
+	}
+}
+
+func TestRedisCache_FailsOpen(t *testing.T) {
+	cache, server := newTestRedisCache(t)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	cache.Set(ctx, "a", response("en-US"))
+	server.Close()
+
+	if _, ok := cache.Get(ctx, "a"); ok {
+		t.Error("Expected a miss while Redis is down")
+	}
+	if stats := cache.CacheStats(); stats.Errors != 1 {
+		t.Fatalf("Expected 1 error, got %+v", stats)
+	}
+
+	// Redis is skipped until the retry interval has passed
+	cache.Set(ctx, "b", response("fr-FR"))
+	cache.Get(ctx, "a")
+	if stats := cache.CacheStats(); stats.Errors != 1 || stats.Misses != 2 {
+		t.Errorf("Expected Redis to be skipped, got %+v", stats)
+	}
+
+	if err := server.Restart(); err != nil {
+		t.Fatalf("Failed to restart Redis: %v", err)
+	}
+	now = now.Add(redisRetryInterval)
+
+	if _, ok := cache.Get(ctx, "a"); !ok {
+		t.Error("Expected a hit once Redis is back")
+	}
+}
+
+func TestRedisCache_CallerGivesUp(t *testing.T) {
+	cache, _ := newTestRedisCache(t)
+	cache.Set(context.Background(), "a", response("en-US"))
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+
+	for _, ctx := range []context.Context{cancelled, expired} {
+		if _, ok := cache.Get(ctx, "a"); ok {
+			t.Error("Expected a miss for a caller that gave up")
+		}
+		cache.Set(ctx, "b", response("fr-FR"))
+	}
+
+	// Other callers still use Redis
+	if _, ok := cache.Get(context.Background(), "a"); !ok {
+		t.Error("Expected a hit after callers gave up")
+	}
+	if stats := cache.CacheStats(); stats.Errors != 0 {
+		t.Errorf("Expected no errors, got %+v", stats)
+	}
+}
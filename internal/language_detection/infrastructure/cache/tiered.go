@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// TieredCache checks a local cache before a shared one. Shared hits are
+// copied to the local cache, and stores go to both.
+type TieredCache struct {
+	local  domain.ResultCache
+	shared domain.ResultCache
+}
+
+// NewTieredCache creates a new tiered cache
+func NewTieredCache(local, shared domain.ResultCache) *TieredCache {
+	return &TieredCache{
+		local:  local,
+		shared: shared,
+	}
+}
+
+// Get returns the cached response of a key from the first tier that holds it
+func (c *TieredCache) Get(ctx context.Context, key string) (*domain.LanguageDetectionResponse, bool) {
+	if response, ok := c.local.Get(ctx, key); ok {
+		return response, true
+	}
+
+	response, ok := c.shared.Get(ctx, key)
+	if ok {
+		c.local.Set(ctx, key, response)
+	}
+	return response, ok
+}
+
+// Set stores the response of a key in both tiers
+func (c *TieredCache) Set(ctx context.Context, key string, response *domain.LanguageDetectionResponse) {
+	c.local.Set(ctx, key, response)
+	c.shared.Set(ctx, key, response)
+}
+
+// CacheStats returns the local counters, with the shared ones under Shared
+func (c *TieredCache) CacheStats() domain.CacheStats {
+	var stats domain.CacheStats
+	if reporter, ok := c.local.(domain.CacheStatsReporter); ok {
+		stats = reporter.CacheStats()
+	}
+	if reporter, ok := c.shared.(domain.CacheStatsReporter); ok {
+		shared := reporter.CacheStats()
+		stats.Shared = &shared
+	}
+	return stats
+}
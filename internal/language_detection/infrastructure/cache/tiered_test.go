@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTieredCache(t *testing.T) {
+	shared, server := newTestRedisCache(t)
+	ctx := context.Background()
+
+	// Another replica stored the result
+	NewTieredCache(NewLRUCache(10, time.Minute), shared).Set(ctx, "a", response("en-US"))
+
+	local := NewLRUCache(10, time.Minute)
+	cache := NewTieredCache(local, shared)
+
+	got, ok := cache.Get(ctx, "a")
+	if !ok || got.LanguageCode != "en-US" {
+		t.Fatalf("Expected a shared hit for en-US, got %v (%v)", got, ok)
+	}
+
+	// The shared hit was copied to the local tier, which keeps serving while Redis is down
+	server.Close()
+	if _, ok := cache.Get(ctx, "a"); !ok {
+		t.Error("Expected a local hit while Redis is down")
+	}
+
+	cache.Set(ctx, "b", response("fr-FR"))
+	if _, ok := cache.Get(ctx, "b"); !ok {
+		t.Error("Expected stores to reach the local tier while Redis is down")
+	}
+
+	stats := cache.CacheStats()
+	if stats.Hits != 2 || stats.Shared == nil || stats.Shared.Hits != 1 {
+		t.Errorf("Expected 2 local hits and 1 shared hit, got %+v (shared %+v)", stats, stats.Shared)
+	}
+}
@@ -0,0 +1,146 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader serves a certificate and client CA pool that are reloaded when
+// their files change on disk, so rotated certificates are picked up without
+// a restart. Connections already established keep their certificate.
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+	minVersion   uint16
+
+	mu      sync.RWMutex
+	config  *tls.Config
+	modTime map[string]time.Time
+}
+
+// NewReloader loads a server certificate and key, and an optional client CA
+// bundle that clients must present a certificate from
+func NewReloader(certFile, keyFile, clientCAFile string, minVersion uint16) (*Reloader, error) {
+	r := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+		minVersion:   minVersion,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ServerConfig returns a TLS config that uses the latest loaded files for every handshake
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: r.minVersion,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return r.config, nil
+		},
+	}
+}
+
+// Reload reads the files again. The previous certificate stays in use if they are invalid.
+func (r *Reloader) Reload() error {
+	modTime, err := r.stat()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   r.minVersion,
+	}
+	if r.clientCAFile != "" {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("client CA file %s contains no certificates", r.clientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	r.mu.Lock()
+	r.config = config
+	r.modTime = modTime
+	r.mu.Unlock()
+	return nil
+}
+
+// Watch checks the files for changes every interval and reloads them until ctx is done
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.Reload(); err != nil {
+				slog.Error("Failed to reload TLS certificates, keeping the previous ones", "error", err)
+				continue
+			}
+			slog.Info("Reloaded TLS certificates", "cert_file", r.certFile)
+		}
+	}
+}
+
+// changed reports whether any file was modified since the last load
+func (r *Reloader) changed() bool {
+	modTime, err := r.stat()
+	if err != nil {
+		// A file may be missing for a moment while it is replaced
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for file, t := range modTime {
+		if !t.Equal(r.modTime[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// stat returns the modification time of every file. Symlinks are followed,
+// so swapping a mounted secret counts as a change.
+func (r *Reloader) stat() (map[string]time.Time, error) {
+	modTime := make(map[string]time.Time, 3)
+	for _, file := range []string{r.certFile, r.keyFile, r.clientCAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat %s: %w", file, err)
+		}
+		modTime[file] = info.ModTime()
+	}
+	return modTime, nil
+}
@@ -0,0 +1,243 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA issues certificates for the handshake tests
+type testCA struct {
+	t    *testing.T
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{t: t, cert: cert, key: key, pool: pool, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns the PEM certificate and key of a leaf certificate
+func (ca *testCA) issue(commonName string, serial int64, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	ca.t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		ca.t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		ca.t.Fatalf("Failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		ca.t.Fatalf("Failed to encode key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Failed to set the time of %s: %v", path, err)
+	}
+}
+
+// handshakeResult is the outcome of the server side of a handshake
+type handshakeResult struct {
+	peer *x509.Certificate
+	err  error
+}
+
+// handshake connects a client to a server and returns the server's leaf
+// certificate and the client certificate the server verified. It fails if
+// either side fails.
+func handshake(t *testing.T, server *tls.Config, client *tls.Config) (*x509.Certificate, *x509.Certificate, error) {
+	t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	// Pipes are unbuffered, so a side writing an alert nobody reads must give up
+	deadline := time.Now().Add(time.Second)
+	serverConn.SetDeadline(deadline)
+	clientConn.SetDeadline(deadline)
+
+	serverDone := make(chan handshakeResult, 1)
+	go func() {
+		conn := tls.Server(serverConn, server)
+		if err := conn.Handshake(); err != nil {
+			serverConn.Close()
+			serverDone <- handshakeResult{err: err}
+			return
+		}
+		var peer *x509.Certificate
+		if chains := conn.ConnectionState().VerifiedChains; len(chains) > 0 {
+			peer = chains[0][0]
+		}
+		serverDone <- handshakeResult{peer: peer}
+	}()
+
+	conn := tls.Client(clientConn, client)
+	if err := conn.Handshake(); err != nil {
+		clientConn.Close()
+		<-serverDone
+		return nil, nil, err
+	}
+	result := <-serverDone
+	if result.err != nil {
+		return nil, nil, result.err
+	}
+	return conn.ConnectionState().PeerCertificates[0], result.peer, nil
+}
+
+func TestReloader_MutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	certFile, keyFile, caFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+
+	serverCert, serverKey := ca.issue("server", 2, x509.ExtKeyUsageServerAuth)
+	start := time.Now().Add(-time.Minute)
+	writeFile(t, certFile, serverCert, start)
+	writeFile(t, keyFile, serverKey, start)
+	writeFile(t, caFile, ca.pem, start)
+
+	reloader, err := NewReloader(certFile, keyFile, caFile, tls.VersionTLS12)
+	if err != nil {
+		t.Fatalf("NewReloader() error = %v, want nil", err)
+	}
+
+	clientCertPEM, clientKeyPEM := ca.issue("billing", 3, x509.ExtKeyUsageClientAuth)
+	clientCert, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+	if err != nil {
+		t.Fatalf("Failed to load client certificate: %v", err)
+	}
+
+	client := &tls.Config{RootCAs: ca.pool, ServerName: "localhost", Certificates: []tls.Certificate{clientCert}}
+	_, peer, err := handshake(t, reloader.ServerConfig(), client)
+	if err != nil {
+		t.Fatalf("Handshake error = %v, want nil", err)
+	}
+	if peer == nil || peer.Subject.CommonName != "billing" {
+		t.Errorf("Expected the verified client certificate billing, got %v", peer)
+	}
+
+	// Clients without a certificate are rejected
+	anonymous := &tls.Config{RootCAs: ca.pool, ServerName: "localhost"}
+	if _, _, err := handshake(t, reloader.ServerConfig(), anonymous); err == nil {
+		t.Error("Expected a client without a certificate to be rejected")
+	}
+}
+
+func TestReloader_Watch(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	start := time.Now().Add(-time.Minute)
+	certPEM, keyPEM := ca.issue("server", 2, x509.ExtKeyUsageServerAuth)
+	writeFile(t, certFile, certPEM, start)
+	writeFile(t, keyFile, keyPEM, start)
+
+	reloader, err := NewReloader(certFile, keyFile, "", tls.VersionTLS12)
+	if err != nil {
+		t.Fatalf("NewReloader() error = %v, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.Watch(ctx, 10*time.Millisecond)
+
+	// A broken rotation keeps the previous certificate
+	writeFile(t, certFile, []byte("garbage"), start.Add(time.Second))
+	time.Sleep(50 * time.Millisecond)
+
+	client := &tls.Config{RootCAs: ca.pool, ServerName: "localhost"}
+	leaf, _, err := handshake(t, reloader.ServerConfig(), client)
+	if err != nil || leaf.SerialNumber.Int64() != 2 {
+		t.Fatalf("Expected the previous certificate after a failed reload, got %v (%v)", leaf, err)
+	}
+
+	certPEM, keyPEM = ca.issue("server", 4, x509.ExtKeyUsageServerAuth)
+	writeFile(t, keyFile, keyPEM, start.Add(2*time.Second))
+	writeFile(t, certFile, certPEM, start.Add(2*time.Second))
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		leaf, _, err := handshake(t, reloader.ServerConfig(), client)
+		if err == nil && leaf.SerialNumber.Int64() == 4 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the rotated certificate to be served, got %v (%v)", leaf, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNewReloader_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewReloader(filepath.Join(dir, "missing.crt"), filepath.Join(dir, "missing.key"), "", tls.VersionTLS12); err == nil {
+		t.Error("Expected an error for missing files")
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	if v, err := ParseVersion("1.3"); err != nil || v != tls.VersionTLS13 {
+		t.Errorf("Expected TLS 1.3, got %x (%v)", v, err)
+	}
+	if _, err := ParseVersion("1.0"); err == nil {
+		t.Error("Expected an error for TLS 1.0")
+	}
+}
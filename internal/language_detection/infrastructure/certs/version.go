@@ -0,0 +1,18 @@
+package certs
+
+import (
+	"crypto/tls"
+	"fmt"
+)
+
+// ParseVersion parses a minimum TLS version written as "1.2" or "1.3"
+func ParseVersion(version string) (uint16, error) {
+	switch version {
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unsupported TLS version %q, want 1.2 or 1.3", version)
+	}
+}
@@ -0,0 +1,355 @@
+package charset
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	unicodeenc "golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+const (
+	// maxSampleBytes bounds the prefix of the content used to score charsets
+	maxSampleBytes = 64 << 10
+
+	// maxCandidates is the number of candidates returned by DetectCharsets
+	maxCandidates = 3
+)
+
+// Frequent characters of each script. Text decoded with the right charset
+// hits these far more often than text decoded with a wrong one.
+const (
+	commonSimplified  = "的一是不了人我在有他这中大来上国个到说们为子和你地出道也时年会对生能就要以着过后里家都天"
+	commonTraditional = "的一是不了人我在有他這中大來上國個到說們為子和你地出道也時年會對生能就要以著過後裡家都天"
+	commonHangul      = "이다는의에가을하고지로기서한사도리니있어나들그것수국정오안문장일시대자게해보주아제면라인우전부만등상용간성원학적생요세입말"
+	commonCyrillic    = "оеаинтсрвлкмі"
+	commonGreek       = "αοιετσνηυρπκμλάέίόύήώς"
+	commonArabic      = "اليمونرتبةعدسفهكقأ"
+	commonHebrew      = "אבגדהוזחטיכךלמםנןסעפףצץקרשת"
+	commonThai        = "านรอกเงมยลวดทสตะิีัุ่้บคหพแไใโ"
+
+	hebrewFinals = "ךםןףץ"
+)
+
+// Characteristic letters of the Latin charsets
+const (
+	westernLetters  = "¿¡àáâãäåæçèéêëìíîïñòóôõöøùúûüýÿœšžßÀÁÂÃÄÅÆÇÈÉÊËÌÍÎÏÑÒÓÔÕÖØÙÚÛÜÝŒŠŽ"
+	centralLetters  = "ąćęłńóśźżčďěňřšťůžáéíýőűöüăâîșțĺľŕĄĆĘŁŃÓŚŹŻČĎĚŇŘŠŤŮŽÁÉÍÝŐŰÖÜĂÂÎȘȚĹĽŔ"
+	turkishLetters  = "çğıöşüâîûÇĞİÖŞÜÂÎÛ"
+	textPunctuation = "‘’‚“”„–—…«»€°§©®±·×÷"
+)
+
+// candidate is a legacy charset with a scoring function for its decoded runes.
+// weight returns how plausible a non-ASCII rune is in text of this charset, from 0 to 1.
+type candidate struct {
+	name      string
+	encoding  encoding.Encoding
+	languages []string
+	latin     bool   // non-ASCII letters are usually isolated within ASCII words
+	finals    string // letter forms that only occur at the end of a word
+	weight    func(r rune) float64
+}
+
+// candidates lists the legacy charsets in order of preference for ties
+var candidates = []candidate{
+	{name: "windows-1252", encoding: charmap.Windows1252, latin: true,
+		languages: []string{"en", "fr", "de", "es", "it", "pt", "nl", "da", "sv", "no", "fi", "ca", "is"},
+		weight:    latinWeight(westernLetters)},
+	{name: "windows-1250", encoding: charmap.Windows1250, latin: true,
+		languages: []string{"pl", "cs", "sk", "hu", "sl", "hr", "ro", "sq"},
+		weight:    latinWeight(centralLetters)},
+	{name: "iso-8859-2", encoding: charmap.ISO8859_2, latin: true,
+		languages: []string{"pl", "cs", "sk", "hu", "sl", "hr", "ro", "sq"},
+		weight:    latinWeight(centralLetters)},
+	{name: "windows-1254", encoding: charmap.Windows1254, latin: true,
+		languages: []string{"tr", "az"},
+		weight:    latinWeight(turkishLetters)},
+	{name: "windows-1251", encoding: charmap.Windows1251,
+		languages: []string{"ru", "uk", "be", "bg", "sr", "mk", "kk"},
+		weight:    alphabetWeight(unicode.Cyrillic, commonCyrillic)},
+	{name: "koi8-r", encoding: charmap.KOI8R,
+		languages: []string{"ru", "uk", "be", "bg"},
+		weight:    alphabetWeight(unicode.Cyrillic, commonCyrillic)},
+	{name: "iso-8859-5", encoding: charmap.ISO8859_5,
+		languages: []string{"ru", "uk", "be", "bg", "sr", "mk"},
+		weight:    alphabetWeight(unicode.Cyrillic, commonCyrillic)},
+	{name: "iso-8859-7", encoding: charmap.ISO8859_7,
+		languages: []string{"el"},
+		weight:    alphabetWeight(unicode.Greek, commonGreek)},
+	{name: "windows-1256", encoding: charmap.Windows1256,
+		languages: []string{"ar", "fa", "ur"},
+		weight:    alphabetWeight(unicode.Arabic, commonArabic)},
+	{name: "windows-1255", encoding: charmap.Windows1255,
+		languages: []string{"he", "yi"}, finals: hebrewFinals,
+		weight: alphabetWeight(unicode.Hebrew, commonHebrew)},
+	{name: "windows-874", encoding: charmap.Windows874,
+		languages: []string{"th"},
+		weight:    alphabetWeight(unicode.Thai, commonThai)},
+	{name: "shift_jis", encoding: japanese.ShiftJIS,
+		languages: []string{"ja"},
+		weight:    japaneseWeight},
+	{name: "euc-jp", encoding: japanese.EUCJP,
+		languages: []string{"ja"},
+		weight:    japaneseWeight},
+	{name: "gb18030", encoding: simplifiedchinese.GB18030,
+		languages: []string{"zh"},
+		weight:    chineseWeight(commonSimplified)},
+	{name: "big5", encoding: traditionalchinese.Big5,
+		languages: []string{"zh"},
+		weight:    chineseWeight(commonTraditional)},
+	{name: "euc-kr", encoding: korean.EUCKR,
+		languages: []string{"ko"},
+		weight:    koreanWeight(commonHangul)},
+}
+
+// Detector implements the CharsetDecoder interface with byte-order marks,
+// UTF-8 validation and a plausibility score of the text decoded from each
+// legacy charset
+type Detector struct{}
+
+// NewDetector creates a new charset detector
+func NewDetector() *Detector {
+	return &Detector{}
+}
+
+// DetectCharsets returns the plausible charsets of content, most likely first
+func (d *Detector) DetectCharsets(content []byte) []domain.CharsetCandidate {
+	if name, ok := bomCharset(content); ok {
+		return []domain.CharsetCandidate{{Charset: name, Confidence: 1}}
+	}
+
+	sample := content
+	if len(sample) > maxSampleBytes {
+		sample = sample[:maxSampleBytes]
+		// Do not count a multi-byte sequence cut at the end of the sample
+		for i := 0; i < utf8.UTFMax && len(sample) > 0 && !utf8.Valid(sample); i++ {
+			sample = sample[:len(sample)-1]
+		}
+	}
+
+	if utf8.Valid(sample) {
+		return []domain.CharsetCandidate{{Charset: domain.CharsetUTF8, Confidence: 1}}
+	}
+
+	var scored []domain.CharsetCandidate
+	for _, c := range candidates {
+		decoded, err := c.encoding.NewDecoder().Bytes(sample)
+		if err != nil {
+			continue
+		}
+		if score := c.score(string(decoded)); score > 0 {
+			scored = append(scored, domain.CharsetCandidate{
+				Charset:    c.name,
+				Confidence: domain.Confidence(score),
+				Languages:  c.languages,
+			})
+		}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].Confidence > scored[j].Confidence
+	})
+	if len(scored) > maxCandidates {
+		scored = scored[:maxCandidates]
+	}
+	return scored
+}
+
+// Decode transcodes content from the named charset to UTF-8. Names are
+// resolved with the WHATWG encoding labels, so "latin1" and "iso-8859-1"
+// decode as windows-1252.
+func (d *Detector) Decode(content []byte, charset string) (domain.Text, error) {
+	if strings.EqualFold(charset, domain.CharsetUTF8) || strings.EqualFold(charset, "utf8") {
+		content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+		if !utf8.Valid(content) {
+			return "", fmt.Errorf("%w: content is not valid UTF-8", domain.ErrUnknownCharset)
+		}
+		return domain.Text(content), nil
+	}
+
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", domain.ErrUnknownCharset, charset)
+	}
+
+	decoded, _, err := transform.Bytes(unicodeenc.BOMOverride(enc.NewDecoder()), content)
+	if err != nil {
+		return "", fmt.Errorf("%w: content is not valid %s: %v", domain.ErrUnknownCharset, charset, err)
+	}
+	return domain.Text(decoded), nil
+}
+
+// bomCharset recognises a Unicode byte-order mark
+func bomCharset(content []byte) (string, bool) {
+	switch {
+	case bytes.HasPrefix(content, []byte("\xef\xbb\xbf")):
+		return domain.CharsetUTF8, true
+	case bytes.HasPrefix(content, []byte("\xff\xfe")):
+		return "utf-16le", true
+	case bytes.HasPrefix(content, []byte("\xfe\xff")):
+		return "utf-16be", true
+	}
+	return "", false
+}
+
+// score rates decoded text from 0 to 1 by the plausibility of its non-ASCII
+// runes, weighted by the number of source bytes they stand for
+func (c candidate) score(decoded string) float64 {
+	runes := []rune(decoded)
+	var total, good float64
+
+	for i, r := range runes {
+		if r < utf8.RuneSelf {
+			continue
+		}
+
+		// Multi-byte charsets encode a rune in two bytes
+		size := 1.0
+		if r > 0x2FFF {
+			size = 2
+		}
+		total += size
+
+		weight := 0.0
+		if r != utf8.RuneError && !unicode.IsControl(r) && !unicode.Is(unicode.Co, r) {
+			weight = c.weight(r)
+		}
+
+		switch {
+		case c.latin && i > 0 && runes[i-1] >= utf8.RuneSelf:
+			// Runs of accented letters are rare in Latin-script languages
+			weight *= 0.5
+		case !c.latin && unicode.IsLetter(r) && (isASCIILetter(runes, i-1) || isASCIILetter(runes, i+1)):
+			// Other scripts do not share words with ASCII letters
+			weight = 0
+		case strings.ContainsRune(c.finals, r) && i+1 < len(runes) && unicode.IsLetter(runes[i+1]):
+			// A final letter form inside a word
+			weight = 0
+		}
+
+		good += weight * size
+	}
+
+	if total == 0 {
+		return 0
+	}
+	return good / total
+}
+
+// isASCIILetter reports whether runes[i] exists and is an ASCII letter
+func isASCIILetter(runes []rune, i int) bool {
+	if i < 0 || i >= len(runes) {
+		return false
+	}
+	r := runes[i]
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// latinWeight favours the characteristic letters of a Latin charset
+func latinWeight(letters string) func(r rune) float64 {
+	return func(r rune) float64 {
+		switch {
+		case strings.ContainsRune(letters, r):
+			return 1
+		case strings.ContainsRune(textPunctuation, r):
+			return 0.8
+		case unicode.Is(unicode.Latin, r):
+			return 0.3
+		default:
+			return 0
+		}
+	}
+}
+
+// alphabetWeight favours the frequent letters of a script. Uppercase letters
+// and marks such as Hebrew points count little, since running text is mostly
+// lowercase and unpointed.
+func alphabetWeight(script *unicode.RangeTable, common string) func(r rune) float64 {
+	return func(r rune) float64 {
+		switch {
+		case strings.ContainsRune(common, r):
+			return 1
+		case unicode.Is(script, r) && !unicode.IsLetter(r):
+			return 0.1
+		case unicode.Is(script, r) && unicode.IsUpper(r):
+			return 0.3
+		case unicode.Is(script, r):
+			return 0.4
+		case strings.ContainsRune(textPunctuation, r):
+			return 0.5
+		default:
+			return 0
+		}
+	}
+}
+
+// japaneseWeight favours kana, which only Japanese text uses heavily.
+// Halfwidth katakana are rare in text but common in misdecoded bytes.
+func japaneseWeight(r rune) float64 {
+	switch {
+	case r >= 0xFF61 && r <= 0xFF9F:
+		return 0.1
+	case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+		return 1
+	case isCJKPunctuation(r):
+		return 0.8
+	case unicode.Is(unicode.Han, r):
+		return 0.6
+	default:
+		return 0
+	}
+}
+
+// chineseWeight favours the most frequent characters of a Chinese script
+func chineseWeight(common string) func(r rune) float64 {
+	return func(r rune) float64 {
+		switch {
+		case strings.ContainsRune(common, r):
+			return 1
+		case isCJKPunctuation(r):
+			return 0.8
+		case unicode.Is(unicode.Han, r):
+			return 0.6
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+			return 0.2
+		default:
+			return 0
+		}
+	}
+}
+
+// koreanWeight favours the most frequent Hangul syllables
+func koreanWeight(common string) func(r rune) float64 {
+	return func(r rune) float64 {
+		switch {
+		case strings.ContainsRune(common, r):
+			return 1
+		case unicode.Is(unicode.Hangul, r):
+			return 0.5
+		case isCJKPunctuation(r):
+			return 0.5
+		case unicode.Is(unicode.Han, r):
+			return 0.3
+		default:
+			return 0
+		}
+	}
+}
+
+// isCJKPunctuation reports CJK symbols and fullwidth forms
+func isCJKPunctuation(r rune) bool {
+	return (r >= 0x3000 && r <= 0x303F) || (r >= 0xFF00 && r <= 0xFFEF)
+}
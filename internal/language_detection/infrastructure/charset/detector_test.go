@@ -0,0 +1,119 @@
+package charset
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func encode(t *testing.T, enc encoding.Encoding, text string) []byte {
+	t.Helper()
+	data, err := enc.NewEncoder().Bytes([]byte(text))
+	if err != nil {
+		t.Fatalf("Failed to encode %q: %v", text, err)
+	}
+	return data
+}
+
+func TestDetector_DetectCharsets(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding encoding.Encoding
+		text     string
+		expected string
+	}{
+		{"Russian in Windows-1251", charmap.Windows1251,
+			"Съешь же ещё этих мягких французских булок, да выпей чаю. Привет, как дела?", "windows-1251"},
+		{"Russian in KOI8-R", charmap.KOI8R,
+			"Съешь же ещё этих мягких французских булок, да выпей чаю. Привет, как дела?", "koi8-r"},
+		{"Japanese in Shift-JIS", japanese.ShiftJIS,
+			"これは日本語のテキストです。今日はいい天気ですね。", "shift_jis"},
+		{"Japanese in EUC-JP", japanese.EUCJP,
+			"これは日本語のテキストです。今日はいい天気ですね。", "euc-jp"},
+		{"Chinese in GB18030", simplifiedchinese.GB18030,
+			"这是一个中文的句子，我们在这里说的是大家都会的话。", "gb18030"},
+		{"Chinese in Big5", traditionalchinese.Big5,
+			"這是一個中文的句子，我們在這裡說的是大家都會的話。", "big5"},
+		{"Korean in EUC-KR", korean.EUCKR,
+			"안녕하세요. 오늘 날씨가 정말 좋네요. 한국어 문장입니다.", "euc-kr"},
+		{"French in Windows-1252", charmap.Windows1252,
+			"Le café est très chaud, mais la crème brûlée est délicieuse à Noël.", "windows-1252"},
+		{"Czech in Windows-1250", charmap.Windows1250,
+			"Příliš žluťoučký kůň úpěl ďábelské ódy a šťastně běžel domů.", "windows-1250"},
+		{"Greek in ISO-8859-7", charmap.ISO8859_7,
+			"Καλημέρα, πώς είστε σήμερα; Αυτό είναι ένα ελληνικό κείμενο.", "iso-8859-7"},
+	}
+
+	detector := NewDetector()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := detector.DetectCharsets(encode(t, tt.encoding, tt.text))
+			if len(candidates) == 0 {
+				t.Fatal("Expected candidates, got none")
+			}
+
+			if candidates[0].Charset != tt.expected {
+				t.Errorf("Expected %s first, got %v", tt.expected, candidates)
+			}
+
+			decoded, err := detector.Decode(encode(t, tt.encoding, tt.text), candidates[0].Charset)
+			if err != nil {
+				t.Fatalf("Decode() error = %v, want nil", err)
+			}
+			if string(decoded) != tt.text {
+				t.Errorf("Decode() = %q, want %q", decoded, tt.text)
+			}
+		})
+	}
+}
+
+func TestDetector_UnicodeInput(t *testing.T) {
+	detector := NewDetector()
+
+	tests := []struct {
+		name     string
+		content  []byte
+		expected string
+	}{
+		{"UTF-8", []byte("Grüße aus Köln"), "utf-8"},
+		{"ASCII", []byte("plain text"), "utf-8"},
+		{"UTF-8 BOM", []byte("\xef\xbb\xbfhello"), "utf-8"},
+		{"UTF-16LE BOM", []byte("\xff\xfeh\x00i\x00"), "utf-16le"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := detector.DetectCharsets(tt.content)
+			if len(candidates) != 1 || candidates[0].Charset != tt.expected || candidates[0].Confidence != 1 {
+				t.Errorf("Expected only %s with full confidence, got %v", tt.expected, candidates)
+			}
+		})
+	}
+
+	decoded, err := detector.Decode([]byte("\xff\xfeh\x00i\x00"), "utf-16le")
+	if err != nil || decoded != "hi" {
+		t.Errorf("Decode(utf-16le) = %q, %v; want \"hi\", nil", decoded, err)
+	}
+}
+
+func TestDetector_Decode_Errors(t *testing.T) {
+	detector := NewDetector()
+
+	_, err := detector.Decode([]byte("text"), "no-such-charset")
+	if !errors.Is(err, domain.ErrUnknownCharset) {
+		t.Errorf("Expected ErrUnknownCharset for unknown name, got %v", err)
+	}
+
+	_, err = detector.Decode([]byte{0xff, 0xfe, 0xfd}, "utf-8")
+	if !errors.Is(err, domain.ErrUnknownCharset) {
+		t.Errorf("Expected ErrUnknownCharset for invalid UTF-8, got %v", err)
+	}
+}
@@ -0,0 +1,12 @@
+package config
+
+// AuthEnabled reports whether callers must authenticate. Verified client
+// certificates authenticate their holder, so mutual TLS turns it on too.
+func (c *Config) AuthEnabled() bool {
+	return c.AuthAPIKeysFile != "" || c.AuthJWKSFile != "" || c.TLSClientCAFile != ""
+}
+
+// AuthExemptions returns the gRPC method prefixes served without credentials
+func (c *Config) AuthExemptions() []string {
+	return splitList(c.AuthExemptMethods)
+}
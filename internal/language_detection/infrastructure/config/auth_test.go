@@ -0,0 +1,77 @@
+package config
+
+import "testing"
+
+func TestConfig_AuthDefaults(t *testing.T) {
+	config := NewConfigProvider().GetConfig()
+
+	if config.AuthEnabled() {
+		t.Error("Expected authentication to be off without key sources")
+	}
+
+	exempt := config.AuthExemptions()
+	if len(exempt) != 2 || exempt[0] != "/grpc.health.v1." || exempt[1] != "/grpc.reflection." {
+		t.Errorf("Expected health and reflection to be exempt, got %v", exempt)
+	}
+
+	config.AuthJWKSFile = "jwks.json"
+	if !config.AuthEnabled() {
+		t.Error("Expected a JWKS file to enable authentication")
+	}
+
+	config.AuthJWKSFile = ""
+	config.TLSClientCAFile = "ca.crt"
+	if !config.AuthEnabled() {
+		t.Error("Expected mutual TLS to enable authentication")
+	}
+}
+
+func TestValidateConfig_Auth(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	config.AuthExemptMethods = "grpc.health.v1."
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for an exempt method without a leading slash, got nil")
+	}
+	config.AuthExemptMethods = ""
+
+	config.AuthJWKSFile = "jwks.json"
+	config.AuthTenantClaim = ""
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for bearer tokens without a tenant claim, got nil")
+	}
+}
+
+func TestValidateConfig_TLS(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	config.TLSCertFile = "tls.crt"
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for a certificate without a key, got nil")
+	}
+	config.TLSKeyFile = "tls.key"
+
+	config.TLSMinVersion = "1.1"
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for TLS 1.1, got nil")
+	}
+	config.TLSMinVersion = "1.3"
+
+	config.TLSReloadIntervalSecs = 0
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for a zero reload interval, got nil")
+	}
+	config.TLSReloadIntervalSecs = 30
+
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+
+	config.TLSCertFile, config.TLSKeyFile = "", ""
+	config.TLSClientCAFile = "ca.crt"
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for a client CA without a certificate, got nil")
+	}
+}
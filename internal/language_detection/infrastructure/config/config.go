@@ -1,12 +1,14 @@
 package config
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"strconv"
 	"strings"
+	"time"
 
 	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/certs"
+	"language-detection-service/internal/language_detection/infrastructure/logging"
 )
 
 // Config holds all configuration for the language detection service
@@ -14,10 +16,15 @@ type Config struct {
 	// Server configuration
 	ServerAddress string
 	ServerPort    int
+	HTTPPort      int // REST/JSON gateway port, 0 disables the gateway
+	MetricsPort   int // Prometheus metrics port, 0 disables metrics
 
 	// AWS configuration
 	AWSRegion        string
 	UseAWSComprehend bool
+	BatchMaxWaitMs   int // how long a request may wait to share a Comprehend batch call, 0 disables batching
+	BatchMaxSize     int
+	FailoverProvider string // provider retried while AWS Comprehend is unavailable, "fallback" or empty for none
 
 	// Service configuration
 	MaxTextLength          int
@@ -28,33 +35,162 @@ type Config struct {
 	// Supported languages
 	SupportedLanguages []domain.LanguageCode
 
+	// Limits on per-request detection options
+	MaxAlternatives     int     // most alternatives a request may ask for
+	MinConfidenceFloor  float32 // lowest confidence threshold a request may ask for
+	MaxRequestTimeoutMs int     // longest timeout a request may ask for
+
+	// Result cache
+	CacheSize       int // entries kept in memory, 0 disables the local cache
+	CacheTTLSeconds int
+
+	// Shared result cache
+	RedisAddress   string // host:port, empty disables the shared cache
+	RedisPassword  string
+	RedisDB        int
+	RedisTimeoutMs int // per-call timeout before the cache is treated as down
+
+	// Rate limiting
+	RateLimitClasses string // "class=rate:burst,...", empty disables rate limiting
+	RateLimitClients string // "identity=class,...", identities are principal:<id>, cn:<subject> or ip:<address>
+
+	// TLS, on when a certificate is set
+	TLSCertFile           string
+	TLSKeyFile            string
+	TLSClientCAFile       string // clients must present a certificate from this CA
+	TLSMinVersion         string // "1.2" or "1.3"
+	TLSReloadIntervalSecs int    // how often the files are checked for rotation
+
+	// Authentication, on when a key source or a client CA is set
+	AuthAPIKeysFile   string // JSON array of {"id", "tenant", "sha256"} entries
+	AuthJWKSFile      string // JWKS verifying bearer tokens
+	AuthJWTIssuer     string // expected "iss" claim, empty skips the check
+	AuthJWTAudience   string // expected "aud" claim, empty skips the check
+	AuthTenantClaim   string // JWT claim naming the tenant of the caller
+	AuthExemptMethods string // comma-separated gRPC method prefixes served without credentials
+
+	// Tenant profiles
+	TenantProfilesFile string // JSON object of tenant IDs to profiles, empty uses one configuration for all
+
+	// Usage accounting
+	UsageStorePath string // bbolt database file, empty disables usage accounting
+	TenantQuotas   string // "tenant=daily_docs:daily_chars:monthly_docs:monthly_chars,...", 0 is unlimited
+
+	// Logging
+	LogLevel       string // "debug", "info", "warn" or "error"
+	AccessLog      bool   // log every request
+	LogRequestText bool   // include raw request texts in access logs, for debugging only
+	LogTextHashKey string // key of the text hashes in access logs, empty uses a random key per process
+
+	// Audit log
+	AuditLogFile       string // JSON Lines file recording every detection, empty disables auditing
+	AuditMaxSizeMB     int    // rotate the file once it would grow past this size, 0 disables
+	AuditMaxAgeSeconds int    // rotate the file once it has been open this long, 0 disables
+	AuditCompress      bool   // gzip rotated files
+	AuditBufferSize    int    // records queued for writing before new ones are dropped
+
+	// Tracing
+	TracingExporter     string  // "otlp", "stdout" or "file", empty disables tracing
+	TracingOTLPEndpoint string  // host:port of the OTLP/gRPC collector
+	TracingOTLPInsecure bool    // connect to the collector without TLS
+	TracingFile         string  // file the "file" exporter appends spans to
+	TracingSampleRatio  float32 // share of new traces sampled, calls with a sampled parent always are
+
 	// Timeouts
 	ShutdownTimeoutSeconds int
+
+	// Detection jobs
+	JobStorePath    string // bbolt database file, empty disables jobs
+	JobWorkers      int
+	JobMaxDocuments int
 }
 
 // ConfigProvider implements the domain.ConfigProvider interface
 type ConfigProvider struct {
-	config *Config
+	config   *Config
+	settings []setting
+	problems []error // malformed values and unknown keys found while loading
 }
 
-// NewConfigProvider creates a new configuration provider
+// NewConfigProvider creates a configuration from the defaults overridden by
+// environment variables. Malformed values keep their defaults and are
+// reported by ValidateConfig.
 func NewConfigProvider() *ConfigProvider {
-	config := &Config{
-		ServerAddress:          getEnv("SERVER_ADDRESS", "0.0.0.0"),
-		ServerPort:             getEnvInt("SERVER_PORT", 6011),
-		AWSRegion:              getEnv("AWS_REGION", "us-east-1"),
-		UseAWSComprehend:       getEnvBool("USE_AWS_COMPREHEND", true),
-		MaxTextLength:          getEnvInt("MAX_TEXT_LENGTH", 5000),
-		MinConfidenceThreshold: getEnvFloat32("MIN_CONFIDENCE_THRESHOLD", 0.1),
-		ServiceVersion:         getEnv("SERVICE_VERSION", "1.0.0"),
-		ModelVersion:           getEnv("MODEL_VERSION", "1.0.0"),
-		ShutdownTimeoutSeconds: getEnvInt("SHUTDOWN_TIMEOUT_SECONDS", 30),
-	}
-
-	// Parse supported languages
-	config.SupportedLanguages = parseSupportedLanguages(getEnv("SUPPORTED_LANGUAGES", ""))
-
-	return &ConfigProvider{config: config}
+	provider, _ := LoadConfigProvider("")
+	return provider
+}
+
+// LoadConfigProvider creates a configuration from the defaults overridden by
+// the YAML or JSON config file at path, if it is set, and then by environment
+// variables. Malformed values and unknown keys are reported by ValidateConfig;
+// only a config file that cannot be read or parsed fails here.
+func LoadConfigProvider(path string) (*ConfigProvider, error) {
+	l, err := newLoader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{}
+	l.string(&config.ServerAddress, "SERVER_ADDRESS", "0.0.0.0")
+	l.int(&config.ServerPort, "SERVER_PORT", 6011)
+	l.int(&config.HTTPPort, "HTTP_PORT", 0)
+	l.int(&config.MetricsPort, "METRICS_PORT", 0)
+	l.string(&config.AWSRegion, "AWS_REGION", "us-east-1")
+	l.bool(&config.UseAWSComprehend, "USE_AWS_COMPREHEND", true)
+	l.int(&config.BatchMaxWaitMs, "BATCH_MAX_WAIT_MS", 0)
+	l.int(&config.BatchMaxSize, "BATCH_MAX_SIZE", 25)
+	l.string(&config.FailoverProvider, "FAILOVER_PROVIDER", "")
+	l.int(&config.MaxTextLength, "MAX_TEXT_LENGTH", 5000)
+	l.float32(&config.MinConfidenceThreshold, "MIN_CONFIDENCE_THRESHOLD", 0.1)
+	l.string(&config.ServiceVersion, "SERVICE_VERSION", "1.0.0")
+	l.string(&config.ModelVersion, "MODEL_VERSION", "1.0.0")
+	l.int(&config.MaxAlternatives, "MAX_ALTERNATIVES", 10)
+	l.float32(&config.MinConfidenceFloor, "MIN_CONFIDENCE_FLOOR", 0)
+	l.int(&config.MaxRequestTimeoutMs, "MAX_REQUEST_TIMEOUT_MS", 30000)
+	l.int(&config.CacheSize, "CACHE_SIZE", 10000)
+	l.int(&config.CacheTTLSeconds, "CACHE_TTL_SECONDS", 3600)
+	l.string(&config.RedisAddress, "REDIS_ADDRESS", "")
+	l.secret(&config.RedisPassword, "REDIS_PASSWORD", "")
+	l.int(&config.RedisDB, "REDIS_DB", 0)
+	l.int(&config.RedisTimeoutMs, "REDIS_TIMEOUT_MS", 50)
+	l.string(&config.RateLimitClasses, "RATE_LIMIT_CLASSES", "")
+	l.string(&config.RateLimitClients, "RATE_LIMIT_CLIENTS", "")
+	l.string(&config.TLSCertFile, "TLS_CERT_FILE", "")
+	l.string(&config.TLSKeyFile, "TLS_KEY_FILE", "")
+	l.string(&config.TLSClientCAFile, "TLS_CLIENT_CA_FILE", "")
+	l.string(&config.TLSMinVersion, "TLS_MIN_VERSION", "1.2")
+	l.int(&config.TLSReloadIntervalSecs, "TLS_RELOAD_INTERVAL_SECONDS", 30)
+	l.string(&config.AuthAPIKeysFile, "AUTH_API_KEYS_FILE", "")
+	l.string(&config.AuthJWKSFile, "AUTH_JWKS_FILE", "")
+	l.string(&config.AuthJWTIssuer, "AUTH_JWT_ISSUER", "")
+	l.string(&config.AuthJWTAudience, "AUTH_JWT_AUDIENCE", "")
+	l.string(&config.AuthTenantClaim, "AUTH_TENANT_CLAIM", "tenant")
+	l.string(&config.AuthExemptMethods, "AUTH_EXEMPT_METHODS", "/grpc.health.v1.,/grpc.reflection.")
+	l.string(&config.TenantProfilesFile, "TENANT_PROFILES_FILE", "")
+	l.string(&config.UsageStorePath, "USAGE_STORE_PATH", "")
+	l.string(&config.TenantQuotas, "TENANT_QUOTAS", "")
+	l.string(&config.LogLevel, "LOG_LEVEL", "info")
+	l.bool(&config.AccessLog, "ACCESS_LOG", true)
+	l.bool(&config.LogRequestText, "LOG_REQUEST_TEXT", false)
+	l.secret(&config.LogTextHashKey, "LOG_TEXT_HASH_KEY", "")
+	l.string(&config.AuditLogFile, "AUDIT_LOG_FILE", "")
+	l.int(&config.AuditMaxSizeMB, "AUDIT_MAX_SIZE_MB", 100)
+	l.int(&config.AuditMaxAgeSeconds, "AUDIT_MAX_AGE_SECONDS", 86400)
+	l.bool(&config.AuditCompress, "AUDIT_COMPRESS", false)
+	l.int(&config.AuditBufferSize, "AUDIT_BUFFER_SIZE", 4096)
+	l.string(&config.TracingExporter, "TRACING_EXPORTER", "")
+	l.string(&config.TracingOTLPEndpoint, "TRACING_OTLP_ENDPOINT", "localhost:4317")
+	l.bool(&config.TracingOTLPInsecure, "TRACING_OTLP_INSECURE", false)
+	l.string(&config.TracingFile, "TRACING_FILE", "language-detection-traces.jsonl")
+	l.float32(&config.TracingSampleRatio, "TRACING_SAMPLE_RATIO", 1)
+	l.int(&config.ShutdownTimeoutSeconds, "SHUTDOWN_TIMEOUT_SECONDS", 30)
+	l.string(&config.JobStorePath, "JOB_STORE_PATH", "")
+	l.int(&config.JobWorkers, "JOB_WORKERS", 4)
+	l.int(&config.JobMaxDocuments, "JOB_MAX_DOCUMENTS", 100000)
+	l.languages(&config.SupportedLanguages, "SUPPORTED_LANGUAGES")
+	l.finish()
+
+	return &ConfigProvider{config: config, settings: l.settings, problems: l.problems}, nil
 }
 
 // GetConfig returns the underlying configuration
@@ -83,39 +219,16 @@ func (cp *ConfigProvider) GetModelVersion() string {
 	return cp.config.ModelVersion
 }
 
-// Helper functions for environment variable parsing
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
-	return defaultValue
+func (cp *ConfigProvider) GetMaxAlternatives() int {
+	return cp.config.MaxAlternatives
 }
 
-func getEnvInt(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
-		if intValue, err := strconv.Atoi(value); err == nil {
-			return intValue
-		}
-	}
-	return defaultValue
+func (cp *ConfigProvider) GetMinConfidenceFloor() float32 {
+	return cp.config.MinConfidenceFloor
 }
 
-func getEnvFloat32(key string, defaultValue float32) float32 {
-	if value := os.Getenv(key); value != "" {
-		if floatValue, err := strconv.ParseFloat(value, 32); err == nil {
-			return float32(floatValue)
-		}
-	}
-	return defaultValue
-}
-
-func getEnvBool(key string, defaultValue bool) bool {
-	if value := os.Getenv(key); value != "" {
-		if boolValue, err := strconv.ParseBool(value); err == nil {
-			return boolValue
-		}
-	}
-	return defaultValue
+func (cp *ConfigProvider) GetMaxRequestTimeout() time.Duration {
+	return time.Duration(cp.config.MaxRequestTimeoutMs) * time.Millisecond
 }
 
 func parseSupportedLanguages(languagesStr string) []domain.LanguageCode {
@@ -144,41 +257,188 @@ func parseSupportedLanguages(languagesStr string) []domain.LanguageCode {
 	return result
 }
 
-// ValidateConfig validates the configuration
+// ValidateConfig reports every malformed value found while loading and every
+// invalid setting together, one per line
 func (cp *ConfigProvider) ValidateConfig() error {
 	config := cp.config
+	problems := append([]error(nil), cp.problems...)
 
 	// Validate server configuration
 	if config.ServerPort <= 0 || config.ServerPort > 65535 {
-		return fmt.Errorf("invalid server port: %d", config.ServerPort)
+		problems = append(problems, fmt.Errorf("invalid server port: %d", config.ServerPort))
+	}
+
+	if config.HTTPPort < 0 || config.HTTPPort > 65535 {
+		problems = append(problems, fmt.Errorf("invalid HTTP port: %d", config.HTTPPort))
+	}
+
+	if config.HTTPPort == config.ServerPort {
+		problems = append(problems, fmt.Errorf("HTTP port must differ from server port %d", config.ServerPort))
+	}
+
+	if config.MetricsPort < 0 || config.MetricsPort > 65535 {
+		problems = append(problems, fmt.Errorf("invalid metrics port: %d", config.MetricsPort))
+	}
+
+	if config.MetricsPort != 0 && (config.MetricsPort == config.ServerPort || config.MetricsPort == config.HTTPPort) {
+		problems = append(problems, fmt.Errorf("metrics port %d must differ from the server and HTTP ports", config.MetricsPort))
 	}
 
 	// Validate AWS configuration if using AWS Comprehend
 	if config.UseAWSComprehend {
 		if config.AWSRegion == "" {
-			return fmt.Errorf("AWS region is required when using AWS Comprehend")
+			problems = append(problems, fmt.Errorf("AWS region is required when using AWS Comprehend"))
 		}
+
+		if config.BatchMaxWaitMs < 0 {
+			problems = append(problems, fmt.Errorf("batch max wait cannot be negative"))
+		}
+
+		// BatchDetectDominantLanguage accepts at most 25 documents
+		if config.BatchMaxWaitMs > 0 && (config.BatchMaxSize < 2 || config.BatchMaxSize > 25) {
+			problems = append(problems, fmt.Errorf("batch max size must be between 2 and 25"))
+		}
+	}
+
+	if config.FailoverProvider != "" && config.FailoverProvider != "fallback" {
+		problems = append(problems, fmt.Errorf("invalid failover provider %q: must be fallback or empty", config.FailoverProvider))
 	}
 
 	// Validate text length
 	if config.MaxTextLength <= 0 {
-		return fmt.Errorf("max text length must be positive")
+		problems = append(problems, fmt.Errorf("max text length must be positive"))
 	}
 
 	// Validate confidence threshold
 	if config.MinConfidenceThreshold < 0 || config.MinConfidenceThreshold > 1 {
-		return fmt.Errorf("confidence threshold must be between 0 and 1")
+		problems = append(problems, fmt.Errorf("confidence threshold must be between 0 and 1"))
+	}
+
+	// Validate per-request option limits
+	if config.MaxAlternatives <= 0 {
+		problems = append(problems, fmt.Errorf("max alternatives must be positive"))
+	}
+
+	if config.MinConfidenceFloor < 0 || config.MinConfidenceFloor > config.MinConfidenceThreshold {
+		problems = append(problems, fmt.Errorf("confidence floor must be between 0 and the confidence threshold"))
+	}
+
+	if config.MaxRequestTimeoutMs <= 0 {
+		problems = append(problems, fmt.Errorf("max request timeout must be positive"))
+	}
+
+	// Validate result cache
+	if config.CacheSize < 0 {
+		problems = append(problems, fmt.Errorf("cache size cannot be negative"))
+	}
+
+	if (config.CacheSize > 0 || config.RedisAddress != "") && config.CacheTTLSeconds <= 0 {
+		problems = append(problems, fmt.Errorf("cache TTL must be positive"))
+	}
+
+	if config.RedisAddress != "" {
+		if config.RedisDB < 0 {
+			problems = append(problems, fmt.Errorf("redis database cannot be negative"))
+		}
+		if config.RedisTimeoutMs <= 0 {
+			problems = append(problems, fmt.Errorf("redis timeout must be positive"))
+		}
+	}
+
+	// Validate rate limits
+	if _, _, err := config.RateLimits(); err != nil {
+		problems = append(problems, err)
+	}
+
+	// Validate TLS
+	if (config.TLSCertFile == "") != (config.TLSKeyFile == "") {
+		problems = append(problems, fmt.Errorf("TLS certificate and key must be set together"))
+	}
+	if config.TLSClientCAFile != "" && config.TLSCertFile == "" {
+		problems = append(problems, fmt.Errorf("a TLS client CA requires a server certificate"))
+	}
+	if config.TLSCertFile != "" {
+		if _, err := certs.ParseVersion(config.TLSMinVersion); err != nil {
+			problems = append(problems, err)
+		}
+		if config.TLSReloadIntervalSecs <= 0 {
+			problems = append(problems, fmt.Errorf("TLS reload interval must be positive"))
+		}
+	}
+
+	// Validate authentication
+	if config.AuthJWKSFile != "" && config.AuthTenantClaim == "" {
+		problems = append(problems, fmt.Errorf("auth tenant claim must be set when bearer tokens are accepted"))
+	}
+	for _, method := range config.AuthExemptions() {
+		if !strings.HasPrefix(method, "/") {
+			problems = append(problems, fmt.Errorf("invalid auth exempt method %q: must start with /", method))
+		}
+	}
+
+	// Validate quotas
+	if _, err := config.Quotas(); err != nil {
+		problems = append(problems, err)
+	}
+	if config.TenantQuotas != "" && config.UsageStorePath == "" {
+		problems = append(problems, fmt.Errorf("tenant quotas require a usage store path"))
+	}
+
+	// Validate logging
+	if _, err := logging.ParseLevel(config.LogLevel); err != nil {
+		problems = append(problems, err)
+	}
+
+	// Validate the audit log
+	if config.AuditLogFile != "" {
+		if config.AuditMaxSizeMB < 0 {
+			problems = append(problems, fmt.Errorf("audit max size cannot be negative"))
+		}
+		if config.AuditMaxAgeSeconds < 0 {
+			problems = append(problems, fmt.Errorf("audit max age cannot be negative"))
+		}
+		if config.AuditBufferSize <= 0 {
+			problems = append(problems, fmt.Errorf("audit buffer size must be positive"))
+		}
+	}
+
+	// Validate tracing
+	switch config.TracingExporter {
+	case "", "stdout":
+	case "otlp":
+		if config.TracingOTLPEndpoint == "" {
+			problems = append(problems, fmt.Errorf("the otlp tracing exporter requires an endpoint"))
+		}
+	case "file":
+		if config.TracingFile == "" {
+			problems = append(problems, fmt.Errorf("the file tracing exporter requires a file"))
+		}
+	default:
+		problems = append(problems, fmt.Errorf("invalid tracing exporter %q: must be otlp, stdout or file", config.TracingExporter))
+	}
+	if config.TracingSampleRatio < 0 || config.TracingSampleRatio > 1 {
+		problems = append(problems, fmt.Errorf("tracing sample ratio must be between 0 and 1"))
 	}
 
 	// Validate supported languages
 	if len(config.SupportedLanguages) == 0 {
-		return fmt.Errorf("at least one supported language must be configured")
+		problems = append(problems, fmt.Errorf("at least one supported language must be configured"))
 	}
 
 	// Validate timeouts
 	if config.ShutdownTimeoutSeconds <= 0 {
-		return fmt.Errorf("shutdown timeout must be positive")
+		problems = append(problems, fmt.Errorf("shutdown timeout must be positive"))
+	}
+
+	// Validate detection jobs
+	if config.JobStorePath != "" {
+		if config.JobWorkers <= 0 {
+			problems = append(problems, fmt.Errorf("job workers must be positive"))
+		}
+		if config.JobMaxDocuments <= 0 {
+			problems = append(problems, fmt.Errorf("job max documents must be positive"))
+		}
 	}
 
-	return nil
+	return errors.Join(problems...)
 }
@@ -2,7 +2,9 @@ package config
 
 import (
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"language-detection-service/internal/language_detection/domain"
 )
@@ -32,6 +34,10 @@ func TestConfigProvider_DefaultValues(t *testing.T) {
 		t.Errorf("Expected ServerPort 6011, got %d", config.ServerPort)
 	}
 	
+	if config.HTTPPort != 0 {
+		t.Errorf("Expected the HTTP gateway to be disabled, got port %d", config.HTTPPort)
+	}
+	
 	if config.AWSRegion != "us-east-1" {
 		t.Errorf("Expected AWSRegion 'us-east-1', got %s", config.AWSRegion)
 	}
@@ -239,6 +245,17 @@ func TestConfigProvider_InvalidEnvironmentVariables(t *testing.T) {
 	if config.UseAWSComprehend != true {
 		t.Errorf("Expected default UseAWSComprehend true, got %v", config.UseAWSComprehend)
 	}
+
+	// Every malformed value is reported at once
+	err := provider.ValidateConfig()
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	for _, envVar := range envVars {
+		if !strings.Contains(err.Error(), envVar) {
+			t.Errorf("Expected the error to name %s, got %v", envVar, err)
+		}
+	}
 }
 
 func TestParseSupportedLanguages(t *testing.T) {
@@ -335,6 +352,84 @@ func TestValidateConfig_InvalidServerPort(t *testing.T) {
 	}
 }
 
+func TestValidateConfig_InvalidHTTPPort(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	tests := []struct {
+		name string
+		port int
+	}{
+		{"Negative port", -1},
+		{"Port too high", 65536},
+		{"Same as server port", config.ServerPort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalPort := config.HTTPPort
+			config.HTTPPort = tt.port
+
+			err := provider.ValidateConfig()
+			if err == nil {
+				t.Errorf("ValidateConfig() expected error for HTTP port %d, got nil", tt.port)
+			}
+
+			config.HTTPPort = originalPort
+		})
+	}
+}
+
+func TestValidateConfig_DisabledHTTPGateway(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	config.HTTPPort = 0
+
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+}
+
+func TestValidateConfig_InvalidMetricsPort(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	if config.MetricsPort != 0 {
+		t.Errorf("Expected metrics to be disabled, got port %d", config.MetricsPort)
+	}
+	config.HTTPPort = 8080
+
+	tests := []struct {
+		name string
+		port int
+	}{
+		{"Negative port", -1},
+		{"Port too high", 65536},
+		{"Same as server port", config.ServerPort},
+		{"Same as HTTP port", config.HTTPPort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalPort := config.MetricsPort
+			config.MetricsPort = tt.port
+
+			err := provider.ValidateConfig()
+			if err == nil {
+				t.Errorf("ValidateConfig() expected error for metrics port %d, got nil", tt.port)
+			}
+
+			config.MetricsPort = originalPort
+		})
+	}
+
+	config.MetricsPort = 0
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+}
+
 func TestValidateConfig_AWSComprehendWithoutRegion(t *testing.T) {
 	provider := NewConfigProvider()
 	config := provider.GetConfig()
@@ -412,28 +507,306 @@ func TestValidateConfig_InvalidShutdownTimeout(t *testing.T) {
 	}
 }
 
-func TestHelperFunctions(t *testing.T) {
-	// Test getEnv with default
-	result := getEnv("NONEXISTENT_VAR", "default")
-	if result != "default" {
-		t.Errorf("getEnv() = %s, want 'default'", result)
+func TestConfigProvider_JobDefaults(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	if config.JobStorePath != "" {
+		t.Errorf("Expected jobs to be disabled, got JobStorePath %s", config.JobStorePath)
 	}
-	
-	// Test getEnvInt with default
-	resultInt := getEnvInt("NONEXISTENT_INT", 42)
-	if resultInt != 42 {
-		t.Errorf("getEnvInt() = %d, want 42", resultInt)
+
+	if config.JobWorkers != 4 {
+		t.Errorf("Expected JobWorkers 4, got %d", config.JobWorkers)
 	}
-	
-	// Test getEnvFloat32 with default
-	resultFloat := getEnvFloat32("NONEXISTENT_FLOAT", 3.14)
-	if resultFloat != 3.14 {
-		t.Errorf("getEnvFloat32() = %f, want 3.14", resultFloat)
+
+	if config.JobMaxDocuments != 100000 {
+		t.Errorf("Expected JobMaxDocuments 100000, got %d", config.JobMaxDocuments)
 	}
-	
-	// Test getEnvBool with default
-	resultBool := getEnvBool("NONEXISTENT_BOOL", true)
-	if resultBool != true {
-		t.Errorf("getEnvBool() = %v, want true", resultBool)
+}
+
+func TestValidateConfig_InvalidJobSettings(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+	config.JobStorePath = "jobs.db"
+
+	config.JobWorkers = 0
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for zero job workers, got nil")
+	}
+	config.JobWorkers = 4
+
+	config.JobMaxDocuments = -1
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for negative job max documents, got nil")
+	}
+
+	// Job settings are ignored when jobs are disabled
+	config.JobStorePath = ""
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+}
+
+func TestValidateConfig_Quotas(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	if config.UsageStorePath != "" {
+		t.Errorf("Expected usage accounting to be disabled, got UsageStorePath %s", config.UsageStorePath)
+	}
+
+	config.TenantQuotas = "acme=1:1"
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for a malformed tenant quota, got nil")
+	}
+
+	config.TenantQuotas = "acme=1:1:1:1"
+	config.UsageStorePath = ""
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for quotas without a usage store, got nil")
+	}
+}
+
+func TestValidateConfig_Logging(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	if config.LogLevel != "info" || !config.AccessLog || config.LogRequestText {
+		t.Errorf("Expected info level access logs without request texts, got %s, %v, %v",
+			config.LogLevel, config.AccessLog, config.LogRequestText)
+	}
+
+	config.LogLevel = "debug"
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+
+	config.LogLevel = "verbose"
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for an unknown log level, got nil")
+	}
+}
+
+func TestValidateConfig_Tracing(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr bool
+	}{
+		{"Disabled by default", func(c *Config) {}, false},
+		{"Stdout exporter", func(c *Config) { c.TracingExporter = "stdout" }, false},
+		{"OTLP exporter", func(c *Config) { c.TracingExporter = "otlp" }, false},
+		{"OTLP exporter without endpoint", func(c *Config) { c.TracingExporter = "otlp"; c.TracingOTLPEndpoint = "" }, true},
+		{"File exporter without file", func(c *Config) { c.TracingExporter = "file"; c.TracingFile = "" }, true},
+		{"Unknown exporter", func(c *Config) { c.TracingExporter = "jaeger" }, true},
+		{"Sample ratio above 1", func(c *Config) { c.TracingSampleRatio = 1.5 }, true},
+		{"Negative sample ratio", func(c *Config) { c.TracingSampleRatio = -0.1 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewConfigProvider()
+			tt.modify(provider.GetConfig())
+
+			err := provider.ValidateConfig()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_ReportsEveryProblem(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+	config.ServerPort = 0
+	config.MaxTextLength = 0
+	config.TracingExporter = "jaeger"
+
+	err := provider.ValidateConfig()
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	for _, want := range []string{"invalid server port", "max text length", "invalid tracing exporter"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected the error to contain %q, got %v", want, err)
+		}
+	}
+}
+
+func TestValidateConfig_Audit(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr bool
+	}{
+		{"Disabled by default", func(c *Config) {}, false},
+		{"Enabled", func(c *Config) { c.AuditLogFile = "audit/detections.jsonl" }, false},
+		{"Without rotation", func(c *Config) { c.AuditLogFile = "audit.jsonl"; c.AuditMaxSizeMB = 0; c.AuditMaxAgeSeconds = 0 }, false},
+		{"Negative max size", func(c *Config) { c.AuditLogFile = "audit.jsonl"; c.AuditMaxSizeMB = -1 }, true},
+		{"Negative max age", func(c *Config) { c.AuditLogFile = "audit.jsonl"; c.AuditMaxAgeSeconds = -1 }, true},
+		{"Zero buffer size", func(c *Config) { c.AuditLogFile = "audit.jsonl"; c.AuditBufferSize = 0 }, true},
+		{"Zero buffer size while disabled", func(c *Config) { c.AuditBufferSize = 0 }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewConfigProvider()
+			tt.modify(provider.GetConfig())
+
+			err := provider.ValidateConfig()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigProvider_OptionLimits(t *testing.T) {
+	provider := NewConfigProvider()
+
+	if provider.GetMaxAlternatives() != 10 {
+		t.Errorf("Expected max alternatives 10, got %d", provider.GetMaxAlternatives())
+	}
+
+	if provider.GetMinConfidenceFloor() != 0 {
+		t.Errorf("Expected confidence floor 0, got %f", provider.GetMinConfidenceFloor())
+	}
+
+	if provider.GetMaxRequestTimeout() != 30*time.Second {
+		t.Errorf("Expected max request timeout 30s, got %v", provider.GetMaxRequestTimeout())
+	}
+}
+
+func TestValidateConfig_InvalidOptionLimits(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	config.MaxAlternatives = 0
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for zero max alternatives, got nil")
+	}
+	config.MaxAlternatives = 10
+
+	config.MinConfidenceFloor = config.MinConfidenceThreshold + 0.1
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for a floor above the threshold, got nil")
+	}
+	config.MinConfidenceFloor = 0
+
+	config.MaxRequestTimeoutMs = -1
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for negative max request timeout, got nil")
+	}
+}
+
+func TestConfigProvider_CacheDefaults(t *testing.T) {
+	config := NewConfigProvider().GetConfig()
+
+	if config.CacheSize != 10000 {
+		t.Errorf("Expected CacheSize 10000, got %d", config.CacheSize)
+	}
+
+	if config.CacheTTLSeconds != 3600 {
+		t.Errorf("Expected CacheTTLSeconds 3600, got %d", config.CacheTTLSeconds)
+	}
+}
+
+func TestValidateConfig_InvalidCacheSettings(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	config.CacheSize = -1
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for negative cache size, got nil")
+	}
+	config.CacheSize = 100
+
+	config.CacheTTLSeconds = 0
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for zero cache TTL, got nil")
+	}
+
+	// The TTL is ignored when the cache is disabled
+	config.CacheSize = 0
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+}
+
+func TestValidateConfig_InvalidRedisSettings(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	// Redis settings are ignored when the shared cache is disabled
+	config.RedisTimeoutMs = 0
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+
+	config.RedisAddress = "localhost:6379"
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for zero redis timeout, got nil")
+	}
+	config.RedisTimeoutMs = 50
+
+	config.RedisDB = -1
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for negative redis database, got nil")
+	}
+	config.RedisDB = 0
+
+	config.CacheSize = 0
+	config.CacheTTLSeconds = 0
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for zero cache TTL with redis, got nil")
+	}
+}
+
+func TestValidateConfig_InvalidBatchSettings(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+	config.UseAWSComprehend = true
+
+	config.BatchMaxWaitMs = -1
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for negative batch wait, got nil")
+	}
+
+	config.BatchMaxWaitMs = 5
+	config.BatchMaxSize = 26
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for a batch larger than Comprehend allows, got nil")
+	}
+
+	config.BatchMaxSize = 25
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+
+	// The batch size is ignored when batching is disabled
+	config.BatchMaxWaitMs = 0
+	config.BatchMaxSize = 0
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+}
+
+func TestValidateConfig_FailoverProvider(t *testing.T) {
+	provider := NewConfigProvider()
+	config := provider.GetConfig()
+
+	if config.FailoverProvider != "" {
+		t.Errorf("Expected failover to be off by default, got %q", config.FailoverProvider)
+	}
+
+	config.FailoverProvider = "fallback"
+	if err := provider.ValidateConfig(); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil", err)
+	}
+
+	config.FailoverProvider = "aws-comprehend"
+	if err := provider.ValidateConfig(); err == nil {
+		t.Error("ValidateConfig() expected error for an unknown failover provider, got nil")
 	}
 }
@@ -0,0 +1,270 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// redacted replaces the values of secrets in printed configurations
+const redacted = "<redacted>"
+
+// setting is one configuration key and the field it sets
+type setting struct {
+	key    string // environment variable, or its lower-case form in a config file
+	field  any    // *string, *int, *float32, *bool or *[]domain.LanguageCode
+	secret bool
+}
+
+// fileValue is the raw value of a key in a config file
+type fileValue struct {
+	key   string // the key as spelled in the file
+	value string
+}
+
+// loader sets each field from its default, then the config file, then the
+// environment. Malformed values keep the previous layer and are collected, so
+// that every problem can be reported at once.
+type loader struct {
+	path     string
+	file     map[string]fileValue // by environment variable name
+	settings []setting
+	problems []error
+}
+
+// newLoader creates a loader over the values of a config file, if path is set
+func newLoader(path string) (*loader, error) {
+	l := &loader{path: path, file: make(map[string]fileValue)}
+	if path == "" {
+		return l, nil
+	}
+
+	if err := l.readFile(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// readFile reads a flat YAML or JSON object of keys to values. Lists are
+// joined with commas, like the environment variables they stand for.
+func (l *loader) readFile() error {
+	content, err := os.ReadFile(l.path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(l.path)) {
+	case ".yaml", ".yml":
+	case ".json":
+		if !json.Valid(content) {
+			return fmt.Errorf("invalid config file %s: not valid JSON", l.path)
+		}
+	default:
+		return fmt.Errorf("invalid config file %s: must be .yaml, .yml or .json", l.path)
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return fmt.Errorf("invalid config file %s: %w", l.path, err)
+	}
+	if len(document.Content) == 0 {
+		return nil
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("invalid config file %s: must be an object of keys to values", l.path)
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, node := root.Content[i].Value, root.Content[i+1]
+		name := strings.ToUpper(key)
+		if previous, ok := l.file[name]; ok {
+			l.problems = append(l.problems, fmt.Errorf("%s in %s: set again as %s", previous.key, l.path, key))
+			continue
+		}
+
+		value, err := scalarValue(node)
+		if err != nil {
+			l.problems = append(l.problems, fmt.Errorf("%s in %s: %w", key, l.path, err))
+			continue
+		}
+		l.file[name] = fileValue{key: key, value: value}
+	}
+	return nil
+}
+
+// scalarValue returns the text of a value, joining lists with commas
+func scalarValue(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return "", nil
+		}
+		return node.Value, nil
+	case yaml.SequenceNode:
+		values := make([]string, len(node.Content))
+		for i, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return "", fmt.Errorf("list items must be single values")
+			}
+			values[i] = item.Value
+		}
+		return strings.Join(values, ","), nil
+	default:
+		return "", fmt.Errorf("must be a single value or a list")
+	}
+}
+
+// finish reports the config file keys that no setting read
+func (l *loader) finish() {
+	var unknown []string
+	for _, value := range l.file {
+		unknown = append(unknown, value.key)
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		l.problems = append(l.problems, fmt.Errorf("%s in %s: unknown key", key, l.path))
+	}
+}
+
+// apply passes the config file value of a key and then its environment value
+// to set, recording the values it rejects. Empty environment variables are
+// treated as unset.
+func (l *loader) apply(key string, set func(value string) error) {
+	if value, ok := l.file[key]; ok {
+		delete(l.file, key)
+		if err := set(value.value); err != nil {
+			l.problems = append(l.problems, fmt.Errorf("%s in %s: invalid value %q: %w", value.key, l.path, value.value, err))
+		}
+	}
+
+	if value := os.Getenv(key); value != "" {
+		if err := set(value); err != nil {
+			l.problems = append(l.problems, fmt.Errorf("%s: invalid value %q: %w", key, value, err))
+		}
+	}
+}
+
+func (l *loader) string(field *string, key, defaultValue string) {
+	*field = defaultValue
+	l.settings = append(l.settings, setting{key: key, field: field})
+	l.apply(key, func(value string) error {
+		*field = value
+		return nil
+	})
+}
+
+// secret is like string, but the value is redacted when the configuration is printed
+func (l *loader) secret(field *string, key, defaultValue string) {
+	l.string(field, key, defaultValue)
+	l.settings[len(l.settings)-1].secret = true
+}
+
+func (l *loader) int(field *int, key string, defaultValue int) {
+	*field = defaultValue
+	l.settings = append(l.settings, setting{key: key, field: field})
+	l.apply(key, func(value string) error {
+		parsed, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("must be an integer")
+		}
+		*field = parsed
+		return nil
+	})
+}
+
+func (l *loader) float32(field *float32, key string, defaultValue float32) {
+	*field = defaultValue
+	l.settings = append(l.settings, setting{key: key, field: field})
+	l.apply(key, func(value string) error {
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 32)
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		*field = float32(parsed)
+		return nil
+	})
+}
+
+func (l *loader) bool(field *bool, key string, defaultValue bool) {
+	*field = defaultValue
+	l.settings = append(l.settings, setting{key: key, field: field})
+	l.apply(key, func(value string) error {
+		parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		*field = parsed
+		return nil
+	})
+}
+
+func (l *loader) languages(field *[]domain.LanguageCode, key string) {
+	*field = parseSupportedLanguages("")
+	l.settings = append(l.settings, setting{key: key, field: field})
+	l.apply(key, func(value string) error {
+		*field = parseSupportedLanguages(value)
+		return nil
+	})
+}
+
+// WriteConfig writes the effective configuration as YAML in the config file
+// format, with secrets redacted
+func (cp *ConfigProvider) WriteConfig(w io.Writer) error {
+	document := &yaml.Node{Kind: yaml.MappingNode}
+	for _, s := range cp.settings {
+		document.Content = append(document.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: strings.ToLower(s.key)},
+			settingNode(s))
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return encoder.Close()
+}
+
+// settingNode returns the YAML value of a setting
+func settingNode(s setting) *yaml.Node {
+	scalar := func(tag, value string) *yaml.Node {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+	}
+
+	switch field := s.field.(type) {
+	case *string:
+		if s.secret && *field != "" {
+			return scalar("!!str", redacted)
+		}
+		return scalar("!!str", *field)
+	case *int:
+		return scalar("!!int", strconv.Itoa(*field))
+	case *float32:
+		value := strconv.FormatFloat(float64(*field), 'g', -1, 32)
+		if !strings.ContainsAny(value, ".eIN") {
+			value += ".0" // keep whole numbers from reading as integers
+		}
+		return scalar("!!float", value)
+	case *bool:
+		return scalar("!!bool", strconv.FormatBool(*field))
+	case *[]domain.LanguageCode:
+		list := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+		for _, code := range *field {
+			list.Content = append(list.Content, scalar("!!str", string(code)))
+		}
+		return list
+	default:
+		return scalar("!!null", "")
+	}
+}
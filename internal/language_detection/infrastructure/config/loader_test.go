@@ -0,0 +1,213 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// writeConfigFile writes a config file into a temporary directory
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return path
+}
+
+func TestLoader_Defaults(t *testing.T) {
+	l, err := newLoader("")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var (
+		text    string
+		number  int
+		ratio   float32
+		enabled bool
+	)
+	l.string(&text, "NONEXISTENT_VAR", "default")
+	l.int(&number, "NONEXISTENT_INT", 42)
+	l.float32(&ratio, "NONEXISTENT_FLOAT", 3.14)
+	l.bool(&enabled, "NONEXISTENT_BOOL", true)
+
+	if text != "default" || number != 42 || ratio != 3.14 || !enabled {
+		t.Errorf("Expected the defaults, got %q, %d, %f and %v", text, number, ratio, enabled)
+	}
+	if len(l.problems) != 0 {
+		t.Errorf("Expected no problems, got %v", l.problems)
+	}
+}
+
+func TestLoadConfigProvider_YAML(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", `
+server_port: 7000
+http_port: 7001
+use_aws_comprehend: false
+min_confidence_threshold: 0.25
+supported_languages: [en-US, fr-FR]
+redis_address:
+`)
+	t.Setenv("HTTP_PORT", "7002")
+
+	provider, err := LoadConfigProvider(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := provider.ValidateConfig(); err != nil {
+		t.Fatalf("Expected a valid configuration, got %v", err)
+	}
+
+	config := provider.GetConfig()
+	if config.ServerPort != 7000 {
+		t.Errorf("Expected the server port from the file, got %d", config.ServerPort)
+	}
+	if config.HTTPPort != 7002 {
+		t.Errorf("Expected the environment to override the file, got %d", config.HTTPPort)
+	}
+	if config.UseAWSComprehend || config.MinConfidenceThreshold != 0.25 {
+		t.Errorf("Expected the file values, got %v and %f", config.UseAWSComprehend, config.MinConfidenceThreshold)
+	}
+	if len(config.SupportedLanguages) != 2 || config.SupportedLanguages[1] != "fr-FR" {
+		t.Errorf("Expected the listed languages, got %v", config.SupportedLanguages)
+	}
+	if config.MaxTextLength != 5000 {
+		t.Errorf("Expected the default for unset keys, got %d", config.MaxTextLength)
+	}
+}
+
+func TestLoadConfigProvider_JSON(t *testing.T) {
+	path := writeConfigFile(t, "config.json", `{"SERVER_PORT": 7000, "aws_region": "eu-west-1", "supported_languages": "en-US,de-DE"}`)
+
+	provider, err := LoadConfigProvider(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	config := provider.GetConfig()
+	if config.ServerPort != 7000 || config.AWSRegion != "eu-west-1" {
+		t.Errorf("Expected the file values, got %d and %s", config.ServerPort, config.AWSRegion)
+	}
+	if len(config.SupportedLanguages) != 2 || config.SupportedLanguages[1] != "de-DE" {
+		t.Errorf("Expected the listed languages, got %v", config.SupportedLanguages)
+	}
+}
+
+func TestLoadConfigProvider_ReportsEveryProblem(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", `
+server_port: 60l1
+cache_size: [1, 2]
+redis_timeout_ms: {value: 50}
+sever_address: 127.0.0.1
+access_log: maybe
+`)
+	t.Setenv("MIN_CONFIDENCE_THRESHOLD", "high")
+
+	provider, err := LoadConfigProvider(path)
+	if err != nil {
+		t.Fatalf("Expected problems to be reported by ValidateConfig, got %v", err)
+	}
+
+	// Malformed values keep the lower layer
+	if provider.GetConfig().ServerPort != 6011 {
+		t.Errorf("Expected the default server port, got %d", provider.GetConfig().ServerPort)
+	}
+
+	err = provider.ValidateConfig()
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	for _, want := range []string{
+		`server_port in ` + path + `: invalid value "60l1": must be an integer`,
+		`cache_size in ` + path + `: invalid value "1,2": must be an integer`,
+		`redis_timeout_ms in ` + path + `: must be a single value or a list`,
+		`sever_address in ` + path + `: unknown key`,
+		`access_log in ` + path + `: invalid value "maybe": must be true or false`,
+		`MIN_CONFIDENCE_THRESHOLD: invalid value "high": must be a number`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected the error to contain %q, got %v", want, err)
+		}
+	}
+}
+
+func TestLoadConfigProvider_InvalidFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"Invalid YAML", "config.yaml", "server_port: [7000"},
+		{"Invalid JSON", "config.json", "server_port: 7000"},
+		{"Not an object", "config.yaml", "- server_port"},
+		{"Unsupported format", "config.toml", "server_port = 7000"},
+		{"Repeated key", "config.yaml", "server_port: 7000\nSERVER_PORT: 7001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := LoadConfigProvider(writeConfigFile(t, tt.file, tt.content))
+			if err == nil {
+				err = provider.ValidateConfig()
+			}
+			if err == nil {
+				t.Error("Expected an error, got nil")
+			}
+		})
+	}
+
+	if _, err := LoadConfigProvider(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("Expected an error for a missing file, got nil")
+	}
+}
+
+func TestConfigProvider_WriteConfig(t *testing.T) {
+	t.Setenv("REDIS_ADDRESS", "redis:6379")
+	t.Setenv("REDIS_PASSWORD", "hunter2")
+	t.Setenv("SERVICE_VERSION", "2.0")
+	provider := NewConfigProvider()
+	provider.GetConfig().MinConfidenceThreshold = 0.3
+
+	var out bytes.Buffer
+	if err := provider.WriteConfig(&out); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	printed := out.String()
+	if strings.Contains(printed, "hunter2") || !strings.Contains(printed, "redis_password: <redacted>") {
+		t.Errorf("Expected the Redis password to be redacted, got:\n%s", printed)
+	}
+	if !strings.Contains(printed, "log_text_hash_key: \"\"") {
+		t.Errorf("Expected unset secrets to be shown as empty, got:\n%s", printed)
+	}
+	if !strings.Contains(printed, "min_confidence_threshold: 0.3\n") {
+		t.Errorf("Expected the effective threshold, got:\n%s", printed)
+	}
+
+	// The printed configuration loads back as a config file
+	os.Unsetenv("REDIS_ADDRESS")
+	os.Unsetenv("REDIS_PASSWORD")
+	os.Unsetenv("SERVICE_VERSION")
+	reloaded, err := LoadConfigProvider(writeConfigFile(t, "config.yaml", printed))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := reloaded.ValidateConfig(); err != nil {
+		t.Errorf("Expected a valid configuration, got %v", err)
+	}
+
+	config := reloaded.GetConfig()
+	if config.RedisAddress != "redis:6379" || config.ServiceVersion != "2.0" || config.MinConfidenceThreshold != 0.3 {
+		t.Errorf("Expected the printed values, got %s, %s and %f", config.RedisAddress, config.ServiceVersion, config.MinConfidenceThreshold)
+	}
+	want := NewConfigProvider().GetSupportedLanguages()
+	if got := reloaded.GetSupportedLanguages(); len(got) != len(want) || got[0] != domain.LanguageCode("en-US") {
+		t.Errorf("Expected the default languages, got %v", got)
+	}
+}
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// Profile overrides the service configuration for one tenant. Unset fields
+// keep the service-wide values.
+type Profile struct {
+	SupportedLanguages     []domain.LanguageCode                       `json:"supported_languages,omitempty"`
+	MinConfidenceThreshold *float32                                    `json:"min_confidence_threshold,omitempty"`
+	Provider               string                                      `json:"provider,omitempty"`
+	LanguageCodes          map[domain.LanguageCode]domain.LanguageCode `json:"language_codes,omitempty"`
+}
+
+// ProfileProvider implements the domain.DetectionProfile interface for one tenant
+type ProfileProvider struct {
+	*ConfigProvider
+	profile Profile
+}
+
+// GetSupportedLanguages returns the languages of the profile, or the service-wide ones
+func (pp *ProfileProvider) GetSupportedLanguages() []domain.LanguageCode {
+	if pp.profile.SupportedLanguages != nil {
+		return pp.profile.SupportedLanguages
+	}
+	return pp.ConfigProvider.GetSupportedLanguages()
+}
+
+// GetMinConfidenceThreshold returns the threshold of the profile, or the service-wide one
+func (pp *ProfileProvider) GetMinConfidenceThreshold() float32 {
+	if pp.profile.MinConfidenceThreshold != nil {
+		return *pp.profile.MinConfidenceThreshold
+	}
+	return pp.ConfigProvider.GetMinConfidenceThreshold()
+}
+
+// GetPreferredProvider returns the provider of the profile, or ""
+func (pp *ProfileProvider) GetPreferredProvider() string {
+	return pp.profile.Provider
+}
+
+// MapLanguageCode returns the profile's code for a detected language
+func (pp *ProfileProvider) MapLanguageCode(code domain.LanguageCode) domain.LanguageCode {
+	if mapped, ok := pp.profile.LanguageCodes[code]; ok {
+		return mapped
+	}
+	return code
+}
+
+// Profiles implements the domain.ConfigResolver interface with per-tenant
+// profiles. Tenants without a profile get the DefaultTenant profile, or the
+// service-wide configuration.
+type Profiles struct {
+	profiles map[string]*ProfileProvider
+	fallback domain.ConfigProvider
+}
+
+// LoadProfiles reads a JSON object of tenant IDs to profiles from a file
+func LoadProfiles(path string, base *ConfigProvider) (*Profiles, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tenant profiles: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var profiles map[string]Profile
+	if err := decoder.Decode(&profiles); err != nil {
+		return nil, fmt.Errorf("failed to parse tenant profiles: %w", err)
+	}
+	return NewProfiles(profiles, base)
+}
+
+// NewProfiles validates the profiles and layers them over the base configuration
+func NewProfiles(profiles map[string]Profile, base *ConfigProvider) (*Profiles, error) {
+	p := &Profiles{profiles: make(map[string]*ProfileProvider, len(profiles)), fallback: base}
+	for tenant, profile := range profiles {
+		if tenant == "" {
+			return nil, fmt.Errorf("tenant profile has an empty tenant ID")
+		}
+		if err := validateProfile(profile); err != nil {
+			return nil, fmt.Errorf("tenant profile %q: %w", tenant, err)
+		}
+		p.profiles[tenant] = &ProfileProvider{ConfigProvider: base, profile: profile}
+	}
+	if profile, ok := p.profiles[domain.DefaultTenant]; ok {
+		p.fallback = profile
+	}
+	return p, nil
+}
+
+// ConfigFor returns the profile of a tenant
+func (p *Profiles) ConfigFor(tenantID string) domain.ConfigProvider {
+	if profile, ok := p.profiles[tenantID]; ok {
+		return profile
+	}
+	return p.fallback
+}
+
+// Len returns the number of profiles
+func (p *Profiles) Len() int {
+	return len(p.profiles)
+}
+
+// CheckProviders returns an error if a profile prefers a provider that is not available
+func (p *Profiles) CheckProviders(available []string) error {
+	for tenant, profile := range p.profiles {
+		if provider := profile.profile.Provider; provider != "" && !slices.Contains(available, provider) {
+			return fmt.Errorf("tenant profile %q prefers unknown provider %q, available: %v", tenant, provider, available)
+		}
+	}
+	return nil
+}
+
+// validateProfile checks the values of a profile
+func validateProfile(profile Profile) error {
+	if t := profile.MinConfidenceThreshold; t != nil && (*t < 0 || *t > 1) {
+		return fmt.Errorf("min confidence threshold must be between 0 and 1")
+	}
+	if profile.SupportedLanguages != nil && len(profile.SupportedLanguages) == 0 {
+		return fmt.Errorf("supported languages cannot be empty")
+	}
+	for _, code := range profile.SupportedLanguages {
+		if code == "" {
+			return fmt.Errorf("supported languages contain an empty code")
+		}
+	}
+	for from, to := range profile.LanguageCodes {
+		if from == "" || to == "" {
+			return fmt.Errorf("language codes cannot map from or to an empty code")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func writeProfiles(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write profiles: %v", err)
+	}
+	return path
+}
+
+func TestLoadProfiles(t *testing.T) {
+	base := NewConfigProvider()
+	path := writeProfiles(t, `{
+		"default": {"min_confidence_threshold": 0.3},
+		"acme": {
+			"supported_languages": ["en", "fr"],
+			"min_confidence_threshold": 0.6,
+			"provider": "fallback",
+			"language_codes": {"en-US": "en", "fr-FR": "fr"}
+		}
+	}`)
+
+	profiles, err := LoadProfiles(path, base)
+	if err != nil {
+		t.Fatalf("LoadProfiles() error = %v, want nil", err)
+	}
+	if profiles.Len() != 2 {
+		t.Errorf("Expected 2 profiles, got %d", profiles.Len())
+	}
+
+	acme, ok := profiles.ConfigFor("acme").(domain.DetectionProfile)
+	if !ok {
+		t.Fatal("Expected the acme profile to be a DetectionProfile")
+	}
+	if acme.GetMinConfidenceThreshold() != 0.6 || acme.GetPreferredProvider() != "fallback" {
+		t.Errorf("Expected threshold 0.6 and provider fallback, got %.2f and %s",
+			acme.GetMinConfidenceThreshold(), acme.GetPreferredProvider())
+	}
+	if languages := acme.GetSupportedLanguages(); len(languages) != 2 || languages[0] != "en" {
+		t.Errorf("Expected languages [en fr], got %v", languages)
+	}
+	if acme.MapLanguageCode("fr-FR") != "fr" || acme.MapLanguageCode("de-DE") != "de-DE" {
+		t.Errorf("Expected fr-FR to map to fr and de-DE to stay, got %s and %s",
+			acme.MapLanguageCode("fr-FR"), acme.MapLanguageCode("de-DE"))
+	}
+	if acme.GetMaxTextLength() != base.GetMaxTextLength() {
+		t.Errorf("Expected the max text length to be inherited, got %d", acme.GetMaxTextLength())
+	}
+
+	// Unknown tenants get the default profile, which inherits unset fields
+	fallback := profiles.ConfigFor("globex")
+	if fallback.GetMinConfidenceThreshold() != 0.3 {
+		t.Errorf("Expected the default threshold 0.3, got %.2f", fallback.GetMinConfidenceThreshold())
+	}
+	if len(fallback.GetSupportedLanguages()) != len(base.GetSupportedLanguages()) {
+		t.Errorf("Expected the default profile to inherit the supported languages, got %v", fallback.GetSupportedLanguages())
+	}
+
+	if err := profiles.CheckProviders([]string{"aws-comprehend", "fallback"}); err != nil {
+		t.Errorf("CheckProviders() error = %v, want nil", err)
+	}
+	if err := profiles.CheckProviders([]string{"aws-comprehend"}); err == nil {
+		t.Error("Expected an error for an unavailable provider")
+	}
+}
+
+func TestProfiles_WithoutDefault(t *testing.T) {
+	base := NewConfigProvider()
+	profiles, err := NewProfiles(map[string]Profile{"acme": {Provider: "fallback"}}, base)
+	if err != nil {
+		t.Fatalf("NewProfiles() error = %v, want nil", err)
+	}
+
+	if profiles.ConfigFor("globex") != domain.ConfigProvider(base) {
+		t.Error("Expected unknown tenants to get the service-wide configuration")
+	}
+}
+
+func TestLoadProfiles_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"Malformed JSON", `{"acme": `},
+		{"Unknown field", `{"acme": {"threshold": 0.5}}`},
+		{"Threshold above 1", `{"acme": {"min_confidence_threshold": 1.5}}`},
+		{"Empty languages", `{"acme": {"supported_languages": []}}`},
+		{"Empty mapped code", `{"acme": {"language_codes": {"en-US": ""}}}`},
+		{"Empty tenant", `{"": {}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadProfiles(writeProfiles(t, tt.content), NewConfigProvider()); err == nil {
+				t.Error("Expected an error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// Quotas parses the quota of each tenant. Limits of 0 are unlimited.
+func (c *Config) Quotas() (map[string]domain.Quota, error) {
+	quotas := make(map[string]domain.Quota)
+	for _, entry := range splitList(c.TenantQuotas) {
+		tenant, spec, ok := strings.Cut(entry, "=")
+		limits := strings.Split(spec, ":")
+		if !ok || strings.TrimSpace(tenant) == "" || len(limits) != 4 {
+			return nil, fmt.Errorf("invalid tenant quota %q, want tenant=daily_docs:daily_chars:monthly_docs:monthly_chars", entry)
+		}
+
+		values := make([]int64, len(limits))
+		for i, limit := range limits {
+			value, err := strconv.ParseInt(strings.TrimSpace(limit), 10, 64)
+			if err != nil || value < 0 {
+				return nil, fmt.Errorf("invalid limit in tenant quota %q: must be a non-negative integer", entry)
+			}
+			values[i] = value
+		}
+
+		quotas[strings.TrimSpace(tenant)] = domain.Quota{
+			DailyDocuments:    values[0],
+			DailyCharacters:   values[1],
+			MonthlyDocuments:  values[2],
+			MonthlyCharacters: values[3],
+		}
+	}
+	return quotas, nil
+}
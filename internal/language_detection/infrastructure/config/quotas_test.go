@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func TestConfig_Quotas(t *testing.T) {
+	config := &Config{TenantQuotas: "default=1000:100000:20000:2000000, acme = 0:0:50000:0"}
+
+	quotas, err := config.Quotas()
+	if err != nil {
+		t.Fatalf("Quotas() error = %v, want nil", err)
+	}
+
+	expected := domain.Quota{DailyDocuments: 1000, DailyCharacters: 100000, MonthlyDocuments: 20000, MonthlyCharacters: 2000000}
+	if quotas[domain.DefaultTenant] != expected {
+		t.Errorf("Expected default quota %+v, got %+v", expected, quotas[domain.DefaultTenant])
+	}
+	if quotas["acme"] != (domain.Quota{MonthlyDocuments: 50000}) {
+		t.Errorf("Expected acme to only limit monthly documents, got %+v", quotas["acme"])
+	}
+}
+
+func TestConfig_QuotasInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		quotas string
+	}{
+		{"Missing tenant", "=1:1:1:1"},
+		{"Missing limits", "acme=1:1"},
+		{"Negative limit", "acme=1:-1:1:1"},
+		{"Not a number", "acme=1:1:many:1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := (&Config{TenantQuotas: tt.quotas}).Quotas(); err == nil {
+				t.Errorf("Expected an error for %q", tt.quotas)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"language-detection-service/internal/language_detection/infrastructure/ratelimit"
+)
+
+// RateLimits parses the rate limit classes and the class of each client.
+// It returns no classes when rate limiting is disabled.
+func (c *Config) RateLimits() (map[string]ratelimit.Limit, map[string]string, error) {
+	if strings.TrimSpace(c.RateLimitClasses) == "" {
+		if strings.TrimSpace(c.RateLimitClients) != "" {
+			return nil, nil, fmt.Errorf("rate limit clients require rate limit classes")
+		}
+		return nil, nil, nil
+	}
+
+	classes := make(map[string]ratelimit.Limit)
+	for _, entry := range splitList(c.RateLimitClasses) {
+		name, spec, ok := strings.Cut(entry, "=")
+		rate, burst, okSpec := strings.Cut(spec, ":")
+		if !ok || !okSpec || strings.TrimSpace(name) == "" {
+			return nil, nil, fmt.Errorf("invalid rate limit class %q, want class=rate:burst", entry)
+		}
+
+		limit := ratelimit.Limit{}
+		var err error
+		if limit.Rate, err = strconv.ParseFloat(strings.TrimSpace(rate), 64); err != nil || limit.Rate <= 0 {
+			return nil, nil, fmt.Errorf("invalid rate in rate limit class %q: must be a positive number", entry)
+		}
+		if limit.Burst, err = strconv.Atoi(strings.TrimSpace(burst)); err != nil || limit.Burst <= 0 {
+			return nil, nil, fmt.Errorf("invalid burst in rate limit class %q: must be a positive integer", entry)
+		}
+		classes[strings.TrimSpace(name)] = limit
+	}
+
+	if _, ok := classes[ratelimit.DefaultClass]; !ok {
+		return nil, nil, fmt.Errorf("rate limit classes must include %q", ratelimit.DefaultClass)
+	}
+
+	clients := make(map[string]string)
+	for _, entry := range splitList(c.RateLimitClients) {
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 {
+			return nil, nil, fmt.Errorf("invalid rate limit client %q, want identity=class", entry)
+		}
+
+		// Clients are named by the ID of their API key or token, never by the
+		// secret itself, so the entry is not quoted back in the error
+		identity, class := strings.TrimSpace(entry[:i]), strings.TrimSpace(entry[i+1:])
+		if strings.HasPrefix(identity, "key:") {
+			return nil, nil, fmt.Errorf("invalid rate limit client: API keys cannot identify clients, use principal:<id> with the id of the key")
+		}
+		if !strings.HasPrefix(identity, "principal:") && !strings.HasPrefix(identity, "cn:") && !strings.HasPrefix(identity, "ip:") {
+			return nil, nil, fmt.Errorf("invalid rate limit client %q: identity must start with principal:, cn: or ip:", entry)
+		}
+		if _, ok := classes[class]; !ok {
+			return nil, nil, fmt.Errorf("rate limit client %q uses unknown class %q", identity, class)
+		}
+		clients[identity] = class
+	}
+
+	return classes, clients, nil
+}
+
+// splitList splits a comma-separated list, dropping empty entries
+func splitList(list string) []string {
+	var entries []string
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
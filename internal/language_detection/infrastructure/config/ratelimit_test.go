@@ -0,0 +1,73 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"language-detection-service/internal/language_detection/infrastructure/ratelimit"
+)
+
+func TestConfig_RateLimits(t *testing.T) {
+	config := &Config{
+		RateLimitClasses: "default=10:20, premium=100.5:200",
+		RateLimitClients: "principal:reports=premium,cn:billing=premium,ip:10.0.0.1=default",
+	}
+
+	classes, clients, err := config.RateLimits()
+	if err != nil {
+		t.Fatalf("RateLimits() error = %v, want nil", err)
+	}
+
+	if classes["premium"] != (ratelimit.Limit{Rate: 100.5, Burst: 200}) {
+		t.Errorf("Expected premium 100.5:200, got %+v", classes["premium"])
+	}
+
+	if clients["principal:reports"] != "premium" || clients["cn:billing"] != "premium" || clients["ip:10.0.0.1"] != "default" {
+		t.Errorf("Unexpected clients %v", clients)
+	}
+}
+
+func TestConfig_RateLimitsDisabled(t *testing.T) {
+	classes, _, err := (&Config{}).RateLimits()
+	if err != nil || classes != nil {
+		t.Errorf("Expected rate limiting to be disabled, got %v (%v)", classes, err)
+	}
+}
+
+func TestConfig_RateLimitsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		classes string
+		clients string
+	}{
+		{"Missing burst", "default=10", ""},
+		{"Zero rate", "default=0:10", ""},
+		{"Negative burst", "default=10:-1", ""},
+		{"No default class", "premium=10:10", ""},
+		{"Unknown class", "default=10:10", "principal:reports=premium"},
+		{"Raw API key", "default=10:10", "key:abc=default"},
+		{"Unknown identity kind", "default=10:10", "user:abc=default"},
+		{"Clients without classes", "", "principal:reports=default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{RateLimitClasses: tt.classes, RateLimitClients: tt.clients}
+			if _, _, err := config.RateLimits(); err == nil {
+				t.Error("RateLimits() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestConfig_RateLimitsDoesNotEchoKeys(t *testing.T) {
+	config := &Config{RateLimitClasses: "default=10:10", RateLimitClients: "key:s3cret-api-key=default"}
+
+	_, _, err := config.RateLimits()
+	if err == nil {
+		t.Fatal("RateLimits() expected error, got nil")
+	}
+	if strings.Contains(err.Error(), "s3cret") {
+		t.Errorf("Expected the API key to be left out of the error, got %v", err)
+	}
+}
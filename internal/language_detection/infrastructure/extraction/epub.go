@@ -0,0 +1,181 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// epubContainer is the META-INF/container.xml document of an EPUB
+type epubContainer struct {
+	Rootfiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+// epubPackage is the OPF package document of an EPUB
+type epubPackage struct {
+	Manifest []struct {
+		ID   string `xml:"id,attr"`
+		Href string `xml:"href,attr"`
+	} `xml:"manifest>item"`
+	Spine []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+// htmlBlockElements end a paragraph in XHTML content
+var htmlBlockElements = map[string]bool{
+	"p": true, "div": true, "br": true, "li": true, "tr": true, "td": true, "th": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"blockquote": true, "pre": true, "section": true, "article": true, "dt": true, "dd": true,
+}
+
+// extractEPUB reads the spine documents of an EPUB in reading order, one section per document
+func extractEPUB(ctx context.Context, archive *zipArchive, builder *sectionBuilder) error {
+	data, err := archive.open("META-INF/container.xml")
+	if err != nil {
+		return err
+	}
+
+	var container epubContainer
+	if err := xml.Unmarshal(data, &container); err != nil || len(container.Rootfiles) == 0 {
+		return fmt.Errorf("%w: META-INF/container.xml has no root file", domain.ErrMalformedDocument)
+	}
+
+	packagePath := container.Rootfiles[0].FullPath
+	data, err = archive.open(packagePath)
+	if err != nil {
+		return err
+	}
+
+	var pkg epubPackage
+	if err := xml.Unmarshal(data, &pkg); err != nil {
+		return fmt.Errorf("%w: %s: %v", domain.ErrMalformedDocument, packagePath, err)
+	}
+
+	hrefs := make(map[string]string, len(pkg.Manifest))
+	for _, item := range pkg.Manifest {
+		hrefs[item.ID] = item.Href
+	}
+
+	for _, itemRef := range pkg.Spine {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		href, ok := hrefs[itemRef.IDRef]
+		if !ok {
+			return fmt.Errorf("%w: spine item %q is not in the manifest", domain.ErrMalformedDocument, itemRef.IDRef)
+		}
+		if unescaped, err := url.PathUnescape(href); err == nil {
+			href = unescaped
+		}
+
+		name := path.Join(path.Dir(packagePath), href)
+		data, err := archive.open(name)
+		if err != nil {
+			return err
+		}
+
+		if err := extractXHTML(data, name, builder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractXHTML adds the body text of an XHTML document as one section,
+// named after its first heading or, failing that, its title
+func extractXHTML(data []byte, name string, builder *sectionBuilder) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	var (
+		paragraph  strings.Builder
+		title      strings.Builder
+		heading    strings.Builder
+		paragraphs []string
+		inBody     bool
+		inTitle    bool
+		inHeading  bool
+		skip       int
+	)
+
+	endParagraph := func() {
+		paragraphs = append(paragraphs, paragraph.String())
+		paragraph.Reset()
+	}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", domain.ErrMalformedDocument, name, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := strings.ToLower(t.Name.Local)
+			switch {
+			case element == "body":
+				inBody = true
+			case element == "title":
+				inTitle = true
+			case element == "script" || element == "style":
+				skip++
+			case htmlBlockElements[element]:
+				endParagraph()
+				if len(element) == 2 && element[0] == 'h' && heading.Len() == 0 {
+					inHeading = true
+				}
+			}
+		case xml.EndElement:
+			element := strings.ToLower(t.Name.Local)
+			switch {
+			case element == "body":
+				inBody = false
+			case element == "title":
+				inTitle = false
+			case element == "script" || element == "style":
+				skip--
+			case htmlBlockElements[element]:
+				endParagraph()
+				inHeading = false
+			}
+		case xml.CharData:
+			switch {
+			case skip > 0:
+			case inTitle:
+				title.Write(t)
+			case inBody:
+				paragraph.Write(t)
+				if inHeading {
+					heading.Write(t)
+				}
+			}
+		}
+	}
+	endParagraph()
+
+	sectionName := normalizeSpace(heading.String())
+	if sectionName == "" {
+		sectionName = title.String()
+	}
+	builder.startSection(sectionName)
+	for _, p := range paragraphs {
+		builder.addParagraph(p)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package extraction
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+const (
+	// maxEntryBytes bounds the uncompressed size of a single archive entry
+	maxEntryBytes = 32 << 20
+
+	// maxArchiveBytes bounds the uncompressed bytes read from one archive,
+	// protecting against zip bombs
+	maxArchiveBytes = 64 << 20
+)
+
+// Extractor implements the TextExtractor interface for plain text, DOCX, ODT and EPUB files
+type Extractor struct{}
+
+// NewExtractor creates a new text extractor
+func NewExtractor() *Extractor {
+	return &Extractor{}
+}
+
+// SupportedMimeTypes returns the MIME types the extractor understands
+func (e *Extractor) SupportedMimeTypes() []string {
+	return []string{
+		domain.MimeTypePlainText,
+		domain.MimeTypeDOCX,
+		domain.MimeTypeODT,
+		domain.MimeTypeEPUB,
+	}
+}
+
+// Extract returns the non-empty text sections of a document in reading order
+func (e *Extractor) Extract(ctx context.Context, content []byte, mimeType string) ([]domain.DocumentSection, error) {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid MIME type %q", domain.ErrUnsupportedFormat, mimeType)
+	}
+
+	var builder sectionBuilder
+	switch mediaType {
+	case domain.MimeTypePlainText:
+		err = extractPlainText(content, &builder)
+	case domain.MimeTypeDOCX:
+		err = withArchive(content, func(archive *zipArchive) error {
+			return extractDOCX(archive, &builder)
+		})
+	case domain.MimeTypeODT:
+		err = withArchive(content, func(archive *zipArchive) error {
+			return extractODT(archive, &builder)
+		})
+	case domain.MimeTypeEPUB:
+		err = withArchive(content, func(archive *zipArchive) error {
+			return extractEPUB(ctx, archive, &builder)
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnsupportedFormat, mediaType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.finish(), nil
+}
+
+// extractPlainText treats a UTF-8 text file as a single section
+func extractPlainText(content []byte, builder *sectionBuilder) error {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(content) {
+		return fmt.Errorf("%w: text is not valid UTF-8", domain.ErrMalformedDocument)
+	}
+
+	for _, paragraph := range strings.Split(string(content), "\n") {
+		builder.addParagraph(paragraph)
+	}
+	return nil
+}
+
+// zipArchive reads entries of a zip file within a shared size budget
+type zipArchive struct {
+	reader    *zip.Reader
+	remaining int64
+}
+
+// withArchive opens content as a zip archive and passes it to fn
+func withArchive(content []byte, fn func(archive *zipArchive) error) error {
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrMalformedDocument, err)
+	}
+	return fn(&zipArchive{reader: reader, remaining: maxArchiveBytes})
+}
+
+// open returns the decompressed content of the named entry
+func (a *zipArchive) open(name string) ([]byte, error) {
+	for _, file := range a.reader.File {
+		if file.Name != name {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", domain.ErrMalformedDocument, name, err)
+		}
+		defer rc.Close()
+
+		limit := min(int64(maxEntryBytes), a.remaining)
+		data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", domain.ErrMalformedDocument, name, err)
+		}
+		if int64(len(data)) > limit {
+			return nil, fmt.Errorf("%w: %s is too large when uncompressed", domain.ErrMalformedDocument, name)
+		}
+		a.remaining -= int64(len(data))
+		return data, nil
+	}
+	return nil, fmt.Errorf("%w: missing %s", domain.ErrMalformedDocument, name)
+}
+
+// sectionBuilder collects paragraphs into named sections
+type sectionBuilder struct {
+	sections []domain.DocumentSection
+	name     string
+	text     strings.Builder
+}
+
+// startSection closes the current section and starts a new one
+func (b *sectionBuilder) startSection(name string) {
+	b.flush()
+	b.name = normalizeSpace(name)
+}
+
+// addParagraph appends a paragraph to the current section, collapsing whitespace
+func (b *sectionBuilder) addParagraph(paragraph string) {
+	paragraph = normalizeSpace(paragraph)
+	if paragraph == "" {
+		return
+	}
+	if b.text.Len() > 0 {
+		b.text.WriteByte('\n')
+	}
+	b.text.WriteString(paragraph)
+}
+
+// flush stores the current section if it has text
+func (b *sectionBuilder) flush() {
+	if b.text.Len() > 0 {
+		b.sections = append(b.sections, domain.DocumentSection{
+			Index: len(b.sections),
+			Name:  b.name,
+			Text:  domain.Text(b.text.String()),
+		})
+	}
+	b.name = ""
+	b.text.Reset()
+}
+
+// finish closes the last section and returns all sections
+func (b *sectionBuilder) finish() []domain.DocumentSection {
+	b.flush()
+	return b.sections
+}
+
+// normalizeSpace trims a string and collapses internal whitespace runs to a single space
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,177 @@
+package extraction
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// buildZip creates an in-memory zip archive from name/content pairs
+func buildZip(t *testing.T, files ...string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for i := 0; i+1 < len(files); i += 2 {
+		w, err := writer.Create(files[i])
+		if err != nil {
+			t.Fatalf("Failed to create zip entry: %v", err)
+		}
+		w.Write([]byte(files[i+1]))
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func assertSections(t *testing.T, sections []domain.DocumentSection, expected []domain.DocumentSection) {
+	t.Helper()
+
+	if len(sections) != len(expected) {
+		t.Fatalf("Expected %d sections, got %d: %+v", len(expected), len(sections), sections)
+	}
+	for i := range expected {
+		if sections[i] != expected[i] {
+			t.Errorf("Section %d = %+v, want %+v", i, sections[i], expected[i])
+		}
+	}
+}
+
+func TestExtractor_PlainText(t *testing.T) {
+	extractor := NewExtractor()
+
+	content := []byte("\xef\xbb\xbfHello   world.\r\n\r\nSecond  paragraph.\n")
+	sections, err := extractor.Extract(context.Background(), content, "text/plain; charset=utf-8")
+	if err != nil {
+		t.Fatalf("Extract() error = %v, want nil", err)
+	}
+
+	assertSections(t, sections, []domain.DocumentSection{
+		{Index: 0, Text: "Hello world.\nSecond paragraph."},
+	})
+}
+
+func TestExtractor_DOCX(t *testing.T) {
+	document := `<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+  <w:body>
+    <w:p><w:r><w:t>Preface text.</w:t></w:r></w:p>
+    <w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t>Chapter</w:t></w:r><w:r><w:t xml:space="preserve"> One</w:t></w:r></w:p>
+    <w:p><w:r><w:t>First</w:t><w:tab/><w:t>paragraph.</w:t></w:r></w:p>
+    <w:p><w:r><w:delText>deleted</w:delText></w:r></w:p>
+    <w:p><w:pPr><w:pStyle w:val="Title"/></w:pPr><w:r><w:t>Appendix</w:t></w:r></w:p>
+    <w:p><w:r><w:t>Last words.</w:t></w:r></w:p>
+  </w:body>
+</w:document>`
+
+	content := buildZip(t, "[Content_Types].xml", "<Types/>", "word/document.xml", document)
+	sections, err := NewExtractor().Extract(context.Background(), content, domain.MimeTypeDOCX)
+	if err != nil {
+		t.Fatalf("Extract() error = %v, want nil", err)
+	}
+
+	assertSections(t, sections, []domain.DocumentSection{
+		{Index: 0, Text: "Preface text."},
+		{Index: 1, Name: "Chapter One", Text: "Chapter One\nFirst paragraph."},
+		{Index: 2, Name: "Appendix", Text: "Appendix\nLast words."},
+	})
+}
+
+func TestExtractor_ODT(t *testing.T) {
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+    xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">
+  <office:body>
+    <office:text>
+      <text:tracked-changes><text:changed-region><text:p>removed</text:p></text:changed-region></text:tracked-changes>
+      <text:h text:outline-level="1">Introduction</text:h>
+      <text:p>Bonjour<text:s text:c="3"/>le monde.</text:p>
+      <text:p>Footnote<text:note><text:note-citation>1</text:note-citation><text:note-body><text:p>note</text:p></text:note-body></text:note> here.</text:p>
+    </office:text>
+  </office:body>
+</office:document-content>`
+
+	archive := buildZip(t, "mimetype", domain.MimeTypeODT, "content.xml", content)
+	sections, err := NewExtractor().Extract(context.Background(), archive, domain.MimeTypeODT)
+	if err != nil {
+		t.Fatalf("Extract() error = %v, want nil", err)
+	}
+
+	assertSections(t, sections, []domain.DocumentSection{
+		{Index: 0, Name: "Introduction", Text: "Introduction\nBonjour le monde.\nFootnote here."},
+	})
+}
+
+func TestExtractor_EPUB(t *testing.T) {
+	container := `<?xml version="1.0"?>
+<container xmlns="urn:oasis:names:tc:opendocument:xmlns:container" version="1.0">
+  <rootfiles><rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/></rootfiles>
+</container>`
+	opf := `<?xml version="1.0"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0">
+  <manifest>
+    <item id="ch2" href="text/chapter%202.xhtml" media-type="application/xhtml+xml"/>
+    <item id="ch1" href="text/chapter1.xhtml" media-type="application/xhtml+xml"/>
+  </manifest>
+  <spine><itemref idref="ch1"/><itemref idref="ch2"/></spine>
+</package>`
+	chapter1 := `<html xmlns="http://www.w3.org/1999/xhtml"><head><title>Book</title><style>p { color: red }</style></head>
+<body><h1>Kapitel&nbsp;1</h1><p>Es war einmal.</p><p>Ende<br/>gut.</p><script>var x;</script></body></html>`
+	chapter2 := `<html xmlns="http://www.w3.org/1999/xhtml"><head><title>Second</title></head>
+<body><p>Noch ein Kapitel.</p></body></html>`
+
+	archive := buildZip(t,
+		"mimetype", domain.MimeTypeEPUB,
+		"META-INF/container.xml", container,
+		"OEBPS/content.opf", opf,
+		"OEBPS/text/chapter1.xhtml", chapter1,
+		"OEBPS/text/chapter 2.xhtml", chapter2,
+	)
+
+	sections, err := NewExtractor().Extract(context.Background(), archive, domain.MimeTypeEPUB)
+	if err != nil {
+		t.Fatalf("Extract() error = %v, want nil", err)
+	}
+
+	assertSections(t, sections, []domain.DocumentSection{
+		{Index: 0, Name: "Kapitel 1", Text: "Kapitel 1\nEs war einmal.\nEnde\ngut."},
+		{Index: 1, Name: "Second", Text: "Noch ein Kapitel."},
+	})
+}
+
+func TestExtractor_Errors(t *testing.T) {
+	extractor := NewExtractor()
+	ctx := context.Background()
+
+	bomb := buildZip(t, "word/document.xml", strings.Repeat(" ", maxEntryBytes+1))
+
+	tests := []struct {
+		name     string
+		content  []byte
+		mimeType string
+		expected error
+	}{
+		{"Unsupported format", []byte("%PDF-1.7"), "application/pdf", domain.ErrUnsupportedFormat},
+		{"Invalid MIME type", []byte("text"), "not a mime type", domain.ErrUnsupportedFormat},
+		{"Invalid UTF-8", []byte{0xff, 0xfe, 0x00}, domain.MimeTypePlainText, domain.ErrMalformedDocument},
+		{"Not a zip archive", []byte("plain text"), domain.MimeTypeDOCX, domain.ErrMalformedDocument},
+		{"Missing document part", buildZip(t, "content.xml", "<x/>"), domain.MimeTypeDOCX, domain.ErrMalformedDocument},
+		{"Oversized entry", bomb, domain.MimeTypeDOCX, domain.ErrMalformedDocument},
+		{"Missing EPUB container", buildZip(t, "mimetype", domain.MimeTypeEPUB), domain.MimeTypeEPUB, domain.ErrMalformedDocument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := extractor.Extract(ctx, tt.content, tt.mimeType)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("Extract() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package extraction
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// XML namespaces of the office formats
+const (
+	wordNamespace = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	odtNamespace  = "urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+)
+
+// extractDOCX reads word/document.xml, starting a new section at every heading paragraph
+func extractDOCX(archive *zipArchive, builder *sectionBuilder) error {
+	data, err := archive.open("word/document.xml")
+	if err != nil {
+		return err
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var paragraph strings.Builder
+	heading := false
+	depth := 0
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: word/document.xml: %v", domain.ErrMalformedDocument, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space != wordNamespace {
+				continue
+			}
+			switch t.Name.Local {
+			case "p":
+				depth++
+				if depth == 1 {
+					paragraph.Reset()
+					heading = false
+				}
+			case "pStyle":
+				style := strings.ToLower(attr(t, "val"))
+				if strings.HasPrefix(style, "heading") || style == "title" {
+					heading = true
+				}
+			case "outlineLvl":
+				heading = true
+			case "tab":
+				paragraph.WriteByte(' ')
+			case "br", "cr":
+				paragraph.WriteByte('\n')
+			case "t":
+				var text string
+				if err := decoder.DecodeElement(&text, &t); err != nil {
+					return fmt.Errorf("%w: word/document.xml: %v", domain.ErrMalformedDocument, err)
+				}
+				paragraph.WriteString(text)
+			}
+		case xml.EndElement:
+			if t.Name.Space != wordNamespace || t.Name.Local != "p" {
+				continue
+			}
+			depth--
+			if depth == 0 {
+				if heading {
+					builder.startSection(paragraph.String())
+				}
+				builder.addParagraph(paragraph.String())
+			}
+		}
+	}
+}
+
+// extractODT reads content.xml, starting a new section at every heading
+func extractODT(archive *zipArchive, builder *sectionBuilder) error {
+	data, err := archive.open("content.xml")
+	if err != nil {
+		return err
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var paragraph strings.Builder
+	heading := false
+	depth := 0
+	skip := 0
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: content.xml: %v", domain.ErrMalformedDocument, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space != odtNamespace {
+				continue
+			}
+			switch t.Name.Local {
+			case "tracked-changes", "note":
+				// Deleted text and footnotes are not part of the reading text
+				skip++
+			case "p", "h":
+				depth++
+				if depth == 1 {
+					paragraph.Reset()
+					heading = t.Name.Local == "h"
+				}
+			case "s":
+				count, err := strconv.Atoi(attr(t, "c"))
+				if err != nil || count < 1 {
+					count = 1
+				}
+				paragraph.WriteString(strings.Repeat(" ", min(count, 100)))
+			case "tab":
+				paragraph.WriteByte(' ')
+			case "line-break":
+				paragraph.WriteByte('\n')
+			}
+		case xml.EndElement:
+			if t.Name.Space != odtNamespace {
+				continue
+			}
+			switch t.Name.Local {
+			case "tracked-changes", "note":
+				skip--
+			case "p", "h":
+				depth--
+				if depth == 0 && skip == 0 {
+					if heading {
+						builder.startSection(paragraph.String())
+					}
+					builder.addParagraph(paragraph.String())
+				}
+			}
+		case xml.CharData:
+			if depth > 0 && skip == 0 {
+				paragraph.Write(t)
+			}
+		}
+	}
+}
+
+// attr returns the value of the attribute with the given local name
+func attr(element xml.StartElement, local string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
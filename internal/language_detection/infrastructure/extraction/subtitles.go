@@ -0,0 +1,180 @@
+package extraction
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+var (
+	// cueTagPattern matches markup inside cue text: HTML-like tags, WebVTT
+	// voice, class and timestamp tags, and SSA override blocks used in SRT
+	cueTagPattern = regexp.MustCompile(`<[^>]*>|\{\\[^}]*\}`)
+
+	// cueTimestampPattern matches SRT (00:00:01,000) and WebVTT (00:01.000, 00:00:01.000) timestamps
+	cueTimestampPattern = regexp.MustCompile(`^(?:(\d+):)?(\d{1,2}):(\d{2})[,.](\d{1,3})$`)
+)
+
+// SubtitleParser implements the SubtitleParser interface for SRT and WebVTT files
+type SubtitleParser struct{}
+
+// NewSubtitleParser creates a new subtitle parser
+func NewSubtitleParser() *SubtitleParser {
+	return &SubtitleParser{}
+}
+
+// Parse returns the cues of a subtitle file in file order and the format it was read as
+func (p *SubtitleParser) Parse(content []byte, format domain.SubtitleFormat) ([]domain.SubtitleCue, domain.SubtitleFormat, error) {
+	if !utf8.Valid(content) {
+		return nil, format, fmt.Errorf("%w: subtitles are not valid UTF-8", domain.ErrMalformedDocument)
+	}
+
+	text := strings.TrimPrefix(string(content), "\ufeff")
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+
+	if format == "" {
+		format = domain.SubtitleFormatSRT
+		if isWebVTT(text) {
+			format = domain.SubtitleFormatWebVTT
+		}
+	}
+
+	var cues []domain.SubtitleCue
+	var err error
+	switch format {
+	case domain.SubtitleFormatSRT:
+		cues, err = parseCueBlocks(blocks(text))
+	case domain.SubtitleFormatWebVTT:
+		if !isWebVTT(text) {
+			return nil, format, fmt.Errorf("%w: missing WEBVTT header", domain.ErrMalformedDocument)
+		}
+		cues, err = parseCueBlocks(webVTTCueBlocks(blocks(text)[1:]))
+	default:
+		return nil, format, fmt.Errorf("%w: subtitle format %q", domain.ErrUnsupportedFormat, format)
+	}
+	return cues, format, err
+}
+
+// isWebVTT reports whether text starts with the WebVTT signature
+func isWebVTT(text string) bool {
+	return text == "WEBVTT" || strings.HasPrefix(text, "WEBVTT ") ||
+		strings.HasPrefix(text, "WEBVTT\t") || strings.HasPrefix(text, "WEBVTT\n")
+}
+
+// blocks splits subtitle text into blank-line separated blocks of lines
+func blocks(text string) [][]string {
+	var result [][]string
+	var current []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				result = append(result, current)
+				current = nil
+			}
+			continue
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 {
+		result = append(result, current)
+	}
+	return result
+}
+
+// webVTTCueBlocks drops NOTE, STYLE and REGION blocks
+func webVTTCueBlocks(all [][]string) [][]string {
+	var cues [][]string
+	for _, block := range all {
+		first := strings.TrimSpace(block[0])
+		if first == "NOTE" || strings.HasPrefix(first, "NOTE ") ||
+			first == "STYLE" || first == "REGION" {
+			continue
+		}
+		cues = append(cues, block)
+	}
+	return cues
+}
+
+// parseCueBlocks turns blocks of "[identifier]\ntiming\ntext..." lines into cues
+func parseCueBlocks(blocks [][]string) ([]domain.SubtitleCue, error) {
+	var cues []domain.SubtitleCue
+	for _, block := range blocks {
+		// The timing line is the first or, after a cue identifier, the second line
+		timing := 0
+		if !strings.Contains(block[0], "-->") {
+			timing = 1
+		}
+		if timing >= len(block) || !strings.Contains(block[timing], "-->") {
+			return nil, fmt.Errorf("%w: cue without timing line: %q", domain.ErrMalformedDocument, block[0])
+		}
+
+		start, end, err := parseCueTiming(block[timing])
+		if err != nil {
+			return nil, err
+		}
+
+		text := cleanCueText(block[timing+1:])
+		if text == "" {
+			continue
+		}
+
+		cues = append(cues, domain.SubtitleCue{
+			Index: len(cues),
+			Start: start,
+			End:   end,
+			Text:  domain.Text(text),
+		})
+	}
+	return cues, nil
+}
+
+// parseCueTiming parses "start --> end [settings]"
+func parseCueTiming(line string) (time.Duration, time.Duration, error) {
+	startText, rest, _ := strings.Cut(line, "-->")
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("%w: invalid cue timing %q", domain.ErrMalformedDocument, line)
+	}
+
+	start, err := parseCueTimestamp(strings.TrimSpace(startText))
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseCueTimestamp(fields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseCueTimestamp parses a timestamp in SRT or WebVTT notation
+func parseCueTimestamp(value string) (time.Duration, error) {
+	m := cueTimestampPattern.FindStringSubmatch(value)
+	if m == nil {
+		return 0, fmt.Errorf("%w: invalid cue timestamp %q", domain.ErrMalformedDocument, value)
+	}
+
+	hours, _ := strconv.Atoi(m[1])
+	minutes, _ := strconv.Atoi(m[2])
+	seconds, _ := strconv.Atoi(m[3])
+	fraction := (m[4] + "00")[:3]
+	millis, _ := strconv.Atoi(fraction)
+
+	return time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second +
+		time.Duration(millis)*time.Millisecond, nil
+}
+
+// cleanCueText strips markup from cue lines and joins them with spaces
+func cleanCueText(lines []string) string {
+	text := cueTagPattern.ReplaceAllString(strings.Join(lines, " "), "")
+	return normalizeSpace(html.UnescapeString(text))
+}
@@ -0,0 +1,102 @@
+package extraction
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func TestSubtitleParser_SRT(t *testing.T) {
+	content := "\ufeff1\r\n00:00:01,000 --> 00:00:03,500\r\n<i>Hello there,</i>\r\nmy friend.\r\n\r\n" +
+		"2\r\n00:00:04,000 --> 00:00:05,000 X1:100 X2:200\r\n{\\an8}Bonjour &amp; salut\r\n\r\n" +
+		"3\r\n00:00:06,000 --> 00:00:07,000\r\n<font color=\"red\"></font>\r\n"
+
+	cues, format, err := NewSubtitleParser().Parse([]byte(content), "")
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	if format != domain.SubtitleFormatSRT {
+		t.Errorf("Expected format srt, got %s", format)
+	}
+
+	// The third cue has no text and is dropped
+	expected := []domain.SubtitleCue{
+		{Index: 0, Start: time.Second, End: 3500 * time.Millisecond, Text: "Hello there, my friend."},
+		{Index: 1, Start: 4 * time.Second, End: 5 * time.Second, Text: "Bonjour & salut"},
+	}
+	if len(cues) != len(expected) {
+		t.Fatalf("Expected %d cues, got %d: %+v", len(expected), len(cues), cues)
+	}
+	for i := range expected {
+		if cues[i] != expected[i] {
+			t.Errorf("Cue %d = %+v, want %+v", i, cues[i], expected[i])
+		}
+	}
+}
+
+func TestSubtitleParser_WebVTT(t *testing.T) {
+	content := `WEBVTT - Test track
+
+NOTE This is a comment
+spanning two lines
+
+STYLE
+::cue { color: yellow }
+
+intro
+00:01.000 --> 00:02.500 align:start position:10%
+<v Roger>Hi <c.loud>everyone</c></v>
+
+01:00:00.250 --> 01:00:01.000
+Wie <00:00:00.500>geht's?
+`
+
+	cues, format, err := NewSubtitleParser().Parse([]byte(content), "")
+	if err != nil {
+		t.Fatalf("Parse() error = %v, want nil", err)
+	}
+
+	if format != domain.SubtitleFormatWebVTT {
+		t.Errorf("Expected format vtt, got %s", format)
+	}
+
+	expected := []domain.SubtitleCue{
+		{Index: 0, Start: time.Second, End: 2500 * time.Millisecond, Text: "Hi everyone"},
+		{Index: 1, Start: time.Hour + 250*time.Millisecond, End: time.Hour + time.Second, Text: "Wie geht's?"},
+	}
+	if len(cues) != len(expected) {
+		t.Fatalf("Expected %d cues, got %d: %+v", len(expected), len(cues), cues)
+	}
+	for i := range expected {
+		if cues[i] != expected[i] {
+			t.Errorf("Cue %d = %+v, want %+v", i, cues[i], expected[i])
+		}
+	}
+}
+
+func TestSubtitleParser_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		format   domain.SubtitleFormat
+		expected error
+	}{
+		{"Missing timing", "1\nHello\n", domain.SubtitleFormatSRT, domain.ErrMalformedDocument},
+		{"Bad timestamp", "1\n00:00:xx,000 --> 00:00:02,000\nHello\n", "", domain.ErrMalformedDocument},
+		{"Missing WebVTT header", "00:01.000 --> 00:02.000\nHello\n", domain.SubtitleFormatWebVTT, domain.ErrMalformedDocument},
+		{"Invalid UTF-8", "\xff\xfe", "", domain.ErrMalformedDocument},
+		{"Unknown format", "", "ass", domain.ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := NewSubtitleParser().Parse([]byte(tt.content), tt.format)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/auth"
+)
+
+// Metadata keys of the caller's credentials
+const (
+	apiKeyHeader        = "x-api-key"
+	authorizationHeader = "authorization"
+)
+
+// UnaryAuthInterceptor rejects unary calls without valid credentials and
+// attaches the caller and its tenant to the context. Methods starting with
+// one of the exempt prefixes are not authenticated.
+func UnaryAuthInterceptor(authenticator *auth.Authenticator, exempt []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if hasPrefix(info.FullMethod, exempt) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, authenticator, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor rejects streams without valid credentials and
+// attaches the caller and its tenant to the stream context
+func StreamAuthInterceptor(authenticator *auth.Authenticator, exempt []string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if hasPrefix(info.FullMethod, exempt) {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticate(ss.Context(), authenticator, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate returns ctx carrying the authenticated caller, or an Unauthenticated status
+func authenticate(ctx context.Context, authenticator *auth.Authenticator, method string) (context.Context, error) {
+	var apiKey, token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(apiKeyHeader); len(keys) > 0 {
+			apiKey = keys[0]
+		}
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			if scheme, credentials, ok := strings.Cut(values[0], " "); ok && strings.EqualFold(scheme, "bearer") {
+				token = strings.TrimSpace(credentials)
+			}
+		}
+	}
+
+	principal, err := authenticator.Authenticate(apiKey, token, verifiedClientCert(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Rejected call", "method", method, "peer", peerAddress(ctx), "error", err)
+		return nil, statusError(err, nil)
+	}
+	return domain.WithPrincipal(ctx, principal), nil
+}
+
+// peerAddress returns the address of the caller for logging
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// hasPrefix reports whether s starts with any of the prefixes
+func hasPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/auth"
+	pb "language-detection-service/pb-service/proto"
+)
+
+func authOptions(t *testing.T, exempt ...string) []grpc.ServerOption {
+	t.Helper()
+
+	sum := sha256.Sum256([]byte("secret"))
+	keys, err := auth.NewAPIKeys([]auth.APIKeyEntry{{ID: "billing", Tenant: "acme", SHA256: hex.EncodeToString(sum[:])}})
+	if err != nil {
+		t.Fatalf("NewAPIKeys() error = %v, want nil", err)
+	}
+	authenticator := auth.NewAuthenticator(keys, nil)
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryAuthInterceptor(authenticator, exempt)),
+		grpc.ChainStreamInterceptor(StreamAuthInterceptor(authenticator, exempt)),
+	}
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	client := startBufconnServer(t, tenantEchoService(), authOptions(t)...)
+	ctx := context.Background()
+
+	// The tenant comes from the API key, not from the tenant header
+	keyCtx := metadata.AppendToOutgoingContext(ctx, apiKeyHeader, "secret", tenantHeader, "globex")
+	resp, err := client.DetectLanguage(keyCtx, &pb.DetectLanguageRequest{Text: "hello"})
+	if err != nil {
+		t.Fatalf("DetectLanguage() error = %v, want nil", err)
+	}
+	if resp.Metadata.Provider != "acme" {
+		t.Errorf("Expected tenant acme, got %s", resp.Metadata.Provider)
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"No credentials", ctx},
+		{"Wrong API key", metadata.AppendToOutgoingContext(ctx, apiKeyHeader, "wrong")},
+		{"Bearer tokens not accepted", metadata.AppendToOutgoingContext(ctx, authorizationHeader, "Bearer abc")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.DetectLanguage(tt.ctx, &pb.DetectLanguageRequest{Text: "hello"})
+			st, details := statusDetails(t, err)
+
+			if st.Code() != codes.Unauthenticated {
+				t.Errorf("Expected code Unauthenticated, got %v", st.Code())
+			}
+			if details.info == nil || details.info.Reason != "UNAUTHENTICATED" {
+				t.Errorf("Expected ErrorInfo UNAUTHENTICATED, got %v", details.info)
+			}
+		})
+	}
+}
+
+func TestUnaryAuthInterceptor_Exempt(t *testing.T) {
+	authenticator := auth.NewAuthenticator(nil, nil)
+	interceptor := UnaryAuthInterceptor(authenticator, []string{"/grpc.health.v1."})
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+	if _, err := interceptor(context.Background(), nil, info, handler); err != nil {
+		t.Errorf("Expected health checks to be exempt, got %v", err)
+	}
+
+	info = &grpc.UnaryServerInfo{FullMethod: pb.LanguageDetectionService_DetectLanguage_FullMethodName}
+	if _, err := interceptor(context.Background(), nil, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected code Unauthenticated for detection, got %v", err)
+	}
+}
+
+func TestUnaryAuthInterceptor_ClientCertificate(t *testing.T) {
+	interceptor := UnaryAuthInterceptor(auth.NewAuthenticator(nil, nil), nil)
+	info := &grpc.UnaryServerInfo{FullMethod: pb.LanguageDetectionService_DetectLanguage_FullMethodName}
+	handler := func(ctx context.Context, req any) (any, error) {
+		principal, _ := domain.PrincipalFromContext(ctx)
+		return principal, nil
+	}
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing", Organization: []string{"acme"}}}
+	tlsPeer := &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4321},
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	}
+
+	resp, err := interceptor(peer.NewContext(context.Background(), tlsPeer), nil, info, handler)
+	if err != nil {
+		t.Fatalf("Expected the verified certificate to authenticate, got %v", err)
+	}
+	if principal := resp.(domain.Principal); principal.ID != "billing" || principal.TenantID != "acme" {
+		t.Errorf("Expected principal billing of tenant acme, got %+v", principal)
+	}
+
+	// Certificates presented but not verified do not count
+	plainPeer := &peer.Peer{
+		Addr:     tlsPeer.Addr,
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+	}
+	if _, err := interceptor(peer.NewContext(context.Background(), plainPeer), nil, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected code Unauthenticated for an unverified certificate, got %v", err)
+	}
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	client := startBufconnServer(t, tenantEchoService(), authOptions(t)...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.DetectLanguageStream(ctx)
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected code Unauthenticated without credentials, got %v", err)
+	}
+
+	stream, err = client.DetectLanguageStream(metadata.AppendToOutgoingContext(ctx, apiKeyHeader, "secret"))
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+	if err := stream.Send(&pb.StreamDetectLanguageRequest{SessionId: "s1", FragmentId: "f1", Text: "hello"}); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v, want nil", err)
+	}
+	if detection := resp.GetDetection(); detection == nil || detection.Metadata.Provider != "acme" {
+		t.Errorf("Expected a detection for tenant acme, got %v", resp)
+	}
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// ListSupportedLanguages implements the ListSupportedLanguages gRPC method
+func (s *Server) ListSupportedLanguages(
+	ctx context.Context,
+	req *pb.ListSupportedLanguagesRequest,
+) (*pb.ListSupportedLanguagesResponse, error) {
+	if s.catalog == nil {
+		return nil, status.Error(codes.Unimplemented, "language catalog is not configured")
+	}
+
+	languages, err := s.catalog.ListSupportedLanguages(ctx)
+	if err != nil {
+		return nil, statusError(err, nil)
+	}
+
+	resp := &pb.ListSupportedLanguagesResponse{}
+	for _, info := range languages {
+		if req.Provider != "" && !hasProvider(info, req.Provider) {
+			continue
+		}
+		resp.Languages = append(resp.Languages, convertToProtobufLanguageInfo(&info))
+	}
+
+	return resp, nil
+}
+
+// GetLanguageInfo implements the GetLanguageInfo gRPC method
+func (s *Server) GetLanguageInfo(
+	ctx context.Context,
+	req *pb.GetLanguageInfoRequest,
+) (*pb.LanguageInfo, error) {
+	if s.catalog == nil {
+		return nil, status.Error(codes.Unimplemented, "language catalog is not configured")
+	}
+
+	info, err := s.catalog.GetLanguageInfo(ctx, domain.LanguageCode(req.LanguageCode))
+	switch {
+	case errors.Is(err, domain.ErrInvalidLanguageCode):
+		// An unsupported language is a missing catalog entry, not a failed precondition
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, statusError(err, requestFields{domain.ErrInvalidRequest: "language_code"})
+	}
+
+	return convertToProtobufLanguageInfo(info), nil
+}
+
+// hasProvider reports whether the language can be produced by the named provider
+func hasProvider(info domain.LanguageInfo, provider string) bool {
+	for _, p := range info.Providers {
+		if p == provider {
+			return true
+		}
+	}
+	return false
+}
+
+// convertToProtobufLanguageInfo converts domain language info to its protobuf form
+func convertToProtobufLanguageInfo(info *domain.LanguageInfo) *pb.LanguageInfo {
+	return &pb.LanguageInfo{
+		LanguageCode: string(info.LanguageCode),
+		EnglishName:  info.EnglishName,
+		NativeName:   info.NativeName,
+		Script:       info.Script,
+		Direction:    info.Direction,
+		Providers:    info.Providers,
+	}
+}
@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// MockLanguageCatalogService is a mock implementation of LanguageCatalogService
+type MockLanguageCatalogService struct {
+	languages []domain.LanguageInfo
+}
+
+func (m *MockLanguageCatalogService) ListSupportedLanguages(ctx context.Context) ([]domain.LanguageInfo, error) {
+	return m.languages, nil
+}
+
+func (m *MockLanguageCatalogService) GetLanguageInfo(ctx context.Context, code domain.LanguageCode) (*domain.LanguageInfo, error) {
+	if code == "" {
+		return nil, domain.ErrInvalidRequest
+	}
+	for _, info := range m.languages {
+		if info.LanguageCode == code {
+			return &info, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", domain.ErrInvalidLanguageCode, code)
+}
+
+func newMockCatalog() *MockLanguageCatalogService {
+	return &MockLanguageCatalogService{
+		languages: []domain.LanguageInfo{
+			{
+				LanguageCode: "en-US",
+				EnglishName:  "English",
+				NativeName:   "English",
+				Script:       "Latn",
+				Direction:    domain.DirectionLTR,
+				Providers:    []string{"fallback", "aws-comprehend"},
+			},
+			{
+				LanguageCode: "ar-SA",
+				EnglishName:  "Arabic",
+				NativeName:   "العربية",
+				Script:       "Arab",
+				Direction:    domain.DirectionRTL,
+				Providers:    []string{"aws-comprehend"},
+			},
+		},
+	}
+}
+
+func TestNewServer_WithLanguageCatalog(t *testing.T) {
+	catalog := newMockCatalog()
+
+	server := NewServer(&MockLanguageDetectionService{},
+		WithLanguageCatalog(catalog),
+		grpc.MaxConcurrentStreams(10),
+	)
+
+	if server.catalog != catalog {
+		t.Errorf("Expected catalog to be %v, got %v", catalog, server.catalog)
+	}
+}
+
+func TestServer_ListSupportedLanguages(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{}, WithLanguageCatalog(newMockCatalog()))
+
+	resp, err := server.ListSupportedLanguages(context.Background(), &pb.ListSupportedLanguagesRequest{})
+	if err != nil {
+		t.Fatalf("ListSupportedLanguages() error = %v, want nil", err)
+	}
+
+	if len(resp.Languages) != 2 {
+		t.Fatalf("Expected 2 languages, got %d", len(resp.Languages))
+	}
+
+	arabic := resp.Languages[1]
+	if arabic.LanguageCode != "ar-SA" {
+		t.Errorf("Expected language code 'ar-SA', got %s", arabic.LanguageCode)
+	}
+	if arabic.NativeName != "العربية" {
+		t.Errorf("Expected native name 'العربية', got %s", arabic.NativeName)
+	}
+	if arabic.Script != "Arab" {
+		t.Errorf("Expected script 'Arab', got %s", arabic.Script)
+	}
+	if arabic.Direction != "rtl" {
+		t.Errorf("Expected direction 'rtl', got %s", arabic.Direction)
+	}
+	if len(arabic.Providers) != 1 || arabic.Providers[0] != "aws-comprehend" {
+		t.Errorf("Expected providers [aws-comprehend], got %v", arabic.Providers)
+	}
+}
+
+func TestServer_ListSupportedLanguages_ProviderFilter(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{}, WithLanguageCatalog(newMockCatalog()))
+
+	resp, err := server.ListSupportedLanguages(context.Background(), &pb.ListSupportedLanguagesRequest{
+		Provider: "fallback",
+	})
+	if err != nil {
+		t.Fatalf("ListSupportedLanguages() error = %v, want nil", err)
+	}
+
+	if len(resp.Languages) != 1 || resp.Languages[0].LanguageCode != "en-US" {
+		t.Errorf("Expected only en-US for the fallback provider, got %v", resp.Languages)
+	}
+}
+
+func TestServer_GetLanguageInfo(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{}, WithLanguageCatalog(newMockCatalog()))
+	ctx := context.Background()
+
+	info, err := server.GetLanguageInfo(ctx, &pb.GetLanguageInfoRequest{LanguageCode: "en-US"})
+	if err != nil {
+		t.Fatalf("GetLanguageInfo() error = %v, want nil", err)
+	}
+
+	if info.EnglishName != "English" {
+		t.Errorf("Expected English name 'English', got %s", info.EnglishName)
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		expected codes.Code
+	}{
+		{"Missing code", "", codes.InvalidArgument},
+		{"Unsupported code", "fr-FR", codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.GetLanguageInfo(ctx, &pb.GetLanguageInfoRequest{LanguageCode: tt.code})
+			if status.Code(err) != tt.expected {
+				t.Errorf("Expected code %v, got %v", tt.expected, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestServer_Catalog_NotConfigured(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{})
+	ctx := context.Background()
+
+	_, err := server.ListSupportedLanguages(ctx, &pb.ListSupportedLanguagesRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected code Unimplemented, got %v", status.Code(err))
+	}
+
+	_, err = server.GetLanguageInfo(ctx, &pb.GetLanguageInfoRequest{LanguageCode: "en-US"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected code Unimplemented, got %v", status.Code(err))
+	}
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// DetectDocumentLanguage implements the DetectDocumentLanguage gRPC method
+func (s *Server) DetectDocumentLanguage(
+	ctx context.Context,
+	req *pb.DetectDocumentLanguageRequest,
+) (*pb.DetectDocumentLanguageResponse, error) {
+	if s.documents == nil {
+		return nil, status.Error(codes.Unimplemented, "document detection is not configured")
+	}
+
+	resp, err := s.documents.DetectDocumentLanguage(ctx, &domain.DocumentDetectionRequest{
+		Content:    req.Content,
+		MimeType:   req.MimeType,
+		DocumentID: req.DocumentId,
+		Metadata:   req.Metadata,
+	})
+	if err != nil {
+		return nil, statusError(err, documentFields)
+	}
+
+	pbResp := &pb.DetectDocumentLanguageResponse{
+		LanguageCode:   string(resp.LanguageCode),
+		Confidence:     float32(resp.Confidence),
+		Alternatives:   convertToProtobufAlternatives(resp.Alternatives),
+		DocumentId:     resp.DocumentID,
+		MimeType:       resp.MimeType,
+		CharacterCount: resp.CharacterCount,
+		Metadata: &pb.ProcessingMetadata{
+			ProcessingTimeMs: resp.Metadata.ProcessingTimeMs,
+			ServiceVersion:   resp.Metadata.ServiceVersion,
+			ModelVersion:     resp.Metadata.ModelVersion,
+			Provider:         resp.Metadata.Provider,
+		},
+	}
+	for _, section := range resp.Sections {
+		pbResp.Sections = append(pbResp.Sections, &pb.SectionDetection{
+			Index:          int32(section.Index),
+			Name:           section.Name,
+			CharacterCount: section.CharacterCount,
+			LanguageCode:   string(section.LanguageCode),
+			Confidence:     float32(section.Confidence),
+			Alternatives:   convertToProtobufAlternatives(section.Alternatives),
+			Error:          section.Error,
+		})
+	}
+
+	return pbResp, nil
+}
+
+// convertToProtobufAlternatives converts domain alternatives to their protobuf form
+func convertToProtobufAlternatives(alternatives []domain.LanguageAlternative) []*pb.LanguageAlternative {
+	var pbAlternatives []*pb.LanguageAlternative
+	for _, alt := range alternatives {
+		pbAlternatives = append(pbAlternatives, &pb.LanguageAlternative{
+			LanguageCode: string(alt.LanguageCode),
+			Confidence:   float32(alt.Confidence),
+		})
+	}
+	return pbAlternatives
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// MockDocumentDetectionService is a mock implementation of DocumentDetectionService
+type MockDocumentDetectionService struct {
+	request  *domain.DocumentDetectionRequest
+	response *domain.DocumentDetectionResponse
+	err      error
+}
+
+func (m *MockDocumentDetectionService) DetectDocumentLanguage(ctx context.Context, request *domain.DocumentDetectionRequest) (*domain.DocumentDetectionResponse, error) {
+	m.request = request
+	return m.response, m.err
+}
+
+func TestServer_DetectDocumentLanguage(t *testing.T) {
+	documents := &MockDocumentDetectionService{
+		response: &domain.DocumentDetectionResponse{
+			DocumentID:     "book",
+			MimeType:       domain.MimeTypeEPUB,
+			LanguageCode:   "de-DE",
+			Confidence:     0.8,
+			Alternatives:   []domain.LanguageAlternative{{LanguageCode: "en-US", Confidence: 0.2}},
+			CharacterCount: 120,
+			Sections: []domain.SectionDetection{
+				{Index: 0, Name: "Kapitel 1", CharacterCount: 100, LanguageCode: "de-DE", Confidence: 1},
+				{Index: 1, Name: "Notes", CharacterCount: 20, Error: "language detection confidence too low"},
+			},
+			Metadata: domain.ProcessingMetadata{Provider: "fallback"},
+		},
+	}
+	server := NewServer(&MockLanguageDetectionService{}, WithDocumentService(documents))
+
+	resp, err := server.DetectDocumentLanguage(context.Background(), &pb.DetectDocumentLanguageRequest{
+		Content:    []byte("epub bytes"),
+		MimeType:   domain.MimeTypeEPUB,
+		DocumentId: "book",
+	})
+	if err != nil {
+		t.Fatalf("DetectDocumentLanguage() error = %v, want nil", err)
+	}
+
+	if string(documents.request.Content) != "epub bytes" || documents.request.MimeType != domain.MimeTypeEPUB {
+		t.Errorf("Unexpected domain request: %+v", documents.request)
+	}
+
+	if resp.LanguageCode != "de-DE" || resp.CharacterCount != 120 || resp.Metadata.Provider != "fallback" {
+		t.Errorf("Unexpected response: %+v", resp)
+	}
+
+	if len(resp.Alternatives) != 1 {
+		t.Errorf("Expected 1 alternative, got %d", len(resp.Alternatives))
+	}
+
+	if len(resp.Sections) != 2 {
+		t.Fatalf("Expected 2 sections, got %d", len(resp.Sections))
+	}
+
+	if resp.Sections[0].Name != "Kapitel 1" || resp.Sections[0].LanguageCode != "de-DE" {
+		t.Errorf("Unexpected first section: %+v", resp.Sections[0])
+	}
+
+	if resp.Sections[1].Error == "" {
+		t.Error("Expected second section to carry an error")
+	}
+}
+
+func TestServer_DetectDocumentLanguage_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{"Unsupported format", fmt.Errorf("text extraction failed: %w", domain.ErrUnsupportedFormat), codes.InvalidArgument},
+		{"Malformed document", fmt.Errorf("text extraction failed: %w", domain.ErrMalformedDocument), codes.InvalidArgument},
+		{"Too long", fmt.Errorf("validation failed: %w", domain.ErrTextTooLong), codes.InvalidArgument},
+		{"Low confidence", domain.ErrLowConfidence, codes.FailedPrecondition},
+		{"Deadline", context.DeadlineExceeded, codes.DeadlineExceeded},
+		{"Internal", domain.ErrInternalError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(&MockLanguageDetectionService{},
+				WithDocumentService(&MockDocumentDetectionService{err: tt.err}))
+
+			_, err := server.DetectDocumentLanguage(context.Background(), &pb.DetectDocumentLanguageRequest{})
+			if status.Code(err) != tt.expected {
+				t.Errorf("Expected code %s, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestServer_DetectDocumentLanguage_NotConfigured(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{})
+
+	_, err := server.DetectDocumentLanguage(context.Background(), &pb.DetectDocumentLanguageRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented, got %v", err)
+	}
+}
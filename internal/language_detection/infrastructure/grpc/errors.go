@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// errorDomain is the ErrorInfo domain of errors raised by this service
+const errorDomain = "language_detection.LanguageDetectionService"
+
+// providerRetryDelay is the delay suggested to clients when the provider is unavailable
+const providerRetryDelay = time.Second
+
+// errorMapping is the gRPC code and stable ErrorInfo reason of a domain error
+type errorMapping struct {
+	err    error
+	code   codes.Code
+	reason string
+}
+
+// errorMappings lists the domain errors clients can act on, checked in order
+var errorMappings = []errorMapping{
+	{domain.ErrProviderUnavailable, codes.Unavailable, "PROVIDER_UNAVAILABLE"},
+	{domain.ErrEmptyText, codes.InvalidArgument, "EMPTY_TEXT"},
+	{domain.ErrTextTooLong, codes.InvalidArgument, "TEXT_TOO_LONG"},
+	{domain.ErrUnsupportedFormat, codes.InvalidArgument, "UNSUPPORTED_FORMAT"},
+	{domain.ErrMalformedDocument, codes.InvalidArgument, "MALFORMED_DOCUMENT"},
+	{domain.ErrUnknownCharset, codes.InvalidArgument, "UNKNOWN_CHARSET"},
+	{domain.ErrInvalidOptions, codes.InvalidArgument, "INVALID_OPTIONS"},
+	{domain.ErrInvalidRequest, codes.InvalidArgument, "INVALID_REQUEST"},
+	{domain.ErrLowConfidence, codes.FailedPrecondition, "LOW_CONFIDENCE"},
+	{domain.ErrInvalidLanguageCode, codes.FailedPrecondition, "UNSUPPORTED_LANGUAGE"},
+	{domain.ErrJobNotFound, codes.NotFound, "JOB_NOT_FOUND"},
+	{domain.ErrTooManySessions, codes.ResourceExhausted, "TOO_MANY_SESSIONS"},
+	{domain.ErrQuotaExceeded, codes.ResourceExhausted, "QUOTA_EXCEEDED"},
+	{domain.ErrUnauthenticated, codes.Unauthenticated, "UNAUTHENTICATED"},
+	{domain.ErrPermissionDenied, codes.PermissionDenied, "PERMISSION_DENIED"},
+}
+
+// requestFields names the request field that each invalid-argument error refers to.
+// Errors missing from the map are reported without a BadRequest detail.
+type requestFields map[error]string
+
+var (
+	textFields = requestFields{
+		domain.ErrEmptyText:      "text",
+		domain.ErrTextTooLong:    "text",
+		domain.ErrInvalidOptions: "options",
+	}
+	documentFields = requestFields{
+		domain.ErrEmptyText:         "content",
+		domain.ErrTextTooLong:       "content",
+		domain.ErrMalformedDocument: "content",
+		domain.ErrUnsupportedFormat: "mime_type",
+		domain.ErrInvalidRequest:    "mime_type",
+	}
+	subtitleFields = requestFields{
+		domain.ErrEmptyText:         "content",
+		domain.ErrTextTooLong:       "content",
+		domain.ErrUnsupportedFormat: "format",
+	}
+	rawFields = requestFields{
+		domain.ErrEmptyText:      "content",
+		domain.ErrTextTooLong:    "content",
+		domain.ErrUnknownCharset: "charset",
+	}
+	usageFields = requestFields{
+		domain.ErrInvalidRequest: "period",
+	}
+)
+
+// classifyError returns the mapping of the first domain error that err wraps
+func classifyError(err error) errorMapping {
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			return m
+		}
+	}
+	return errorMapping{code: codes.Internal, reason: "INTERNAL"}
+}
+
+// statusError maps a domain error to a gRPC status error. Every status carries
+// an ErrorInfo with a stable reason; invalid arguments add a BadRequest field
+// violation when fields names the offending field, and provider outages add
+// a RetryInfo.
+func statusError(err error, fields requestFields) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	m := classifyError(err)
+	st := status.New(m.code, err.Error())
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: m.reason, Domain: errorDomain}}
+	if field, ok := fields[m.err]; ok && m.code == codes.InvalidArgument {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       field,
+				Description: err.Error(),
+				Reason:      m.reason,
+			}},
+		})
+	}
+	if m.code == codes.Unavailable {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(providerRetryDelay)})
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
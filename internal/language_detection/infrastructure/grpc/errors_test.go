@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// errorDetails collects the known details of a status error
+type errorDetails struct {
+	info       *errdetails.ErrorInfo
+	badRequest *errdetails.BadRequest
+	retry      *errdetails.RetryInfo
+}
+
+func statusDetails(t *testing.T, err error) (*status.Status, errorDetails) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("Expected a status error, got %v", err)
+	}
+
+	var details errorDetails
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			details.info = d
+		case *errdetails.BadRequest:
+			details.badRequest = d
+		case *errdetails.RetryInfo:
+			details.retry = d
+		}
+	}
+	return st, details
+}
+
+func TestServer_DetectLanguage_StatusCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+		field  string
+		retry  bool
+	}{
+		{"Empty text", fmt.Errorf("validation failed: %w", domain.ErrEmptyText), codes.InvalidArgument, "EMPTY_TEXT", "text", false},
+		{"Text too long", fmt.Errorf("validation failed: %w", domain.ErrTextTooLong), codes.InvalidArgument, "TEXT_TOO_LONG", "text", false},
+		{"Invalid request", domain.ErrInvalidRequest, codes.InvalidArgument, "INVALID_REQUEST", "", false},
+		{"Invalid options", fmt.Errorf("validation failed: %w", domain.ErrInvalidOptions), codes.InvalidArgument, "INVALID_OPTIONS", "options", false},
+		{"Low confidence", fmt.Errorf("response validation failed: %w", domain.ErrLowConfidence), codes.FailedPrecondition, "LOW_CONFIDENCE", "", false},
+		{"Unsupported language", domain.ErrInvalidLanguageCode, codes.FailedPrecondition, "UNSUPPORTED_LANGUAGE", "", false},
+		{"Quota exceeded", fmt.Errorf("quota check failed: %w", domain.ErrQuotaExceeded), codes.ResourceExhausted, "QUOTA_EXCEEDED", "", false},
+		{"Unauthenticated", domain.ErrUnauthenticated, codes.Unauthenticated, "UNAUTHENTICATED", "", false},
+		{"Provider unavailable", fmt.Errorf("language detection failed: %w", domain.ErrProviderUnavailable), codes.Unavailable, "PROVIDER_UNAVAILABLE", "", true},
+		{"Internal", domain.ErrInternalError, codes.Internal, "INTERNAL", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(&MockLanguageDetectionService{err: tt.err})
+
+			_, err := server.DetectLanguage(context.Background(), &pb.DetectLanguageRequest{Text: "x"})
+			st, details := statusDetails(t, err)
+
+			if st.Code() != tt.code {
+				t.Errorf("Expected code %v, got %v", tt.code, st.Code())
+			}
+
+			if details.info == nil || details.info.Reason != tt.reason || details.info.Domain != errorDomain {
+				t.Errorf("Expected ErrorInfo with reason %s, got %v", tt.reason, details.info)
+			}
+
+			if tt.field == "" && details.badRequest != nil {
+				t.Errorf("Expected no BadRequest, got %v", details.badRequest)
+			}
+			if tt.field != "" {
+				if details.badRequest == nil || len(details.badRequest.FieldViolations) != 1 {
+					t.Fatalf("Expected one field violation, got %v", details.badRequest)
+				}
+				if got := details.badRequest.FieldViolations[0].Field; got != tt.field {
+					t.Errorf("Expected violation of field %s, got %s", tt.field, got)
+				}
+			}
+
+			if tt.retry != (details.retry != nil) {
+				t.Errorf("Expected RetryInfo %v, got %v", tt.retry, details.retry)
+			}
+			if tt.retry && details.retry.RetryDelay.AsDuration() != time.Second {
+				t.Errorf("Expected retry delay 1s, got %v", details.retry.RetryDelay.AsDuration())
+			}
+		})
+	}
+}
+
+func TestStatusError_Fields(t *testing.T) {
+	err := statusError(fmt.Errorf("%w: \"x-unknown\"", domain.ErrUnknownCharset), rawFields)
+	_, details := statusDetails(t, err)
+
+	if details.badRequest == nil || details.badRequest.FieldViolations[0].Field != "charset" {
+		t.Errorf("Expected violation of field charset, got %v", details.badRequest)
+	}
+
+	err = statusError(fmt.Errorf("%w: image/png", domain.ErrUnsupportedFormat), documentFields)
+	_, details = statusDetails(t, err)
+
+	if details.badRequest == nil || details.badRequest.FieldViolations[0].Field != "mime_type" {
+		t.Errorf("Expected violation of field mime_type, got %v", details.badRequest)
+	}
+}
+
+func TestStatusError_Context(t *testing.T) {
+	err := statusError(fmt.Errorf("detection failed: %w", context.DeadlineExceeded), textFields)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Expected code DeadlineExceeded, got %v", status.Code(err))
+	}
+
+	err = statusError(context.Canceled, textFields)
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("Expected code Canceled, got %v", status.Code(err))
+	}
+}
+
+func TestConvertToProtobufStreamError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+		reason   string
+	}{
+		{"Empty text", domain.ErrEmptyText, codes.InvalidArgument, "EMPTY_TEXT"},
+		{"Text too long", domain.ErrTextTooLong, codes.InvalidArgument, "TEXT_TOO_LONG"},
+		{"Low confidence", domain.ErrLowConfidence, codes.FailedPrecondition, "LOW_CONFIDENCE"},
+		{"Too many sessions", domain.ErrTooManySessions, codes.ResourceExhausted, "TOO_MANY_SESSIONS"},
+		{"Quota exceeded", domain.ErrQuotaExceeded, codes.ResourceExhausted, "QUOTA_EXCEEDED"},
+		{"Provider unavailable", domain.ErrProviderUnavailable, codes.Unavailable, "PROVIDER_UNAVAILABLE"},
+		{"Internal", domain.ErrInternalError, codes.Internal, "INTERNAL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertToProtobufStreamError(tt.err)
+			if codes.Code(got.Code) != tt.expected || got.Reason != tt.reason {
+				t.Errorf("Expected %v (%s), got %v (%s)", tt.expected, tt.reason, codes.Code(got.Code), got.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// SubmitDetectionJob implements the SubmitDetectionJob gRPC method
+func (s *Server) SubmitDetectionJob(
+	ctx context.Context,
+	req *pb.SubmitDetectionJobRequest,
+) (*pb.DetectionJob, error) {
+	if s.jobs == nil {
+		return nil, status.Error(codes.Unimplemented, "detection jobs are not configured")
+	}
+
+	documents := make([]domain.LanguageDetectionRequest, len(req.Documents))
+	for i, doc := range req.Documents {
+		documents[i] = convertFromProtobufRequest(doc)
+	}
+
+	job, err := s.jobs.SubmitJob(ctx, documents, req.Metadata)
+	if err != nil {
+		return nil, statusError(err, nil)
+	}
+
+	return convertToProtobufJob(job), nil
+}
+
+// GetDetectionJob implements the GetDetectionJob gRPC method
+func (s *Server) GetDetectionJob(
+	ctx context.Context,
+	req *pb.GetDetectionJobRequest,
+) (*pb.DetectionJob, error) {
+	if s.jobs == nil {
+		return nil, status.Error(codes.Unimplemented, "detection jobs are not configured")
+	}
+
+	job, err := s.jobs.GetJob(ctx, req.JobId)
+	if err != nil {
+		return nil, statusError(err, nil)
+	}
+
+	return convertToProtobufJob(job), nil
+}
+
+// ListDetectionJobResults implements the ListDetectionJobResults gRPC method
+func (s *Server) ListDetectionJobResults(
+	ctx context.Context,
+	req *pb.ListDetectionJobResultsRequest,
+) (*pb.ListDetectionJobResultsResponse, error) {
+	if s.jobs == nil {
+		return nil, status.Error(codes.Unimplemented, "detection jobs are not configured")
+	}
+
+	page, err := s.jobs.GetJobResults(ctx, req.JobId, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, statusError(err, nil)
+	}
+
+	resp := &pb.ListDetectionJobResultsResponse{
+		NextPageToken: page.NextPageToken,
+	}
+	for _, result := range page.Results {
+		pbResult := &pb.DetectionJobResult{
+			Index:      int32(result.Index),
+			DocumentId: result.DocumentID,
+			Error:      result.Error,
+		}
+		if result.Response != nil {
+			pbResult.Response = s.convertToProtobufResponse(result.Response)
+		}
+		resp.Results = append(resp.Results, pbResult)
+	}
+
+	return resp, nil
+}
+
+// convertToProtobufJob converts a domain job to its protobuf form
+func convertToProtobufJob(job *domain.Job) *pb.DetectionJob {
+	return &pb.DetectionJob{
+		JobId:              job.ID,
+		Status:             string(job.Status),
+		TotalDocuments:     int32(job.TotalDocuments),
+		ProcessedDocuments: int32(job.ProcessedDocuments),
+		FailedDocuments:    int32(job.FailedDocuments),
+		Metadata:           job.Metadata,
+		CreatedAtUnixMs:    unixMillis(job.CreatedAt),
+		UpdatedAtUnixMs:    unixMillis(job.UpdatedAt),
+		CompletedAtUnixMs:  unixMillis(job.CompletedAt),
+	}
+}
+
+// unixMillis converts a time to Unix milliseconds, keeping the zero time at zero
+func unixMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
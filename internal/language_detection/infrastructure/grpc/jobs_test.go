@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// MockJobService is a mock implementation of JobService
+type MockJobService struct {
+	submitted []domain.LanguageDetectionRequest
+	job       *domain.Job
+	page      *domain.JobResultPage
+	err       error
+}
+
+func (m *MockJobService) SubmitJob(ctx context.Context, documents []domain.LanguageDetectionRequest, metadata map[string]string) (*domain.Job, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.submitted = documents
+	return m.job, nil
+}
+
+func (m *MockJobService) GetJob(ctx context.Context, id string) (*domain.Job, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.job, nil
+}
+
+func (m *MockJobService) GetJobResults(ctx context.Context, id string, pageToken string, pageSize int) (*domain.JobResultPage, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.page, nil
+}
+
+func TestServer_SubmitDetectionJob(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	jobs := &MockJobService{
+		job: &domain.Job{
+			ID:             "job-1",
+			Status:         domain.JobStatusPending,
+			TotalDocuments: 2,
+			CreatedAt:      created,
+			UpdatedAt:      created,
+		},
+	}
+	server := NewServer(&MockLanguageDetectionService{}, WithJobService(jobs))
+
+	resp, err := server.SubmitDetectionJob(context.Background(), &pb.SubmitDetectionJobRequest{
+		Documents: []*pb.DetectLanguageRequest{
+			{Text: "Hello world", DocumentId: "a"},
+			{Text: "Hola mundo", DocumentId: "b"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SubmitDetectionJob() error = %v, want nil", err)
+	}
+
+	if len(jobs.submitted) != 2 || jobs.submitted[1].DocumentID != "b" {
+		t.Errorf("Expected 2 documents to be submitted, got %v", jobs.submitted)
+	}
+
+	if resp.JobId != "job-1" {
+		t.Errorf("Expected job ID 'job-1', got %s", resp.JobId)
+	}
+
+	if resp.Status != "pending" {
+		t.Errorf("Expected status 'pending', got %s", resp.Status)
+	}
+
+	if resp.CreatedAtUnixMs != created.UnixMilli() {
+		t.Errorf("Expected created time %d, got %d", created.UnixMilli(), resp.CreatedAtUnixMs)
+	}
+
+	if resp.CompletedAtUnixMs != 0 {
+		t.Errorf("Expected no completion time, got %d", resp.CompletedAtUnixMs)
+	}
+}
+
+func TestServer_GetDetectionJob(t *testing.T) {
+	jobs := &MockJobService{
+		job: &domain.Job{
+			ID:                 "job-1",
+			Status:             domain.JobStatusRunning,
+			TotalDocuments:     10,
+			ProcessedDocuments: 4,
+			FailedDocuments:    1,
+		},
+	}
+	server := NewServer(&MockLanguageDetectionService{}, WithJobService(jobs))
+
+	resp, err := server.GetDetectionJob(context.Background(), &pb.GetDetectionJobRequest{JobId: "job-1"})
+	if err != nil {
+		t.Fatalf("GetDetectionJob() error = %v, want nil", err)
+	}
+
+	if resp.Status != "running" || resp.ProcessedDocuments != 4 || resp.FailedDocuments != 1 {
+		t.Errorf("Unexpected job progress: %+v", resp)
+	}
+}
+
+func TestServer_ListDetectionJobResults(t *testing.T) {
+	jobs := &MockJobService{
+		page: &domain.JobResultPage{
+			Results: []domain.JobResult{
+				{Index: 0, DocumentID: "a", Response: &domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.9}},
+				{Index: 1, DocumentID: "b", Error: "text cannot be empty"},
+			},
+			NextPageToken: "2",
+		},
+	}
+	server := NewServer(&MockLanguageDetectionService{}, WithJobService(jobs))
+
+	resp, err := server.ListDetectionJobResults(context.Background(), &pb.ListDetectionJobResultsRequest{JobId: "job-1"})
+	if err != nil {
+		t.Fatalf("ListDetectionJobResults() error = %v, want nil", err)
+	}
+
+	if resp.NextPageToken != "2" {
+		t.Errorf("Expected next page token '2', got %s", resp.NextPageToken)
+	}
+
+	if len(resp.Results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(resp.Results))
+	}
+
+	if resp.Results[0].Response == nil || resp.Results[0].Response.LanguageCode != "en-US" {
+		t.Errorf("Expected English response for the first result, got %v", resp.Results[0].Response)
+	}
+
+	if resp.Results[1].Response != nil || resp.Results[1].Error == "" {
+		t.Errorf("Expected the second result to carry an error, got %+v", resp.Results[1])
+	}
+}
+
+func TestServer_DetectionJobs_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{"Invalid request", fmt.Errorf("%w: job contains no documents", domain.ErrInvalidRequest), codes.InvalidArgument},
+		{"Job not found", fmt.Errorf("%w: job-1", domain.ErrJobNotFound), codes.NotFound},
+		{"Store failure", fmt.Errorf("disk full"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(&MockLanguageDetectionService{}, WithJobService(&MockJobService{err: tt.err}))
+
+			_, err := server.GetDetectionJob(ctx, &pb.GetDetectionJobRequest{JobId: "job-1"})
+			if status.Code(err) != tt.expected {
+				t.Errorf("Expected code %v, got %v", tt.expected, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestServer_DetectionJobs_NotConfigured(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{})
+	ctx := context.Background()
+
+	_, err := server.SubmitDetectionJob(ctx, &pb.SubmitDetectionJobRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected code Unimplemented, got %v", status.Code(err))
+	}
+
+	_, err = server.ListDetectionJobResults(ctx, &pb.ListDetectionJobResultsRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected code Unimplemented, got %v", status.Code(err))
+	}
+}
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/logging"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// requestIDHeader is the metadata key carrying the request ID
+const requestIDHeader = "x-request-id"
+
+// UnaryRequestIDInterceptor attaches the request ID sent by the caller, or a
+// new one, to the context and returns it in the response headers
+func UnaryRequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = withRequestID(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, domain.RequestIDFromContext(ctx)))
+		return handler(ctx, req)
+	}
+}
+
+// StreamRequestIDInterceptor attaches the request ID sent by the caller, or a
+// new one, to the stream context and returns it in the response headers
+func StreamRequestIDInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRequestID(ss.Context())
+		ss.SetHeader(metadata.Pairs(requestIDHeader, domain.RequestIDFromContext(ctx)))
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// withRequestID returns ctx carrying the request ID from its metadata, or a new one
+func withRequestID(ctx context.Context) context.Context {
+	var sent string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDHeader); len(ids) > 0 {
+			sent = ids[0]
+		}
+	}
+	return domain.WithRequestID(ctx, domain.ResolveRequestID(sent))
+}
+
+// UnaryAccessLogInterceptor logs every call with its outcome and duration.
+// Request texts are described by redactor instead of being logged.
+func UnaryAccessLogInterceptor(logger *slog.Logger, redactor *logging.Redactor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		attrs := accessAttrs(ctx, info.FullMethod, start, err)
+		attrs = append(attrs, requestAttrs(req, redactor)...)
+		attrs = append(attrs, responseAttrs(resp)...)
+		logger.LogAttrs(ctx, accessLevel(status.Code(err)), "Request handled", attrs...)
+		return resp, err
+	}
+}
+
+// StreamAccessLogInterceptor logs every stream with its outcome, duration and
+// message counts once it ends
+func StreamAccessLogInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		counted := &countingStream{ServerStream: ss}
+		err := handler(srv, counted)
+
+		attrs := accessAttrs(ss.Context(), info.FullMethod, start, err)
+		attrs = append(attrs,
+			slog.Int("messages_received", counted.received),
+			slog.Int("messages_sent", counted.sent),
+		)
+		logger.LogAttrs(ss.Context(), accessLevel(status.Code(err)), "Stream handled", attrs...)
+		return err
+	}
+}
+
+// countingStream counts the messages received and sent on a server stream
+type countingStream struct {
+	grpc.ServerStream
+	received int
+	sent     int
+}
+
+func (s *countingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+	}
+	return err
+}
+
+func (s *countingStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+	}
+	return err
+}
+
+// accessAttrs describes the method, caller, outcome and duration of a call
+func accessAttrs(ctx context.Context, method string, start time.Time, err error) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.String("transport", "grpc"),
+		slog.String("method", method),
+		slog.String("peer", peerAddress(ctx)),
+		slog.String("code", status.Code(err).String()),
+		slog.Int64("duration_ms", time.Since(start).Milliseconds()),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+	}
+	return attrs
+}
+
+// requestAttrs describes the text or content of a request without revealing it
+func requestAttrs(req any, redactor *logging.Redactor) []slog.Attr {
+	switch r := req.(type) {
+	case interface{ GetText() string }:
+		return redactor.TextAttrs(r.GetText())
+	case interface{ GetContent() []byte }:
+		return redactor.ContentAttrs(r.GetContent())
+	case interface {
+		GetDocuments() []*pb.DetectLanguageRequest
+	}:
+		return []slog.Attr{slog.Int("documents", len(r.GetDocuments()))}
+	}
+	return nil
+}
+
+// responseAttrs describes the detected language of a response, if any
+func responseAttrs(resp any) []slog.Attr {
+	if raw, ok := resp.(*pb.DetectLanguageRawResponse); ok {
+		resp = raw.GetDetection()
+	}
+	if detection, ok := resp.(interface {
+		GetLanguageCode() string
+		GetConfidence() float32
+	}); ok && detection.GetLanguageCode() != "" {
+		return []slog.Attr{
+			slog.String("language", detection.GetLanguageCode()),
+			slog.Float64("confidence", float64(detection.GetConfidence())),
+		}
+	}
+	return nil
+}
+
+// accessLevel logs failures of the service above those of its callers
+func accessLevel(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
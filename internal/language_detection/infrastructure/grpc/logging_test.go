@@ -0,0 +1,190 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/logging"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// syncBuffer is a buffer the server goroutines can log to concurrently
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// records parses the JSON log records written so far
+func (b *syncBuffer) records(t *testing.T) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Failed to decode log record %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func loggingOptions(logger *slog.Logger, redactor *logging.Redactor) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryRequestIDInterceptor(), UnaryAccessLogInterceptor(logger, redactor)),
+		grpc.ChainStreamInterceptor(StreamRequestIDInterceptor(), StreamAccessLogInterceptor(logger)),
+	}
+}
+
+func TestUnaryRequestIDInterceptor(t *testing.T) {
+	var seen string
+	service := FuncLanguageDetectionService(func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		seen = domain.RequestIDFromContext(ctx)
+		return &domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.9}, nil
+	})
+	client := startBufconnServer(t, service, grpc.ChainUnaryInterceptor(UnaryRequestIDInterceptor()))
+
+	tests := []struct {
+		name string
+		sent string
+	}{
+		{"Propagated", "req-1"},
+		{"Generated", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.sent != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, requestIDHeader, tt.sent)
+			}
+
+			var header metadata.MD
+			if _, err := client.DetectLanguage(ctx, &pb.DetectLanguageRequest{Text: "hello"}, grpc.Header(&header)); err != nil {
+				t.Fatalf("DetectLanguage() error = %v, want nil", err)
+			}
+
+			if tt.sent != "" && seen != tt.sent {
+				t.Errorf("Expected request ID %s, got %s", tt.sent, seen)
+			}
+			if seen == "" {
+				t.Error("Expected a request ID in the service context")
+			}
+			if ids := header.Get(requestIDHeader); len(ids) != 1 || ids[0] != seen {
+				t.Errorf("Expected response header %s = %s, got %v", requestIDHeader, seen, ids)
+			}
+		})
+	}
+}
+
+func TestUnaryAccessLogInterceptor(t *testing.T) {
+	var buf syncBuffer
+	client := startBufconnServer(t, keywordService(), loggingOptions(logging.New(&buf, slog.LevelInfo), logging.NewRedactor("key", false))...)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), requestIDHeader, "req-1")
+	if _, err := client.DetectLanguage(ctx, &pb.DetectLanguageRequest{Text: "bonjour secret"}); err != nil {
+		t.Fatalf("DetectLanguage() error = %v, want nil", err)
+	}
+	if _, err := client.DetectLanguage(context.Background(), &pb.DetectLanguageRequest{}); err == nil {
+		t.Fatal("DetectLanguage() error = nil, want an error")
+	}
+
+	records := buf.records(t)
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 access log records, got %d", len(records))
+	}
+
+	expected := map[string]any{
+		"msg":         "Request handled",
+		"level":       "INFO",
+		"method":      pb.LanguageDetectionService_DetectLanguage_FullMethodName,
+		"code":        "OK",
+		"request_id":  "req-1",
+		"text_length": float64(14),
+		"language":    "fr-FR",
+	}
+	for key, value := range expected {
+		if records[0][key] != value {
+			t.Errorf("Expected %s = %v, got %v", key, value, records[0][key])
+		}
+	}
+	if _, ok := records[0]["text_hash"]; !ok {
+		t.Error("Expected a text hash in the access log")
+	}
+
+	if records[1]["code"] != "InvalidArgument" || records[1]["level"] != "WARN" {
+		t.Errorf("Expected a warning for the invalid request, got %v", records[1])
+	}
+
+	if strings.Contains(buf.String(), "secret") {
+		t.Error("Expected the request text not to be logged")
+	}
+}
+
+func TestStreamAccessLogInterceptor(t *testing.T) {
+	var buf syncBuffer
+	client := startBufconnServer(t, keywordService(), loggingOptions(logging.New(&buf, slog.LevelInfo), logging.NewRedactor("key", false))...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.DetectLanguageStream(ctx)
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+	if err := stream.Send(&pb.StreamDetectLanguageRequest{SessionId: "s1", FragmentId: "f1", Text: "hello"}); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("Recv() error = %v, want nil", err)
+	}
+	stream.CloseSend()
+	for {
+		if _, err := stream.Recv(); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatalf("Recv() error = %v, want EOF", err)
+		}
+	}
+
+	// The stream is logged after its status is sent
+	deadline := time.Now().Add(time.Second)
+	for len(buf.records(t)) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	records := buf.records(t)
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 access log record, got %d", len(records))
+	}
+	if records[0]["msg"] != "Stream handled" || records[0]["messages_received"] != float64(1) || records[0]["request_id"] == nil {
+		t.Errorf("Expected the stream with 1 message received and a request ID, got %v", records[0])
+	}
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// UnaryMetricsInterceptor counts each call by method and status code
+func UnaryMetricsInterceptor(metrics domain.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		metrics.RequestHandled("grpc", info.FullMethod, status.Code(err).String())
+		return resp, err
+	}
+}
+
+// StreamMetricsInterceptor counts each stream by method and status code
+func StreamMetricsInterceptor(metrics domain.Metrics) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		metrics.RequestHandled("grpc", info.FullMethod, status.Code(err).String())
+		return err
+	}
+}
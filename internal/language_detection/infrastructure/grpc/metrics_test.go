@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// MockMetrics records the requests reported to it
+type MockMetrics struct {
+	domain.NopMetrics
+	mu       sync.Mutex
+	requests []string
+}
+
+func (m *MockMetrics) RequestHandled(transport, method, code string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, transport+" "+method+" "+code)
+}
+
+func (m *MockMetrics) recorded() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.requests...)
+}
+
+func metricsOptions(metrics domain.Metrics) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryMetricsInterceptor(metrics)),
+		grpc.ChainStreamInterceptor(StreamMetricsInterceptor(metrics)),
+	}
+}
+
+func TestUnaryMetricsInterceptor(t *testing.T) {
+	metrics := &MockMetrics{}
+	client := startBufconnServer(t, keywordService(), metricsOptions(metrics)...)
+
+	if _, err := client.DetectLanguage(context.Background(), &pb.DetectLanguageRequest{Text: "hello"}); err != nil {
+		t.Fatalf("DetectLanguage() error = %v, want nil", err)
+	}
+	if _, err := client.DetectLanguage(context.Background(), &pb.DetectLanguageRequest{}); err == nil {
+		t.Fatal("DetectLanguage() error = nil, want an error")
+	}
+
+	method := pb.LanguageDetectionService_DetectLanguage_FullMethodName
+	expected := []string{"grpc " + method + " OK", "grpc " + method + " InvalidArgument"}
+	if got := metrics.recorded(); fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("Expected requests %v, got %v", expected, got)
+	}
+}
+
+func TestStreamMetricsInterceptor(t *testing.T) {
+	metrics := &MockMetrics{}
+	client := startBufconnServer(t, keywordService(), metricsOptions(metrics)...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.DetectLanguageStream(ctx)
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v, want nil", err)
+	}
+	if _, err := stream.Recv(); !errors.Is(err, io.EOF) {
+		t.Fatalf("Recv() error = %v, want EOF", err)
+	}
+
+	expected := []string{"grpc " + pb.LanguageDetectionService_DetectLanguageStream_FullMethodName + " OK"}
+	if got := metrics.recorded(); fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("Expected requests %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"google.golang.org/grpc"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// serverOption configures the language detection Server itself. It embeds
+// grpc.EmptyServerOption so it can be passed to NewServer next to regular
+// gRPC server options.
+type serverOption struct {
+	grpc.EmptyServerOption
+	apply func(*Server)
+}
+
+// WithLanguageCatalog enables the ListSupportedLanguages and GetLanguageInfo methods
+func WithLanguageCatalog(catalog domain.LanguageCatalogService) grpc.ServerOption {
+	return serverOption{apply: func(s *Server) {
+		s.catalog = catalog
+	}}
+}
+
+// WithJobService enables the asynchronous detection job methods
+func WithJobService(jobs domain.JobService) grpc.ServerOption {
+	return serverOption{apply: func(s *Server) {
+		s.jobs = jobs
+	}}
+}
+
+// WithDocumentService enables the DetectDocumentLanguage method
+func WithDocumentService(documents domain.DocumentDetectionService) grpc.ServerOption {
+	return serverOption{apply: func(s *Server) {
+		s.documents = documents
+	}}
+}
+
+// WithSubtitleService enables the DetectSubtitleLanguage method
+func WithSubtitleService(subtitles domain.SubtitleDetectionService) grpc.ServerOption {
+	return serverOption{apply: func(s *Server) {
+		s.subtitles = subtitles
+	}}
+}
+
+// WithRawTextService enables the DetectLanguageRaw method
+func WithRawTextService(raw domain.RawTextDetectionService) grpc.ServerOption {
+	return serverOption{apply: func(s *Server) {
+		s.raw = raw
+	}}
+}
+
+// WithUsageService enables the GetUsageReport method
+func WithUsageService(usage domain.UsageService) grpc.ServerOption {
+	return serverOption{apply: func(s *Server) {
+		s.usage = usage
+	}}
+}
+
+// splitServerOptions separates Server options from regular gRPC server options
+func splitServerOptions(opts []grpc.ServerOption) ([]serverOption, []grpc.ServerOption) {
+	var own []serverOption
+	var rest []grpc.ServerOption
+	for _, opt := range opts {
+		if o, ok := opt.(serverOption); ok {
+			own = append(own, o)
+			continue
+		}
+		rest = append(rest, opt)
+	}
+	return own, rest
+}
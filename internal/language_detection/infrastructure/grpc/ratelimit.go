@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"language-detection-service/internal/language_detection/infrastructure/ratelimit"
+)
+
+// unlimitedServices are the infrastructure services that are never rate limited
+var unlimitedServices = []string{"/grpc.health.v1.", "/grpc.reflection."}
+
+// UnaryRateLimitInterceptor rejects unary calls from clients over their limit
+func UnaryRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isUnlimited(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if err := checkRateLimit(ctx, limiter); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamRateLimitInterceptor charges every message received on a stream to
+// the client's limit. A message over the limit ends the stream.
+func StreamRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isUnlimited(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		return handler(srv, &rateLimitedStream{ServerStream: ss, limiter: limiter})
+	}
+}
+
+// rateLimitedStream checks the rate limit for every received message
+type rateLimitedStream struct {
+	grpc.ServerStream
+	limiter *ratelimit.Limiter
+}
+
+func (s *rateLimitedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkRateLimit(s.Context(), s.limiter)
+}
+
+// checkRateLimit returns a ResourceExhausted status telling the client when
+// to retry if it is over its limit
+func checkRateLimit(ctx context.Context, limiter *ratelimit.Limiter) error {
+	decision := limiter.Allow(clientIdentity(ctx))
+	if decision.Allowed {
+		return nil
+	}
+
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("rate limit of client class %q exceeded", decision.Class))
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   "RATE_LIMITED",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"class": decision.Class},
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(decision.RetryAfter)},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// clientIdentity names the caller for the limiter, see ratelimit.Identity
+func clientIdentity(ctx context.Context) string {
+	var address string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		address = p.Addr.String()
+	}
+	return ratelimit.Identity(ctx, verifiedClientCert(ctx), address)
+}
+
+// isUnlimited reports whether a method belongs to an infrastructure service
+func isUnlimited(fullMethod string) bool {
+	return hasPrefix(fullMethod, unlimitedServices)
+}
+
+// verifiedClientCert returns the client certificate verified during the TLS
+// handshake, if any
+func verifiedClientCert(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	if chains := tlsInfo.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+		return chains[0][0]
+	}
+	return nil
+}
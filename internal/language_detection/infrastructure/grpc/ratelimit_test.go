@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/ratelimit"
+	pb "language-detection-service/pb-service/proto"
+)
+
+func TestClientIdentity(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4321}
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing"}}
+	tlsPeer := &peer.Peer{
+		Addr:     addr,
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{"Principal", domain.WithPrincipal(peer.NewContext(context.Background(), tlsPeer), domain.Principal{ID: "reports", Method: domain.AuthMethodAPIKey}), "principal:reports"},
+		{"Client certificate", peer.NewContext(context.Background(), tlsPeer), "cn:billing"},
+		{"Unverified API key", metadata.NewIncomingContext(peer.NewContext(context.Background(), &peer.Peer{Addr: addr}), metadata.Pairs(apiKeyHeader, "abc")), "ip:10.0.0.1"},
+		{"Peer address", peer.NewContext(context.Background(), &peer.Peer{Addr: addr}), "ip:10.0.0.1"},
+		{"No peer", context.Background(), "ip:unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clientIdentity(tt.ctx); got != tt.expected {
+				t.Errorf("Expected identity %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func rateLimitOptions(limit ratelimit.Limit, clients map[string]string) []grpc.ServerOption {
+	limiter := ratelimit.NewLimiter(map[string]ratelimit.Limit{ratelimit.DefaultClass: limit}, clients)
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryRateLimitInterceptor(limiter)),
+		grpc.ChainStreamInterceptor(StreamRateLimitInterceptor(limiter)),
+	}
+}
+
+func TestUnaryRateLimitInterceptor(t *testing.T) {
+	client := startBufconnServer(t, keywordService(), rateLimitOptions(ratelimit.Limit{Rate: 1, Burst: 1}, nil)...)
+	ctx := context.Background()
+
+	if _, err := client.DetectLanguage(ctx, &pb.DetectLanguageRequest{Text: "hello"}); err != nil {
+		t.Fatalf("Expected the first call to pass, got %v", err)
+	}
+
+	_, err := client.DetectLanguage(ctx, &pb.DetectLanguageRequest{Text: "hello"})
+	st, details := statusDetails(t, err)
+
+	if st.Code() != codes.ResourceExhausted {
+		t.Errorf("Expected code ResourceExhausted, got %v", st.Code())
+	}
+	if details.info == nil || details.info.Reason != "RATE_LIMITED" || details.info.Metadata["class"] != ratelimit.DefaultClass {
+		t.Errorf("Expected ErrorInfo RATE_LIMITED for the default class, got %v", details.info)
+	}
+	if details.retry == nil || details.retry.RetryDelay.AsDuration() <= 0 || details.retry.RetryDelay.AsDuration() > time.Second {
+		t.Errorf("Expected a retry delay of at most 1s, got %v", details.retry)
+	}
+
+	// Sending an unverified API key does not get the caller a new bucket
+	keyCtx := metadata.AppendToOutgoingContext(ctx, apiKeyHeader, "other")
+	if _, err := client.DetectLanguage(keyCtx, &pb.DetectLanguageRequest{Text: "hello"}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected code ResourceExhausted, got %v", err)
+	}
+}
+
+func TestRateLimitInterceptor_AfterAuthentication(t *testing.T) {
+	opts := append(authOptions(t), rateLimitOptions(ratelimit.Limit{Rate: 0.001, Burst: 1}, nil)...)
+	client := startBufconnServer(t, keywordService(), opts...)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), apiKeyHeader, "secret")
+
+	if _, err := client.DetectLanguage(ctx, &pb.DetectLanguageRequest{Text: "hello"}); err != nil {
+		t.Fatalf("Expected the first call to pass, got %v", err)
+	}
+	if _, err := client.DetectLanguage(ctx, &pb.DetectLanguageRequest{Text: "hello"}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected the principal's bucket to be empty, got %v", err)
+	}
+
+	// Made-up keys are rejected before they reach the limiter
+	for _, key := range []string{"random-1", "random-2"} {
+		keyCtx := metadata.AppendToOutgoingContext(context.Background(), apiKeyHeader, key)
+		if _, err := client.DetectLanguage(keyCtx, &pb.DetectLanguageRequest{Text: "hello"}); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Expected code Unauthenticated for key %s, got %v", key, err)
+		}
+	}
+}
+
+func TestStreamRateLimitInterceptor(t *testing.T) {
+	client := startBufconnServer(t, keywordService(), rateLimitOptions(ratelimit.Limit{Rate: 0.001, Burst: 2}, nil)...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.DetectLanguageStream(ctx)
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := stream.Send(&pb.StreamDetectLanguageRequest{SessionId: "s", Text: "hello"}); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+		if _, err := stream.Recv(); err != nil {
+			t.Fatalf("Expected fragment %d within the burst to pass, got %v", i, err)
+		}
+	}
+
+	if err := stream.Send(&pb.StreamDetectLanguageRequest{SessionId: "s", Text: "hello"}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	_, err = stream.Recv()
+	if st, _ := statusDetails(t, err); st.Code() != codes.ResourceExhausted {
+		t.Errorf("Expected code ResourceExhausted, got %v", st.Code())
+	}
+}
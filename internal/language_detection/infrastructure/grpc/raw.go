@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// DetectLanguageRaw implements the DetectLanguageRaw gRPC method
+func (s *Server) DetectLanguageRaw(
+	ctx context.Context,
+	req *pb.DetectLanguageRawRequest,
+) (*pb.DetectLanguageRawResponse, error) {
+	if s.raw == nil {
+		return nil, status.Error(codes.Unimplemented, "raw text detection is not configured")
+	}
+
+	resp, err := s.raw.DetectRawLanguage(ctx, &domain.RawTextDetectionRequest{
+		Content:    req.Content,
+		Charset:    req.Charset,
+		DocumentID: req.DocumentId,
+		Metadata:   req.Metadata,
+	})
+	if err != nil {
+		return nil, statusError(err, rawFields)
+	}
+
+	return &pb.DetectLanguageRawResponse{
+		Detection:              s.convertToProtobufResponse(&resp.LanguageDetectionResponse),
+		Charset:                resp.Charset,
+		CharsetConfidence:      float32(resp.CharsetConfidence),
+		CharsetMatchesLanguage: resp.CharsetMatchesLanguage,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// MockRawTextDetectionService is a mock implementation of RawTextDetectionService
+type MockRawTextDetectionService struct {
+	request  *domain.RawTextDetectionRequest
+	response *domain.RawTextDetectionResponse
+	err      error
+}
+
+func (m *MockRawTextDetectionService) DetectRawLanguage(ctx context.Context, request *domain.RawTextDetectionRequest) (*domain.RawTextDetectionResponse, error) {
+	m.request = request
+	return m.response, m.err
+}
+
+func TestServer_DetectLanguageRaw(t *testing.T) {
+	raw := &MockRawTextDetectionService{
+		response: &domain.RawTextDetectionResponse{
+			LanguageDetectionResponse: domain.LanguageDetectionResponse{
+				LanguageCode: "ru-RU",
+				Confidence:   0.9,
+				DocumentID:   "doc-1",
+				Metadata:     domain.ProcessingMetadata{Provider: "test"},
+			},
+			Charset:                "windows-1251",
+			CharsetConfidence:      0.8,
+			CharsetMatchesLanguage: true,
+		},
+	}
+	server := NewServer(&MockLanguageDetectionService{}, WithRawTextService(raw))
+
+	resp, err := server.DetectLanguageRaw(context.Background(), &pb.DetectLanguageRawRequest{
+		Content:    []byte{0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2},
+		Charset:    "windows-1251",
+		DocumentId: "doc-1",
+	})
+	if err != nil {
+		t.Fatalf("DetectLanguageRaw() error = %v, want nil", err)
+	}
+
+	if raw.request.Charset != "windows-1251" || len(raw.request.Content) != 6 {
+		t.Errorf("Expected the request to be passed through, got %+v", raw.request)
+	}
+
+	if resp.Detection.LanguageCode != "ru-RU" || resp.Detection.DocumentId != "doc-1" {
+		t.Errorf("Expected Russian detection for doc-1, got %+v", resp.Detection)
+	}
+
+	if resp.Charset != "windows-1251" || !resp.CharsetMatchesLanguage {
+		t.Errorf("Expected matching charset windows-1251, got %s (%v)", resp.Charset, resp.CharsetMatchesLanguage)
+	}
+
+	if resp.CharsetConfidence != 0.8 {
+		t.Errorf("Expected charset confidence 0.8, got %v", resp.CharsetConfidence)
+	}
+}
+
+func TestServer_DetectLanguageRaw_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{"Unknown charset", fmt.Errorf("%w: \"x-unknown\"", domain.ErrUnknownCharset), codes.InvalidArgument},
+		{"Text too long", domain.ErrTextTooLong, codes.InvalidArgument},
+		{"Low confidence", domain.ErrLowConfidence, codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(&MockLanguageDetectionService{}, WithRawTextService(&MockRawTextDetectionService{err: tt.err}))
+
+			_, err := server.DetectLanguageRaw(context.Background(), &pb.DetectLanguageRawRequest{Content: []byte("x")})
+			if status.Code(err) != tt.expected {
+				t.Errorf("Expected code %v, got %v", tt.expected, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestServer_DetectLanguageRaw_NotConfigured(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{})
+
+	_, err := server.DetectLanguageRaw(context.Background(), &pb.DetectLanguageRawRequest{Content: []byte("x")})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected code Unimplemented, got %v", status.Code(err))
+	}
+}
@@ -3,7 +3,7 @@ package grpc
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"time"
 
@@ -12,14 +12,20 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
-	pb "github.com/Hovhannesmn/ld_proto/pb"
 	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
 )
 
 // Server represents the gRPC server for language detection
 type Server struct {
 	pb.UnimplementedLanguageDetectionServiceServer
 	service         domain.LanguageDetectionService
+	catalog         domain.LanguageCatalogService
+	jobs            domain.JobService
+	documents       domain.DocumentDetectionService
+	subtitles       domain.SubtitleDetectionService
+	raw             domain.RawTextDetectionService
+	usage           domain.UsageService
 	healthServer    *health.Server
 	server          *grpc.Server
 	shutdownTimeout time.Duration
@@ -27,6 +33,8 @@ type Server struct {
 
 // NewServer creates a new gRPC server
 func NewServer(service domain.LanguageDetectionService, opts ...grpc.ServerOption) *Server {
+	serverOpts, opts := splitServerOptions(opts)
+
 	// Add default options
 	opts = append(opts,
 		grpc.ConnectionTimeout(30*time.Second),
@@ -37,13 +45,18 @@ func NewServer(service domain.LanguageDetectionService, opts ...grpc.ServerOptio
 	server := grpc.NewServer(opts...)
 	healthServer := health.NewServer()
 
-	// Register services
-	pb.RegisterLanguageDetectionServiceServer(server, &Server{
+	s := &Server{
 		service:         service,
 		healthServer:    healthServer,
 		server:          server,
 		shutdownTimeout: 30 * time.Second,
-	})
+	}
+	for _, opt := range serverOpts {
+		opt.apply(s)
+	}
+
+	// Register services
+	pb.RegisterLanguageDetectionServiceServer(server, s)
 
 	// Register health service
 	grpc_health_v1.RegisterHealthServer(server, healthServer)
@@ -54,12 +67,7 @@ func NewServer(service domain.LanguageDetectionService, opts ...grpc.ServerOptio
 	// Set health status
 	healthServer.SetServingStatus("language_detection.LanguageDetectionService", grpc_health_v1.HealthCheckResponse_SERVING)
 
-	return &Server{
-		service:         service,
-		healthServer:    healthServer,
-		server:          server,
-		shutdownTimeout: 30 * time.Second,
-	}
+	return s
 }
 
 // StartWithContext starts the gRPC server with context support
@@ -69,7 +77,7 @@ func (s *Server) StartWithContext(ctx context.Context, address string) error {
 		return fmt.Errorf("failed to listen on %s: %w", address, err)
 	}
 
-	log.Printf("Starting gRPC Language Detection Service on %s", address)
+	slog.Info("Starting gRPC Language Detection Service", "address", address)
 
 	// Set health status to serving
 	s.healthServer.SetServingStatus("language_detection.LanguageDetectionService", grpc_health_v1.HealthCheckResponse_SERVING)
@@ -83,7 +91,7 @@ func (s *Server) StartWithContext(ctx context.Context, address string) error {
 	// Wait for context cancellation or server error
 	select {
 	case <-ctx.Done():
-		log.Println("Context cancelled, stopping gRPC server...")
+		slog.Info("Context cancelled, stopping gRPC server")
 		s.server.Stop()
 		return ctx.Err()
 	case err := <-serverErr:
@@ -93,7 +101,7 @@ func (s *Server) StartWithContext(ctx context.Context, address string) error {
 
 // Stop gracefully stops the gRPC server
 func (s *Server) Stop() error {
-	log.Println("Shutting down gRPC Language Detection Service...")
+	slog.Info("Shutting down gRPC Language Detection Service")
 
 	// Set health status to not serving
 	s.healthServer.SetServingStatus("language_detection.LanguageDetectionService", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
@@ -108,10 +116,10 @@ func (s *Server) Stop() error {
 	// Wait for graceful shutdown or timeout
 	select {
 	case <-stopped:
-		log.Println("gRPC server stopped gracefully")
+		slog.Info("gRPC server stopped gracefully")
 		return nil
 	case <-time.After(s.shutdownTimeout):
-		log.Println("gRPC server shutdown timeout, forcing stop")
+		slog.Warn("gRPC server shutdown timeout, forcing stop")
 		s.server.Stop()
 		return fmt.Errorf("server shutdown timeout")
 	}
@@ -123,42 +131,81 @@ func (s *Server) DetectLanguage(
 	req *pb.DetectLanguageRequest,
 ) (*pb.DetectLanguageResponse, error) {
 	// Convert protobuf request to domain request
-	domainReq := &domain.LanguageDetectionRequest{
-		Text:       domain.Text(req.Text),
-		DocumentID: req.DocumentId,
-		Metadata:   req.Metadata,
-	}
+	domainReq := convertFromProtobufRequest(req)
 
 	// Call the application service
-	domainResp, err := s.service.DetectLanguage(ctx, domainReq)
+	domainResp, err := s.service.DetectLanguage(ctx, &domainReq)
 	if err != nil {
-		return nil, fmt.Errorf("language detection failed: %w", err)
+		return nil, statusError(err, textFields)
 	}
 
 	// Convert domain response to protobuf response
 	return s.convertToProtobufResponse(domainResp), nil
 }
 
-// convertToProtobufResponse converts domain response to protobuf response
-func (s *Server) convertToProtobufResponse(resp *domain.LanguageDetectionResponse) *pb.DetectLanguageResponse {
-	var alternatives []*pb.LanguageAlternative
-	for _, alt := range resp.Alternatives {
-		alternatives = append(alternatives, &pb.LanguageAlternative{
-			LanguageCode: string(alt.LanguageCode),
-			Confidence:   float32(alt.Confidence),
-		})
+// convertFromProtobufRequest converts a protobuf request to a domain request
+func convertFromProtobufRequest(req *pb.DetectLanguageRequest) domain.LanguageDetectionRequest {
+	domainReq := domain.LanguageDetectionRequest{
+		Text:       domain.Text(req.Text),
+		DocumentID: req.DocumentId,
+		Metadata:   req.Metadata,
+		Explain:    req.Explain,
 	}
+	if opts := req.Options; opts != nil {
+		domainReq.Options = &domain.DetectionOptions{
+			MaxAlternatives:     int(opts.MaxAlternatives),
+			MinAlternativeScore: domain.Confidence(opts.MinAlternativeScore),
+			MinConfidence:       domain.Confidence(opts.MinConfidence),
+			Provider:            opts.Provider,
+			TimeoutMs:           opts.TimeoutMs,
+		}
+	}
+	return domainReq
+}
 
+// convertToProtobufResponse converts domain response to protobuf response
+func (s *Server) convertToProtobufResponse(resp *domain.LanguageDetectionResponse) *pb.DetectLanguageResponse {
 	return &pb.DetectLanguageResponse{
 		LanguageCode: string(resp.LanguageCode),
 		Confidence:   float32(resp.Confidence),
-		Alternatives: alternatives,
+		Alternatives: convertToProtobufAlternatives(resp.Alternatives),
 		DocumentId:   resp.DocumentID,
 		Metadata: &pb.ProcessingMetadata{
 			ProcessingTimeMs: resp.Metadata.ProcessingTimeMs,
 			ServiceVersion:   resp.Metadata.ServiceVersion,
 			ModelVersion:     resp.Metadata.ModelVersion,
 			Provider:         resp.Metadata.Provider,
+			Details:          resp.Metadata.Details,
+			Cached:           resp.Metadata.Cached,
 		},
+		Explanation: convertToProtobufExplanation(resp.Explanation),
+	}
+}
+
+// convertToProtobufExplanation converts a domain explanation to its protobuf form
+func convertToProtobufExplanation(explanation *domain.Explanation) *pb.Explanation {
+	if explanation == nil {
+		return nil
+	}
+
+	pbExplanation := &pb.Explanation{
+		Preprocessing: explanation.Preprocessing,
+		RawOutput:     explanation.RawOutput,
+	}
+	for _, candidate := range explanation.Candidates {
+		pbCandidate := &pb.CandidateEvidence{
+			LanguageCode: string(candidate.LanguageCode),
+			Score:        candidate.Score,
+		}
+		for _, feature := range candidate.Features {
+			pbCandidate.Features = append(pbCandidate.Features, &pb.FeatureContribution{
+				Feature:      feature.Feature,
+				Kind:         feature.Kind,
+				Count:        int32(feature.Count),
+				Contribution: feature.Contribution,
+			})
+		}
+		pbExplanation.Candidates = append(pbExplanation.Candidates, pbCandidate)
 	}
+	return pbExplanation
 }
@@ -9,8 +9,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
-	pb "github.com/Hovhannesmn/ld_proto/pb"
 	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
 )
 
 // MockLanguageDetectionService is a mock implementation of LanguageDetectionService
@@ -87,6 +87,7 @@ func TestServer_DetectLanguage_Success(t *testing.T) {
 			Details: map[string]string{
 				"test": "value",
 			},
+			Cached: true,
 		},
 	}
 
@@ -157,6 +158,10 @@ func TestServer_DetectLanguage_Success(t *testing.T) {
 	if resp.Metadata.Provider != "test" {
 		t.Errorf("Expected provider 'test', got %s", resp.Metadata.Provider)
 	}
+
+	if !resp.Metadata.Cached {
+		t.Error("Expected the response to be marked as cached")
+	}
 }
 
 func TestServer_DetectLanguage_ServiceError(t *testing.T) {
@@ -287,6 +292,59 @@ func TestServer_ConvertToProtobufResponse(t *testing.T) {
 	}
 }
 
+func TestServer_ConvertToProtobufResponse_Explanation(t *testing.T) {
+	server := &Server{}
+
+	domainResp := &domain.LanguageDetectionResponse{
+		LanguageCode: "en-US",
+		Confidence:   0.5,
+		Metadata: domain.ProcessingMetadata{
+			Provider: "fallback",
+			Details:  map[string]string{"total_words": "4"},
+		},
+		Explanation: &domain.Explanation{
+			Preprocessing: []string{"lowercased"},
+			Candidates: []domain.CandidateEvidence{{
+				LanguageCode: "en-US",
+				Score:        0.5,
+				Features: []domain.FeatureContribution{
+					{Feature: "the", Kind: domain.FeatureKindWord, Count: 2, Contribution: 0.5},
+				},
+			}},
+			RawOutput: `{"scores":{"en-US":0.5}}`,
+		},
+	}
+
+	pbResp := server.convertToProtobufResponse(domainResp)
+
+	if pbResp.Metadata.Details["total_words"] != "4" {
+		t.Errorf("Expected metadata details to be kept, got %v", pbResp.Metadata.Details)
+	}
+
+	explanation := pbResp.Explanation
+	if explanation == nil {
+		t.Fatal("Expected explanation, got nil")
+	}
+
+	if len(explanation.Preprocessing) != 1 || explanation.RawOutput == "" {
+		t.Errorf("Expected preprocessing and raw output, got %+v", explanation)
+	}
+
+	if len(explanation.Candidates) != 1 || len(explanation.Candidates[0].Features) != 1 {
+		t.Fatalf("Expected one candidate with one feature, got %+v", explanation.Candidates)
+	}
+
+	feature := explanation.Candidates[0].Features[0]
+	if feature.Feature != "the" || feature.Kind != "word" || feature.Count != 2 || feature.Contribution != 0.5 {
+		t.Errorf("Unexpected feature %+v", feature)
+	}
+
+	domainResp.Explanation = nil
+	if server.convertToProtobufResponse(domainResp).Explanation != nil {
+		t.Error("Expected no explanation when none was requested")
+	}
+}
+
 func TestServer_ConvertToProtobufResponse_NoAlternatives(t *testing.T) {
 	server := &Server{}
 
@@ -499,3 +557,36 @@ func TestServer_Integration(t *testing.T) {
 		t.Errorf("Integration test: expected provider 'aws-comprehend', got %s", resp.Metadata.Provider)
 	}
 }
+
+func TestConvertFromProtobufRequest(t *testing.T) {
+	req := convertFromProtobufRequest(&pb.DetectLanguageRequest{
+		Text:    "Hola",
+		Explain: true,
+		Options: &pb.DetectionOptions{
+			MaxAlternatives:     3,
+			MinAlternativeScore: 0.2,
+			MinConfidence:       0.5,
+			Provider:            "fallback",
+			TimeoutMs:           250,
+		},
+	})
+
+	if req.Text != "Hola" || !req.Explain {
+		t.Errorf("Expected text and explain flag to be kept, got %+v", req)
+	}
+
+	expected := domain.DetectionOptions{
+		MaxAlternatives:     3,
+		MinAlternativeScore: 0.2,
+		MinConfidence:       0.5,
+		Provider:            "fallback",
+		TimeoutMs:           250,
+	}
+	if req.Options == nil || *req.Options != expected {
+		t.Errorf("Expected options %+v, got %+v", expected, req.Options)
+	}
+
+	if convertFromProtobufRequest(&pb.DetectLanguageRequest{Text: "Hola"}).Options != nil {
+		t.Error("Expected no options when none were sent")
+	}
+}
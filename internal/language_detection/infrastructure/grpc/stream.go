@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// maxStreamSessions limits the number of sessions a single stream may keep open
+const maxStreamSessions = 1024
+
+// DetectLanguageStream implements the bidirectional DetectLanguageStream gRPC method.
+// Fragments are handled in arrival order and every Send blocks until the client
+// has room for it, so a slow reader throttles its own writer through HTTP/2 flow control.
+func (s *Server) DetectLanguageStream(stream pb.LanguageDetectionService_DetectLanguageStreamServer) error {
+	ctx := stream.Context()
+	sessions := domain.NewSessionTracker(maxStreamSessions)
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.handleStreamFragment(ctx, sessions, req)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// handleStreamFragment detects a single fragment and updates its session.
+// Fragment failures are reported in-band; only cancellation ends the stream.
+func (s *Server) handleStreamFragment(
+	ctx context.Context,
+	sessions *domain.SessionTracker,
+	req *pb.StreamDetectLanguageRequest,
+) (*pb.StreamDetectLanguageResponse, error) {
+	resp := &pb.StreamDetectLanguageResponse{
+		SessionId:  req.SessionId,
+		FragmentId: req.FragmentId,
+	}
+
+	if req.Text == "" && req.EndOfSession {
+		// A bare end marker just returns the final estimate
+		if estimate, ok := sessions.Estimate(req.SessionId); ok {
+			resp.SessionEstimate = convertToProtobufEstimate(estimate)
+		}
+		sessions.Close(req.SessionId)
+		return resp, nil
+	}
+
+	domainResp, err := s.service.DetectLanguage(ctx, &domain.LanguageDetectionRequest{
+		Text:       domain.Text(req.Text),
+		DocumentID: req.FragmentId,
+		Metadata:   req.Metadata,
+	})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		resp.Error = convertToProtobufStreamError(err)
+		// Failed fragments add no evidence but still report the estimate so far
+		if estimate, ok := sessions.Estimate(req.SessionId); ok && (req.IncludeSessionEstimate || req.EndOfSession) {
+			resp.SessionEstimate = convertToProtobufEstimate(estimate)
+		}
+	} else {
+		resp.Detection = s.convertToProtobufResponse(domainResp)
+
+		estimate, err := sessions.Observe(req.SessionId, domain.Text(req.Text), domainResp)
+		if err != nil {
+			resp.Error = convertToProtobufStreamError(err)
+		} else if req.IncludeSessionEstimate || req.EndOfSession {
+			resp.SessionEstimate = convertToProtobufEstimate(estimate)
+		}
+	}
+
+	if req.EndOfSession {
+		sessions.Close(req.SessionId)
+	}
+
+	return resp, nil
+}
+
+// convertToProtobufStreamError converts a fragment failure to the in-band error,
+// classified the same way as the status of a unary call
+func convertToProtobufStreamError(err error) *pb.StreamError {
+	m := classifyError(err)
+	return &pb.StreamError{
+		Code:    int32(m.code),
+		Message: err.Error(),
+		Reason:  m.reason,
+	}
+}
+
+// convertToProtobufEstimate converts a domain session estimate to its protobuf form
+func convertToProtobufEstimate(estimate *domain.SessionEstimate) *pb.SessionEstimate {
+	return &pb.SessionEstimate{
+		LanguageCode:   string(estimate.LanguageCode),
+		Confidence:     float32(estimate.Confidence),
+		Alternatives:   convertToProtobufAlternatives(estimate.Alternatives),
+		FragmentCount:  int32(estimate.FragmentCount),
+		CharacterCount: estimate.CharacterCount,
+	}
+}
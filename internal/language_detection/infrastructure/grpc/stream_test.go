@@ -0,0 +1,279 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// FuncLanguageDetectionService adapts a function to the LanguageDetectionService interface
+type FuncLanguageDetectionService func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error)
+
+func (f FuncLanguageDetectionService) DetectLanguage(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+	return f(ctx, request)
+}
+
+// keywordService detects French for texts containing "bonjour" and English otherwise
+func keywordService() FuncLanguageDetectionService {
+	return func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		if request.Text == "" {
+			return nil, domain.ErrEmptyText
+		}
+		lang := domain.LanguageCode("en-US")
+		if strings.Contains(strings.ToLower(string(request.Text)), "bonjour") {
+			lang = "fr-FR"
+		}
+		return &domain.LanguageDetectionResponse{
+			LanguageCode: lang,
+			Confidence:   0.9,
+			DocumentID:   request.DocumentID,
+			Metadata:     domain.ProcessingMetadata{Provider: "test"},
+		}, nil
+	}
+}
+
+// startBufconnServer serves the given service over an in-memory listener
+func startBufconnServer(t *testing.T, service domain.LanguageDetectionService, opts ...grpc.ServerOption) pb.LanguageDetectionServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := NewServer(service, opts...)
+	go server.server.Serve(lis)
+	t.Cleanup(server.server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewLanguageDetectionServiceClient(conn)
+}
+
+func TestServer_DetectLanguageStream_Detections(t *testing.T) {
+	client := startBufconnServer(t, keywordService())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.DetectLanguageStream(ctx)
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+
+	fragments := []*pb.StreamDetectLanguageRequest{
+		{SessionId: "chat-1", FragmentId: "f1", Text: "Hello everyone", IncludeSessionEstimate: true},
+		{SessionId: "chat-1", FragmentId: "f2", Text: "Good morning to all of you", IncludeSessionEstimate: true},
+		{SessionId: "chat-1", FragmentId: "f3", Text: "bonjour", IncludeSessionEstimate: true},
+	}
+
+	var lastConfidence float32
+	for i, fragment := range fragments {
+		if err := stream.Send(fragment); err != nil {
+			t.Fatalf("Send() error = %v, want nil", err)
+		}
+
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v, want nil", err)
+		}
+
+		if resp.FragmentId != fragment.FragmentId {
+			t.Errorf("Expected fragment ID %s, got %s", fragment.FragmentId, resp.FragmentId)
+		}
+
+		if resp.SessionId != "chat-1" {
+			t.Errorf("Expected session ID 'chat-1', got %s", resp.SessionId)
+		}
+
+		if resp.Detection == nil {
+			t.Fatalf("Expected detection for fragment %s, got nil", fragment.FragmentId)
+		}
+
+		if resp.Detection.DocumentId != fragment.FragmentId {
+			t.Errorf("Expected document ID %s, got %s", fragment.FragmentId, resp.Detection.DocumentId)
+		}
+
+		if resp.SessionEstimate == nil {
+			t.Fatalf("Expected session estimate for fragment %s, got nil", fragment.FragmentId)
+		}
+
+		if resp.SessionEstimate.FragmentCount != int32(i+1) {
+			t.Errorf("Expected fragment count %d, got %d", i+1, resp.SessionEstimate.FragmentCount)
+		}
+
+		// The estimate should stay English despite the short French fragment
+		if resp.SessionEstimate.LanguageCode != "en-US" {
+			t.Errorf("Expected session language 'en-US', got %s", resp.SessionEstimate.LanguageCode)
+		}
+
+		if i < 2 && resp.SessionEstimate.Confidence <= lastConfidence {
+			t.Errorf("Expected estimate to firm up, got %.3f after %.3f", resp.SessionEstimate.Confidence, lastConfidence)
+		}
+		lastConfidence = resp.SessionEstimate.Confidence
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v, want nil", err)
+	}
+
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("Expected io.EOF after CloseSend, got %v", err)
+	}
+}
+
+func TestServer_DetectLanguageStream_FragmentErrorKeepsStreamOpen(t *testing.T) {
+	client := startBufconnServer(t, keywordService())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.DetectLanguageStream(ctx)
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+
+	// An empty fragment is rejected in-band
+	if err := stream.Send(&pb.StreamDetectLanguageRequest{SessionId: "s", FragmentId: "empty"}); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v, want nil", err)
+	}
+
+	if resp.Error == nil {
+		t.Fatal("Expected in-band error, got nil")
+	}
+
+	if codes.Code(resp.Error.Code) != codes.InvalidArgument {
+		t.Errorf("Expected code InvalidArgument, got %v", codes.Code(resp.Error.Code))
+	}
+
+	if resp.Detection != nil {
+		t.Errorf("Expected no detection for failed fragment, got %v", resp.Detection)
+	}
+
+	// The stream keeps working after the failure
+	if err := stream.Send(&pb.StreamDetectLanguageRequest{SessionId: "s", FragmentId: "ok", Text: "Hello world"}); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+
+	resp, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v, want nil", err)
+	}
+
+	if resp.Error != nil {
+		t.Errorf("Expected no error, got %v", resp.Error)
+	}
+
+	if resp.Detection == nil || resp.Detection.LanguageCode != "en-US" {
+		t.Errorf("Expected detection 'en-US', got %v", resp.Detection)
+	}
+}
+
+func TestServer_DetectLanguageStream_EndOfSession(t *testing.T) {
+	client := startBufconnServer(t, keywordService())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.DetectLanguageStream(ctx)
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+
+	requests := []*pb.StreamDetectLanguageRequest{
+		{SessionId: "doc", FragmentId: "1", Text: "bonjour mes amis"},
+		{SessionId: "doc", FragmentId: "2", EndOfSession: true},
+		{SessionId: "doc", FragmentId: "3", EndOfSession: true},
+	}
+
+	var responses []*pb.StreamDetectLanguageResponse
+	for _, req := range requests {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("Send() error = %v, want nil", err)
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v, want nil", err)
+		}
+		responses = append(responses, resp)
+	}
+
+	if responses[0].SessionEstimate != nil {
+		t.Errorf("Expected no estimate unless requested, got %v", responses[0].SessionEstimate)
+	}
+
+	final := responses[1].SessionEstimate
+	if final == nil {
+		t.Fatal("Expected final session estimate, got nil")
+	}
+
+	if final.LanguageCode != "fr-FR" {
+		t.Errorf("Expected final language 'fr-FR', got %s", final.LanguageCode)
+	}
+
+	if responses[1].Detection != nil {
+		t.Errorf("Expected no detection for a bare end marker, got %v", responses[1].Detection)
+	}
+
+	// The session was released, so a second end marker has nothing to report
+	if responses[2].SessionEstimate != nil {
+		t.Errorf("Expected no estimate for a closed session, got %v", responses[2].SessionEstimate)
+	}
+}
+
+func TestServer_DetectLanguageStream_Cancellation(t *testing.T) {
+	started := make(chan struct{})
+	blocking := FuncLanguageDetectionService(func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	client := startBufconnServer(t, blocking)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream, err := client.DetectLanguageStream(ctx)
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+
+	if err := stream.Send(&pb.StreamDetectLanguageRequest{SessionId: "s", Text: "Hello"}); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Detection was never started")
+	}
+
+	cancel()
+
+	_, err = stream.Recv()
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("Expected code Canceled, got %v", err)
+	}
+}
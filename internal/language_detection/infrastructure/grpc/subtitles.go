@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// DetectSubtitleLanguage implements the DetectSubtitleLanguage gRPC method
+func (s *Server) DetectSubtitleLanguage(
+	ctx context.Context,
+	req *pb.DetectSubtitleLanguageRequest,
+) (*pb.DetectSubtitleLanguageResponse, error) {
+	if s.subtitles == nil {
+		return nil, status.Error(codes.Unimplemented, "subtitle detection is not configured")
+	}
+
+	resp, err := s.subtitles.DetectSubtitleLanguage(ctx, &domain.SubtitleDetectionRequest{
+		Content:          req.Content,
+		Format:           domain.SubtitleFormat(req.Format),
+		TrackID:          req.TrackId,
+		DeclaredLanguage: domain.LanguageCode(req.DeclaredLanguage),
+		Metadata:         req.Metadata,
+	})
+	if err != nil {
+		return nil, statusError(err, subtitleFields)
+	}
+
+	pbResp := &pb.DetectSubtitleLanguageResponse{
+		LanguageCode:     string(resp.LanguageCode),
+		Confidence:       float32(resp.Confidence),
+		Alternatives:     convertToProtobufAlternatives(resp.Alternatives),
+		TrackId:          resp.TrackID,
+		Format:           string(resp.Format),
+		CueCount:         int32(resp.CueCount),
+		DetectedCueCount: int32(resp.DetectedCueCount),
+		DeclaredLanguage: string(resp.DeclaredLanguage),
+		DeclaredMatches:  resp.DeclaredMatches,
+		Metadata: &pb.ProcessingMetadata{
+			ProcessingTimeMs: resp.Metadata.ProcessingTimeMs,
+			ServiceVersion:   resp.Metadata.ServiceVersion,
+			ModelVersion:     resp.Metadata.ModelVersion,
+			Provider:         resp.Metadata.Provider,
+		},
+	}
+	for _, cue := range resp.DisagreeingCues {
+		pbResp.DisagreeingCues = append(pbResp.DisagreeingCues, &pb.CueDetection{
+			Index:                int32(cue.Index),
+			StartMs:              cue.Start.Milliseconds(),
+			EndMs:                cue.End.Milliseconds(),
+			Text:                 string(cue.Text),
+			LanguageCode:         string(cue.LanguageCode),
+			Confidence:           float32(cue.Confidence),
+			SmoothedLanguageCode: string(cue.SmoothedLanguageCode),
+			SmoothedConfidence:   float32(cue.SmoothedConfidence),
+		})
+	}
+
+	return pbResp, nil
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// MockSubtitleDetectionService is a mock implementation of SubtitleDetectionService
+type MockSubtitleDetectionService struct {
+	request  *domain.SubtitleDetectionRequest
+	response *domain.SubtitleDetectionResponse
+	err      error
+}
+
+func (m *MockSubtitleDetectionService) DetectSubtitleLanguage(ctx context.Context, request *domain.SubtitleDetectionRequest) (*domain.SubtitleDetectionResponse, error) {
+	m.request = request
+	return m.response, m.err
+}
+
+func TestServer_DetectSubtitleLanguage(t *testing.T) {
+	subtitles := &MockSubtitleDetectionService{
+		response: &domain.SubtitleDetectionResponse{
+			TrackID:          "track-1",
+			Format:           domain.SubtitleFormatWebVTT,
+			LanguageCode:     "en-US",
+			Confidence:       0.9,
+			CueCount:         10,
+			DetectedCueCount: 9,
+			DeclaredLanguage: "fr",
+			DisagreeingCues: []domain.CueDetection{{
+				Index:                4,
+				Start:                90 * time.Second,
+				End:                  92500 * time.Millisecond,
+				Text:                 "Bonjour à tous",
+				LanguageCode:         "fr-FR",
+				Confidence:           0.7,
+				SmoothedLanguageCode: "fr-FR",
+				SmoothedConfidence:   0.6,
+			}},
+		},
+	}
+	server := NewServer(&MockLanguageDetectionService{}, WithSubtitleService(subtitles))
+
+	resp, err := server.DetectSubtitleLanguage(context.Background(), &pb.DetectSubtitleLanguageRequest{
+		Content:          []byte("WEBVTT"),
+		Format:           "vtt",
+		TrackId:          "track-1",
+		DeclaredLanguage: "fr",
+	})
+	if err != nil {
+		t.Fatalf("DetectSubtitleLanguage() error = %v, want nil", err)
+	}
+
+	if subtitles.request.Format != domain.SubtitleFormatWebVTT || subtitles.request.DeclaredLanguage != "fr" {
+		t.Errorf("Unexpected domain request: %+v", subtitles.request)
+	}
+
+	if resp.LanguageCode != "en-US" || resp.CueCount != 10 || resp.DetectedCueCount != 9 || resp.DeclaredMatches {
+		t.Errorf("Unexpected response: %+v", resp)
+	}
+
+	if len(resp.DisagreeingCues) != 1 {
+		t.Fatalf("Expected 1 disagreeing cue, got %d", len(resp.DisagreeingCues))
+	}
+
+	cue := resp.DisagreeingCues[0]
+	if cue.StartMs != 90000 || cue.EndMs != 92500 || cue.SmoothedLanguageCode != "fr-FR" {
+		t.Errorf("Unexpected cue: %+v", cue)
+	}
+}
+
+func TestServer_DetectSubtitleLanguage_Errors(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{})
+	_, err := server.DetectSubtitleLanguage(context.Background(), &pb.DetectSubtitleLanguageRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented, got %v", err)
+	}
+
+	server = NewServer(&MockLanguageDetectionService{},
+		WithSubtitleService(&MockSubtitleDetectionService{err: domain.ErrMalformedDocument}))
+	_, err = server.DetectSubtitleLanguage(context.Background(), &pb.DetectSubtitleLanguageRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+}
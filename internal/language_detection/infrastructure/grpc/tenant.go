@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// tenantHeader is the metadata key naming the tenant a call is made for
+const tenantHeader = "x-tenant-id"
+
+// UnaryTenantInterceptor attaches the tenant named in the call metadata to the context
+func UnaryTenantInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withTenant(ctx), req)
+	}
+}
+
+// StreamTenantInterceptor attaches the tenant named in the call metadata to the stream context
+func StreamTenantInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withTenant(ss.Context())})
+	}
+}
+
+// contextStream replaces the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// withTenant returns ctx carrying the tenant from its metadata, if any
+func withTenant(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if tenants := md.Get(tenantHeader); len(tenants) > 0 && tenants[0] != "" {
+		return domain.WithTenant(ctx, tenants[0])
+	}
+	return ctx
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// tenantEchoService reports the tenant of each request as its provider
+func tenantEchoService() FuncLanguageDetectionService {
+	return func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		return &domain.LanguageDetectionResponse{
+			LanguageCode: "en-US",
+			Confidence:   0.9,
+			Metadata:     domain.ProcessingMetadata{Provider: domain.TenantFromContext(ctx)},
+		}, nil
+	}
+}
+
+func tenantOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryTenantInterceptor()),
+		grpc.ChainStreamInterceptor(StreamTenantInterceptor()),
+	}
+}
+
+func TestUnaryTenantInterceptor(t *testing.T) {
+	client := startBufconnServer(t, tenantEchoService(), tenantOptions()...)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{"Tenant header", metadata.AppendToOutgoingContext(context.Background(), tenantHeader, "acme"), "acme"},
+		{"No header", context.Background(), domain.DefaultTenant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.DetectLanguage(tt.ctx, &pb.DetectLanguageRequest{Text: "hello"})
+			if err != nil {
+				t.Fatalf("DetectLanguage() error = %v, want nil", err)
+			}
+			if resp.Metadata.Provider != tt.expected {
+				t.Errorf("Expected tenant %s, got %s", tt.expected, resp.Metadata.Provider)
+			}
+		})
+	}
+}
+
+func TestStreamTenantInterceptor(t *testing.T) {
+	client := startBufconnServer(t, tenantEchoService(), tenantOptions()...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.DetectLanguageStream(metadata.AppendToOutgoingContext(ctx, tenantHeader, "acme"))
+	if err != nil {
+		t.Fatalf("DetectLanguageStream() error = %v, want nil", err)
+	}
+	if err := stream.Send(&pb.StreamDetectLanguageRequest{SessionId: "s1", FragmentId: "f1", Text: "hello"}); err != nil {
+		t.Fatalf("Send() error = %v, want nil", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v, want nil", err)
+	}
+	if detection := resp.GetDetection(); detection == nil || detection.Metadata.Provider != "acme" {
+		t.Errorf("Expected a detection for tenant acme, got %v", resp)
+	}
+}
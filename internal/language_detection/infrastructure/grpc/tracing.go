@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// TracingOption creates a server span for every call. The span continues the
+// trace context the caller sent in its metadata, so the detection spans join
+// the caller's trace.
+func TracingOption(provider trace.TracerProvider, propagator propagation.TextMapPropagator) grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(
+		otelgrpc.WithTracerProvider(provider),
+		otelgrpc.WithPropagators(propagator),
+	))
+}
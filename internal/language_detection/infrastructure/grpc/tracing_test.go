@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+func TestTracingOption(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	var traceID trace.TraceID
+	service := FuncLanguageDetectionService(func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+		traceID = trace.SpanContextFromContext(ctx).TraceID()
+		return &domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.9}, nil
+	})
+	client := startBufconnServer(t, service, TracingOption(provider, propagation.TraceContext{}))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	if _, err := client.DetectLanguage(ctx, &pb.DetectLanguageRequest{Text: "hello"}); err != nil {
+		t.Fatalf("DetectLanguage() error = %v, want nil", err)
+	}
+
+	if traceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected the caller's trace ID, got %s", traceID)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 server span, got %d", len(spans))
+	}
+	if spans[0].Parent().SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("Expected the caller's span as parent, got %s", spans[0].Parent().SpanID())
+	}
+}
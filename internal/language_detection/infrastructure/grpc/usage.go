@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "language-detection-service/pb-service/proto"
+)
+
+// GetUsageReport implements the GetUsageReport gRPC method
+func (s *Server) GetUsageReport(
+	ctx context.Context,
+	req *pb.GetUsageReportRequest,
+) (*pb.GetUsageReportResponse, error) {
+	if s.usage == nil {
+		return nil, status.Error(codes.Unimplemented, "usage accounting is not configured")
+	}
+
+	report, err := s.usage.GetUsageReport(ctx, req.Period, req.TenantId)
+	if err != nil {
+		return nil, statusError(err, usageFields)
+	}
+
+	resp := &pb.GetUsageReportResponse{}
+	for _, entry := range report {
+		resp.Usage = append(resp.Usage, &pb.TenantUsage{
+			TenantId:     entry.TenantID,
+			Period:       entry.Period,
+			Documents:    entry.Documents,
+			Characters:   entry.Characters,
+			BillingUnits: entry.BillingUnits,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"language-detection-service/internal/language_detection/domain"
+	pb "language-detection-service/pb-service/proto"
+)
+
+// MockUsageService is a mock implementation of UsageService
+type MockUsageService struct {
+	report []domain.TenantUsage
+	err    error
+
+	period   string
+	tenantID string
+}
+
+func (m *MockUsageService) CheckQuota(ctx context.Context, tenantID string, usage domain.Usage) error {
+	return nil
+}
+
+func (m *MockUsageService) RecordUsage(ctx context.Context, tenantID string, usage domain.Usage) error {
+	return nil
+}
+
+func (m *MockUsageService) GetUsageReport(ctx context.Context, period, tenantID string) ([]domain.TenantUsage, error) {
+	m.period = period
+	m.tenantID = tenantID
+	return m.report, m.err
+}
+
+func TestServer_GetUsageReport(t *testing.T) {
+	usage := &MockUsageService{report: []domain.TenantUsage{
+		{TenantID: "acme", Period: "2026-04", Usage: domain.Usage{Documents: 2, Characters: 450, BillingUnits: 8}},
+		{TenantID: "globex", Period: "2026-04", Usage: domain.Usage{Documents: 1, Characters: 20, BillingUnits: 3}},
+	}}
+	server := NewServer(&MockLanguageDetectionService{}, WithUsageService(usage))
+
+	resp, err := server.GetUsageReport(context.Background(), &pb.GetUsageReportRequest{Period: "2026-04", TenantId: "acme"})
+	if err != nil {
+		t.Fatalf("GetUsageReport() error = %v, want nil", err)
+	}
+
+	if usage.period != "2026-04" || usage.tenantID != "acme" {
+		t.Errorf("Expected the request period and tenant to be passed through, got %q and %q", usage.period, usage.tenantID)
+	}
+	if len(resp.Usage) != 2 {
+		t.Fatalf("Expected 2 usage entries, got %d", len(resp.Usage))
+	}
+
+	acme := resp.Usage[0]
+	if acme.TenantId != "acme" || acme.Period != "2026-04" {
+		t.Errorf("Expected acme for 2026-04, got %s for %s", acme.TenantId, acme.Period)
+	}
+	if acme.Documents != 2 || acme.Characters != 450 || acme.BillingUnits != 8 {
+		t.Errorf("Expected 2 documents, 450 characters and 8 billing units, got %d, %d and %d",
+			acme.Documents, acme.Characters, acme.BillingUnits)
+	}
+}
+
+func TestServer_GetUsageReport_InvalidPeriod(t *testing.T) {
+	usage := &MockUsageService{err: fmt.Errorf("%w: period must be YYYY-MM or YYYY-MM-DD", domain.ErrInvalidRequest)}
+	server := NewServer(&MockLanguageDetectionService{}, WithUsageService(usage))
+
+	_, err := server.GetUsageReport(context.Background(), &pb.GetUsageReportRequest{Period: "April"})
+	st, details := statusDetails(t, err)
+
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("Expected code InvalidArgument, got %v", st.Code())
+	}
+	if details.badRequest == nil || details.badRequest.FieldViolations[0].Field != "period" {
+		t.Errorf("Expected a field violation for period, got %v", details.badRequest)
+	}
+}
+
+func TestServer_GetUsageReport_PermissionDenied(t *testing.T) {
+	usage := &MockUsageService{err: fmt.Errorf("%w: cannot report on tenant globex", domain.ErrPermissionDenied)}
+	server := NewServer(&MockLanguageDetectionService{}, WithUsageService(usage))
+
+	_, err := server.GetUsageReport(context.Background(), &pb.GetUsageReportRequest{TenantId: "globex"})
+	st, details := statusDetails(t, err)
+
+	if st.Code() != codes.PermissionDenied {
+		t.Errorf("Expected code PermissionDenied, got %v", st.Code())
+	}
+	if details.info == nil || details.info.Reason != "PERMISSION_DENIED" {
+		t.Errorf("Expected reason PERMISSION_DENIED, got %v", details.info)
+	}
+}
+
+func TestServer_GetUsageReport_NotConfigured(t *testing.T) {
+	server := NewServer(&MockLanguageDetectionService{})
+
+	_, err := server.GetUsageReport(context.Background(), &pb.GetUsageReportRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected code Unimplemented, got %v", status.Code(err))
+	}
+}
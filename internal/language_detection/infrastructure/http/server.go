@@ -0,0 +1,518 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/auth"
+	"language-detection-service/internal/language_detection/infrastructure/logging"
+	"language-detection-service/internal/language_detection/infrastructure/ratelimit"
+)
+
+const (
+	// maxBodyBytes matches the gRPC server's receive limit
+	maxBodyBytes = 4 * 1024 * 1024
+
+	// maxBatchSize limits the number of documents in a single batch request
+	maxBatchSize = 100
+
+	// batchConcurrency limits the number of detections a batch runs at once
+	batchConcurrency = 8
+
+	// tenantHeader names the tenant a request is made for
+	tenantHeader = "X-Tenant-ID"
+
+	// apiKeyHeader carries the API key of the caller
+	apiKeyHeader = "X-API-Key"
+
+	// requestIDHeader carries the request ID in both directions
+	requestIDHeader = "X-Request-ID"
+)
+
+// Server represents the REST/JSON gateway for language detection
+type Server struct {
+	service         domain.LanguageDetectionService
+	cacheStats      domain.CacheStatsReporter
+	authenticator   *auth.Authenticator
+	limiter         *ratelimit.Limiter
+	metrics         domain.Metrics
+	accessLog       *slog.Logger
+	redactor        *logging.Redactor
+	server          *http.Server
+	shutdownTimeout time.Duration
+}
+
+// HealthResponse is the body returned by the health check
+type HealthResponse struct {
+	Status            string             `json:"status"`
+	Cache             *domain.CacheStats `json:"cache,omitempty"`
+	CoalescedRequests *uint64            `json:"coalesced_requests,omitempty"`
+}
+
+// BatchDetectRequest represents a batch of language detection requests
+type BatchDetectRequest struct {
+	Requests []domain.LanguageDetectionRequest `json:"requests"`
+}
+
+// BatchDetectResponse holds one result per request, in request order
+type BatchDetectResponse struct {
+	Results []BatchDetectResult `json:"results"`
+}
+
+// BatchDetectResult holds either the response or the error of a single batch item
+type BatchDetectResult struct {
+	Response *domain.LanguageDetectionResponse `json:"response,omitempty"`
+	Error    *ErrorBody                        `json:"error,omitempty"`
+}
+
+// ErrorResponse is the body returned for failed requests
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody describes a failure with a stable code and a readable message
+type ErrorBody struct {
+	Status  int    `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewServer creates a new HTTP gateway server
+func NewServer(service domain.LanguageDetectionService) *Server {
+	s := &Server{
+		service:         service,
+		metrics:         domain.NopMetrics{},
+		shutdownTimeout: 30 * time.Second,
+	}
+
+	s.server = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+
+	return s
+}
+
+// WithCacheStats reports the counters of the result cache in the health check
+func (s *Server) WithCacheStats(cacheStats domain.CacheStatsReporter) *Server {
+	s.cacheStats = cacheStats
+	return s
+}
+
+// WithAuthenticator requires credentials on every route but the health check.
+// The tenant then comes from the caller instead of the tenant header.
+func (s *Server) WithAuthenticator(authenticator *auth.Authenticator) *Server {
+	s.authenticator = authenticator
+	return s
+}
+
+// WithRateLimiter limits every client but the health check to the rate of
+// its class, with the buckets of the gRPC server
+func (s *Server) WithRateLimiter(limiter *ratelimit.Limiter) *Server {
+	s.limiter = limiter
+	return s
+}
+
+// WithMetrics counts each request by route and status code
+func (s *Server) WithMetrics(metrics domain.Metrics) *Server {
+	s.metrics = metrics
+	return s
+}
+
+// WithAccessLog logs every request with its outcome and duration. Request
+// texts are described by redactor instead of being logged.
+func (s *Server) WithAccessLog(logger *slog.Logger, redactor *logging.Redactor) *Server {
+	s.accessLog = logger
+	s.redactor = redactor
+	return s
+}
+
+// WithTLS serves the gateway over TLS with the given config
+func (s *Server) WithTLS(config *tls.Config) *Server {
+	s.server.TLSConfig = config
+	return s
+}
+
+// Handler returns the HTTP handler serving the gateway routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/detect", s.handleDetect)
+	mux.HandleFunc("POST /v1/detect/batch", s.handleBatchDetect)
+	mux.HandleFunc("GET /healthz", s.handleHealth)
+	return withRequestID(s.withAccessLog(mux, s.withMetrics(mux, s.withCaller(s.withRateLimit(mux)))))
+}
+
+// routeOf returns the route pattern a request matches in mux
+func routeOf(mux *http.ServeMux, r *http.Request) string {
+	if _, pattern := mux.Handler(r); pattern != "" {
+		return pattern
+	}
+	return "unmatched"
+}
+
+// withMetrics records the status code of each request under the route pattern
+// it matched in mux
+func (s *Server) withMetrics(mux *http.ServeMux, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		s.metrics.RequestHandled("http", routeOf(mux, r), strconv.Itoa(recorder.status))
+	})
+}
+
+// withRequestID attaches the request ID sent by the caller, or a new one, to
+// the request context and returns it in the response headers
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := domain.ResolveRequestID(r.Header.Get(requestIDHeader))
+		w.Header().Set(requestIDHeader, requestID)
+		next.ServeHTTP(w, r.WithContext(domain.WithRequestID(r.Context(), requestID)))
+	})
+}
+
+// withAccessLog logs every request once it is answered, with the attributes
+// the handlers added to its access entry
+func (s *Server) withAccessLog(mux *http.ServeMux, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.accessLog == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+		entry := &accessEntry{}
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), accessEntryKey{}, entry)))
+
+		attrs := []slog.Attr{
+			slog.String("transport", "http"),
+			slog.String("method", routeOf(mux, r)),
+			slog.String("peer", r.RemoteAddr),
+			slog.Int("status", recorder.status),
+			slog.Int64("duration_ms", time.Since(start).Milliseconds()),
+		}
+		level := slog.LevelInfo
+		switch {
+		case recorder.status >= 500:
+			level = slog.LevelError
+		case recorder.status >= 400:
+			level = slog.LevelWarn
+		}
+		s.accessLog.LogAttrs(r.Context(), level, "Request handled", append(attrs, entry.attrs...)...)
+	})
+}
+
+// accessEntry collects the attributes handlers add to the access log of a request
+type accessEntry struct {
+	attrs []slog.Attr
+}
+
+// accessEntryKey is the context key of the access entry
+type accessEntryKey struct{}
+
+// annotate adds attributes to the access log of the request, if it is logged
+func annotate(ctx context.Context, attrs ...slog.Attr) {
+	if entry, ok := ctx.Value(accessEntryKey{}).(*accessEntry); ok {
+		entry.attrs = append(entry.attrs, attrs...)
+	}
+}
+
+// statusRecorder remembers the status code written through it
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// withCaller attaches the authenticated caller, or without authentication the
+// tenant named in the request headers, to the request context
+func (s *Server) withCaller(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case s.authenticator == nil:
+			if tenant := r.Header.Get(tenantHeader); tenant != "" {
+				r = r.WithContext(domain.WithTenant(r.Context(), tenant))
+			}
+		case r.URL.Path != "/healthz":
+			var token string
+			if scheme, credentials, ok := strings.Cut(r.Header.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "bearer") {
+				token = strings.TrimSpace(credentials)
+			}
+
+			principal, err := s.authenticator.Authenticate(r.Header.Get(apiKeyHeader), token, verifiedClientCert(r))
+			if err != nil {
+				slog.WarnContext(r.Context(), "Rejected request", "path", r.URL.Path, "peer", r.RemoteAddr, "error", err)
+				writeError(w, errorBody(err))
+				return
+			}
+			r = r.WithContext(domain.WithPrincipal(r.Context(), principal))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withRateLimit rejects requests from clients over their limit with 429 and
+// the number of seconds to wait in Retry-After
+func (s *Server) withRateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.limiter == nil || r.URL.Path == "/healthz" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		decision := s.limiter.Allow(ratelimit.Identity(r.Context(), verifiedClientCert(r), r.RemoteAddr))
+		if !decision.Allowed {
+			retryAfter := int64(math.Ceil(decision.RetryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.FormatInt(max(retryAfter, 1), 10))
+			writeError(w, ErrorBody{
+				Status:  http.StatusTooManyRequests,
+				Code:    "rate_limited",
+				Message: fmt.Sprintf("rate limit of client class %q exceeded", decision.Class),
+			})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifiedClientCert returns the client certificate verified during the TLS
+// handshake, if any
+func verifiedClientCert(r *http.Request) *x509.Certificate {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		return r.TLS.VerifiedChains[0][0]
+	}
+	return nil
+}
+
+// StartWithContext starts the HTTP server with context support
+func (s *Server) StartWithContext(ctx context.Context, address string) error {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+	if s.server.TLSConfig != nil {
+		lis = tls.NewListener(lis, s.server.TLSConfig)
+	}
+
+	slog.Info("Starting HTTP Language Detection gateway", "address", address)
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- s.server.Serve(lis)
+	}()
+
+	select {
+	case <-ctx.Done():
+		slog.Info("Context cancelled, stopping HTTP server")
+		s.server.Close()
+		return ctx.Err()
+	case err := <-serverErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+// Stop gracefully stops the HTTP server
+func (s *Server) Stop() error {
+	slog.Info("Shutting down HTTP Language Detection gateway")
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.server.Close()
+		return fmt.Errorf("HTTP server shutdown: %w", err)
+	}
+
+	slog.Info("HTTP server stopped gracefully")
+	return nil
+}
+
+// handleDetect serves POST /v1/detect
+func (s *Server) handleDetect(w http.ResponseWriter, r *http.Request) {
+	var req domain.LanguageDetectionRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeError(w, errorBody(err))
+		return
+	}
+	if s.redactor != nil {
+		annotate(r.Context(), s.redactor.TextAttrs(string(req.Text))...)
+	}
+
+	resp, err := s.service.DetectLanguage(r.Context(), &req)
+	if err != nil {
+		body := errorBody(err)
+		annotate(r.Context(), slog.String("error", body.Message))
+		writeError(w, body)
+		return
+	}
+	annotate(r.Context(),
+		slog.String("language", string(resp.LanguageCode)),
+		slog.Float64("confidence", float64(resp.Confidence)),
+	)
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// handleBatchDetect serves POST /v1/detect/batch.
+// Items fail independently; the batch itself only fails on a malformed body.
+func (s *Server) handleBatchDetect(w http.ResponseWriter, r *http.Request) {
+	var batch BatchDetectRequest
+	if err := decodeJSON(w, r, &batch); err != nil {
+		writeError(w, errorBody(err))
+		return
+	}
+
+	if len(batch.Requests) == 0 {
+		writeError(w, errorBody(fmt.Errorf("%w: batch contains no requests", domain.ErrInvalidRequest)))
+		return
+	}
+
+	if len(batch.Requests) > maxBatchSize {
+		writeError(w, errorBody(fmt.Errorf("%w: batch size %d exceeds maximum %d",
+			domain.ErrInvalidRequest, len(batch.Requests), maxBatchSize)))
+		return
+	}
+
+	annotate(r.Context(), slog.Int("documents", len(batch.Requests)))
+
+	results := make([]BatchDetectResult, len(batch.Requests))
+	sem := make(chan struct{}, batchConcurrency)
+	var wg sync.WaitGroup
+
+	for i := range batch.Requests {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := s.service.DetectLanguage(r.Context(), &batch.Requests[i])
+			if err != nil {
+				body := errorBody(err)
+				results[i].Error = &body
+				return
+			}
+			results[i].Response = resp
+		}(i)
+	}
+	wg.Wait()
+
+	writeJSON(w, http.StatusOK, BatchDetectResponse{Results: results})
+}
+
+// handleHealth serves GET /healthz
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	health := HealthResponse{Status: "SERVING"}
+	if s.cacheStats != nil {
+		stats := s.cacheStats.CacheStats()
+		health.Cache = &stats
+	}
+	if reporter, ok := s.service.(domain.CoalescingReporter); ok {
+		coalesced := reporter.CoalescedRequests()
+		health.CoalescedRequests = &coalesced
+	}
+	writeJSON(w, http.StatusOK, health)
+}
+
+// errMalformedBody marks request bodies that are not valid JSON for the endpoint
+var errMalformedBody = errors.New("malformed request body")
+
+// decodeJSON decodes a size-limited JSON body, rejecting unknown fields
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return fmt.Errorf("%w: body exceeds %d bytes", domain.ErrTextTooLong, maxErr.Limit)
+		}
+		return fmt.Errorf("%w: %v", errMalformedBody, err)
+	}
+
+	if decoder.More() {
+		return fmt.Errorf("%w: unexpected data after JSON body", errMalformedBody)
+	}
+
+	return nil
+}
+
+// errorBody maps an error to its HTTP status and stable error code
+func errorBody(err error) ErrorBody {
+	status, code := http.StatusInternalServerError, "internal"
+
+	switch {
+	case errors.Is(err, domain.ErrProviderUnavailable):
+		status, code = http.StatusServiceUnavailable, "provider_unavailable"
+	case errors.Is(err, errMalformedBody):
+		status, code = http.StatusBadRequest, "malformed_body"
+	case errors.Is(err, domain.ErrEmptyText):
+		status, code = http.StatusBadRequest, "empty_text"
+	case errors.Is(err, domain.ErrTextTooLong):
+		status, code = http.StatusRequestEntityTooLarge, "text_too_long"
+	case errors.Is(err, domain.ErrInvalidOptions):
+		status, code = http.StatusBadRequest, "invalid_options"
+	case errors.Is(err, domain.ErrInvalidRequest):
+		status, code = http.StatusBadRequest, "invalid_request"
+	case errors.Is(err, domain.ErrLowConfidence):
+		status, code = http.StatusUnprocessableEntity, "low_confidence"
+	case errors.Is(err, domain.ErrInvalidLanguageCode):
+		status, code = http.StatusUnprocessableEntity, "unsupported_language"
+	case errors.Is(err, domain.ErrUnauthenticated):
+		status, code = http.StatusUnauthorized, "unauthenticated"
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		status, code = http.StatusTooManyRequests, "quota_exceeded"
+	case errors.Is(err, context.DeadlineExceeded):
+		status, code = http.StatusGatewayTimeout, "deadline_exceeded"
+	case errors.Is(err, context.Canceled):
+		status, code = http.StatusServiceUnavailable, "canceled"
+	}
+
+	return ErrorBody{
+		Status:  status,
+		Code:    code,
+		Message: err.Error(),
+	}
+}
+
+// writeError writes an error response. Provider outages suggest when to retry,
+// and authentication failures name the accepted scheme.
+func writeError(w http.ResponseWriter, body ErrorBody) {
+	switch body.Code {
+	case "provider_unavailable":
+		w.Header().Set("Retry-After", "1")
+	case "unauthenticated":
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	writeJSON(w, body.Status, ErrorResponse{Error: body})
+}
+
+// writeJSON writes v as a JSON response with the given status
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Warn("Failed to write HTTP response", "error", err)
+	}
+}
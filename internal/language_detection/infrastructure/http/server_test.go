@@ -0,0 +1,561 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/auth"
+	"language-detection-service/internal/language_detection/infrastructure/logging"
+	"language-detection-service/internal/language_detection/infrastructure/ratelimit"
+)
+
+// MockLanguageDetectionService is a mock implementation of LanguageDetectionService
+type MockLanguageDetectionService struct {
+	detect func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error)
+}
+
+func (m *MockLanguageDetectionService) DetectLanguage(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+	return m.detect(ctx, request)
+}
+
+// MockMetrics records the requests reported to it
+type MockMetrics struct {
+	domain.NopMetrics
+	requests []string
+}
+
+func (m *MockMetrics) RequestHandled(transport, method, code string) {
+	m.requests = append(m.requests, transport+" "+method+" "+code)
+}
+
+// echoService returns an English detection echoing the document ID, or validation errors
+func echoService() *MockLanguageDetectionService {
+	return &MockLanguageDetectionService{
+		detect: func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+			if request.Text == "" {
+				return nil, fmt.Errorf("validation failed: %w", domain.ErrEmptyText)
+			}
+			return &domain.LanguageDetectionResponse{
+				LanguageCode: "en-US",
+				Confidence:   0.95,
+				DocumentID:   request.DocumentID,
+				Metadata: domain.ProcessingMetadata{
+					Provider: "test",
+				},
+			}, nil
+		},
+	}
+}
+
+func doRequest(t *testing.T, handler http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestNewServer(t *testing.T) {
+	service := echoService()
+	server := NewServer(service)
+
+	if server == nil {
+		t.Fatal("Expected server to be created, got nil")
+	}
+
+	if server.service != service {
+		t.Errorf("Expected service to be %v, got %v", service, server.service)
+	}
+
+	if server.shutdownTimeout != 30*time.Second {
+		t.Errorf("Expected shutdown timeout 30s, got %v", server.shutdownTimeout)
+	}
+}
+
+func TestServer_Detect_Success(t *testing.T) {
+	handler := NewServer(echoService()).Handler()
+
+	rec := doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": "Hello world", "document_id": "doc-1"}`)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Expected Content-Type application/json, got %s", ct)
+	}
+
+	var resp domain.LanguageDetectionResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if resp.LanguageCode != "en-US" {
+		t.Errorf("Expected language code 'en-US', got %s", resp.LanguageCode)
+	}
+
+	if resp.DocumentID != "doc-1" {
+		t.Errorf("Expected document ID 'doc-1', got %s", resp.DocumentID)
+	}
+
+	if resp.Metadata.Provider != "test" {
+		t.Errorf("Expected provider 'test', got %s", resp.Metadata.Provider)
+	}
+}
+
+func TestServer_Detect_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"Malformed JSON", `{"text": `, nil, http.StatusBadRequest, "malformed_body"},
+		{"Unknown field", `{"txt": "hello"}`, nil, http.StatusBadRequest, "malformed_body"},
+		{"Trailing data", `{"text": "hello"} {}`, nil, http.StatusBadRequest, "malformed_body"},
+		{"Empty text", `{"text": ""}`, domain.ErrEmptyText, http.StatusBadRequest, "empty_text"},
+		{"Text too long", `{"text": "hello"}`, domain.ErrTextTooLong, http.StatusRequestEntityTooLarge, "text_too_long"},
+		{"Invalid request", `{"text": "hello"}`, domain.ErrInvalidRequest, http.StatusBadRequest, "invalid_request"},
+		{"Invalid options", `{"text": "hello", "options": {"max_alternatives": 500}}`, domain.ErrInvalidOptions, http.StatusBadRequest, "invalid_options"},
+		{"Low confidence", `{"text": "hello"}`, domain.ErrLowConfidence, http.StatusUnprocessableEntity, "low_confidence"},
+		{"Unsupported language", `{"text": "hello"}`, domain.ErrInvalidLanguageCode, http.StatusUnprocessableEntity, "unsupported_language"},
+		{"Unauthenticated", `{"text": "hello"}`, domain.ErrUnauthenticated, http.StatusUnauthorized, "unauthenticated"},
+		{"Quota exceeded", `{"text": "hello"}`, domain.ErrQuotaExceeded, http.StatusTooManyRequests, "quota_exceeded"},
+		{"Deadline", `{"text": "hello"}`, context.DeadlineExceeded, http.StatusGatewayTimeout, "deadline_exceeded"},
+		{"Provider unavailable", `{"text": "hello"}`, domain.ErrProviderUnavailable, http.StatusServiceUnavailable, "provider_unavailable"},
+		{"Internal", `{"text": "hello"}`, errors.New("boom"), http.StatusInternalServerError, "internal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &MockLanguageDetectionService{
+				detect: func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+					if tt.err == nil {
+						t.Fatal("Service should not be called for a malformed body")
+					}
+					return nil, fmt.Errorf("language detection failed: %w", tt.err)
+				},
+			}
+
+			rec := doRequest(t, NewServer(service).Handler(), http.MethodPost, "/v1/detect", tt.body)
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, rec.Code)
+			}
+
+			var resp ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to decode error response: %v", err)
+			}
+
+			if resp.Error.Code != tt.expectedCode {
+				t.Errorf("Expected error code %s, got %s", tt.expectedCode, resp.Error.Code)
+			}
+
+			if resp.Error.Status != tt.expectedStatus {
+				t.Errorf("Expected error status %d, got %d", tt.expectedStatus, resp.Error.Status)
+			}
+
+			if resp.Error.Message == "" {
+				t.Error("Expected error message, got empty string")
+			}
+
+			if retry := rec.Header().Get("Retry-After"); (tt.expectedCode == "provider_unavailable") != (retry != "") {
+				t.Errorf("Unexpected Retry-After header %q", retry)
+			}
+		})
+	}
+}
+
+func TestServer_Detect_Tenant(t *testing.T) {
+	var tenant string
+	service := &MockLanguageDetectionService{
+		detect: func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+			tenant = domain.TenantFromContext(ctx)
+			return &domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.95}, nil
+		},
+	}
+	handler := NewServer(service).Handler()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/detect", strings.NewReader(`{"text": "hello"}`))
+	req.Header.Set(tenantHeader, "acme")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if tenant != "acme" {
+		t.Errorf("Expected tenant acme, got %s", tenant)
+	}
+
+	doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": "hello"}`)
+	if tenant != domain.DefaultTenant {
+		t.Errorf("Expected tenant %s without a header, got %s", domain.DefaultTenant, tenant)
+	}
+}
+
+func TestServer_Detect_Authentication(t *testing.T) {
+	var principal domain.Principal
+	service := &MockLanguageDetectionService{
+		detect: func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+			principal, _ = domain.PrincipalFromContext(ctx)
+			return &domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.95}, nil
+		},
+	}
+	sum := sha256.Sum256([]byte("secret"))
+	keys, err := auth.NewAPIKeys([]auth.APIKeyEntry{{ID: "billing", Tenant: "acme", SHA256: hex.EncodeToString(sum[:])}})
+	if err != nil {
+		t.Fatalf("NewAPIKeys() error = %v, want nil", err)
+	}
+	handler := NewServer(service).WithAuthenticator(auth.NewAuthenticator(keys, nil)).Handler()
+
+	rec := doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": "hello"}`)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 without credentials, got %d", rec.Code)
+	}
+	if rec.Header().Get("WWW-Authenticate") != "Bearer" {
+		t.Errorf("Expected a WWW-Authenticate header, got %q", rec.Header().Get("WWW-Authenticate"))
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/detect", strings.NewReader(`{"text": "hello"}`))
+	req.Header.Set(apiKeyHeader, "secret")
+	req.Header.Set(tenantHeader, "globex")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200 with an API key, got %d", rec.Code)
+	}
+	if principal.ID != "billing" || principal.TenantID != "acme" {
+		t.Errorf("Expected principal billing of tenant acme, got %+v", principal)
+	}
+
+	if rec := doRequest(t, handler, http.MethodGet, "/healthz", ""); rec.Code != http.StatusOK {
+		t.Errorf("Expected the health check to be exempt, got status %d", rec.Code)
+	}
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	limiter := ratelimit.NewLimiter(map[string]ratelimit.Limit{ratelimit.DefaultClass: {Rate: 0.5, Burst: 1}}, nil)
+	handler := NewServer(echoService()).WithRateLimiter(limiter).Handler()
+
+	if rec := doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": "hello"}`); rec.Code != http.StatusOK {
+		t.Fatalf("Expected the first request to pass, got status %d", rec.Code)
+	}
+
+	rec := doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": "hello"}`)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429 once the bucket is empty, got %d", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") != "2" {
+		t.Errorf("Expected Retry-After 2, got %q", rec.Header().Get("Retry-After"))
+	}
+	var resp ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || resp.Error.Code != "rate_limited" {
+		t.Errorf("Expected error code rate_limited, got %s (%v)", rec.Body.String(), err)
+	}
+
+	// Other clients have their own bucket, and health checks are never limited
+	req := httptest.NewRequest(http.MethodPost, "/v1/detect", strings.NewReader(`{"text": "hello"}`))
+	req.RemoteAddr = "10.0.0.7:4321"
+	other := httptest.NewRecorder()
+	handler.ServeHTTP(other, req)
+	if other.Code != http.StatusOK {
+		t.Errorf("Expected another client to pass, got status %d", other.Code)
+	}
+	if rec := doRequest(t, handler, http.MethodGet, "/healthz", ""); rec.Code != http.StatusOK {
+		t.Errorf("Expected the health check to be exempt, got status %d", rec.Code)
+	}
+}
+
+func TestServer_Detect_BodyTooLarge(t *testing.T) {
+	handler := NewServer(echoService()).Handler()
+
+	body := `{"text": "` + strings.Repeat("a", maxBodyBytes) + `"}`
+	rec := doRequest(t, handler, http.MethodPost, "/v1/detect", body)
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected status 413, got %d", rec.Code)
+	}
+}
+
+func TestServer_Detect_MethodNotAllowed(t *testing.T) {
+	handler := NewServer(echoService()).Handler()
+
+	rec := doRequest(t, handler, http.MethodGet, "/v1/detect", "")
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", rec.Code)
+	}
+}
+
+func TestServer_Metrics(t *testing.T) {
+	metrics := &MockMetrics{}
+	handler := NewServer(echoService()).WithMetrics(metrics).Handler()
+
+	doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": "hello"}`)
+	doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": ""}`)
+	doRequest(t, handler, http.MethodGet, "/healthz", "")
+	doRequest(t, handler, http.MethodGet, "/unknown", "")
+
+	expected := []string{
+		"http POST /v1/detect 200",
+		"http POST /v1/detect 400",
+		"http GET /healthz 200",
+		"http unmatched 404",
+	}
+	if fmt.Sprint(metrics.requests) != fmt.Sprint(expected) {
+		t.Errorf("Expected requests %v, got %v", expected, metrics.requests)
+	}
+}
+
+func TestServer_RequestID(t *testing.T) {
+	var seen string
+	service := &MockLanguageDetectionService{
+		detect: func(ctx context.Context, request *domain.LanguageDetectionRequest) (*domain.LanguageDetectionResponse, error) {
+			seen = domain.RequestIDFromContext(ctx)
+			return &domain.LanguageDetectionResponse{LanguageCode: "en-US", Confidence: 0.9}, nil
+		},
+	}
+	handler := NewServer(service).Handler()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/detect", strings.NewReader(`{"text": "hello"}`))
+	req.Header.Set(requestIDHeader, "req-1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if seen != "req-1" || rec.Header().Get(requestIDHeader) != "req-1" {
+		t.Errorf("Expected request ID req-1 to be propagated, got %q and header %q", seen, rec.Header().Get(requestIDHeader))
+	}
+
+	rec = doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": "hello"}`)
+	if seen == "" || seen == "req-1" || rec.Header().Get(requestIDHeader) != seen {
+		t.Errorf("Expected a new request ID in the context and header, got %q and header %q", seen, rec.Header().Get(requestIDHeader))
+	}
+}
+
+func TestServer_AccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	handler := NewServer(echoService()).
+		WithAccessLog(logging.New(&buf, slog.LevelInfo), logging.NewRedactor("key", false)).
+		Handler()
+
+	doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": "private words"}`)
+	doRequest(t, handler, http.MethodPost, "/v1/detect", `{"text": ""}`)
+	output := buf.String()
+
+	var records []map[string]any
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var record map[string]any
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("Failed to decode log record: %v", err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 access log records, got %d", len(records))
+	}
+
+	expected := map[string]any{
+		"msg":         "Request handled",
+		"level":       "INFO",
+		"method":      "POST /v1/detect",
+		"status":      float64(200),
+		"text_length": float64(13),
+		"language":    "en-US",
+	}
+	for key, value := range expected {
+		if records[0][key] != value {
+			t.Errorf("Expected %s = %v, got %v", key, value, records[0][key])
+		}
+	}
+	if records[0]["request_id"] == nil || records[0]["text_hash"] == nil {
+		t.Errorf("Expected a request ID and text hash, got %v", records[0])
+	}
+	if records[1]["status"] != float64(400) || records[1]["level"] != "WARN" {
+		t.Errorf("Expected a warning for the empty text, got %v", records[1])
+	}
+	if strings.Contains(output, "private") {
+		t.Error("Expected the request text not to be logged")
+	}
+}
+
+func TestServer_BatchDetect(t *testing.T) {
+	handler := NewServer(echoService()).Handler()
+
+	body := `{"requests": [
+		{"text": "Hello world", "document_id": "a"},
+		{"text": "", "document_id": "b"},
+		{"text": "Good morning", "document_id": "c"}
+	]}`
+	rec := doRequest(t, handler, http.MethodPost, "/v1/detect/batch", body)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp BatchDetectResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if len(resp.Results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(resp.Results))
+	}
+
+	// Results keep the request order
+	for i, docID := range []string{"a", "", "c"} {
+		result := resp.Results[i]
+		if docID == "" {
+			if result.Error == nil || result.Error.Code != "empty_text" {
+				t.Errorf("Expected empty_text error for item %d, got %+v", i, result.Error)
+			}
+			if result.Response != nil {
+				t.Errorf("Expected no response for item %d, got %+v", i, result.Response)
+			}
+			continue
+		}
+		if result.Response == nil {
+			t.Fatalf("Expected response for item %d, got error %+v", i, result.Error)
+		}
+		if result.Response.DocumentID != docID {
+			t.Errorf("Expected document ID %s for item %d, got %s", docID, i, result.Response.DocumentID)
+		}
+	}
+}
+
+func TestServer_BatchDetect_InvalidBatch(t *testing.T) {
+	handler := NewServer(echoService()).Handler()
+
+	var oversized bytes.Buffer
+	oversized.WriteString(`{"requests": [`)
+	for i := 0; i <= maxBatchSize; i++ {
+		if i > 0 {
+			oversized.WriteString(",")
+		}
+		oversized.WriteString(`{"text": "hello"}`)
+	}
+	oversized.WriteString(`]}`)
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"Empty batch", `{"requests": []}`},
+		{"Missing requests", `{}`},
+		{"Oversized batch", oversized.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doRequest(t, handler, http.MethodPost, "/v1/detect/batch", tt.body)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("Expected status 400, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestServer_Health(t *testing.T) {
+	handler := NewServer(echoService()).Handler()
+
+	rec := doRequest(t, handler, http.MethodGet, "/healthz", "")
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+}
+
+// MockCacheStats reports fixed cache counters
+type MockCacheStats struct {
+	stats domain.CacheStats
+}
+
+func (m *MockCacheStats) CacheStats() domain.CacheStats {
+	return m.stats
+}
+
+// MockCoalescingService reports a fixed coalescing count
+type MockCoalescingService struct {
+	*MockLanguageDetectionService
+}
+
+func (m MockCoalescingService) CoalescedRequests() uint64 {
+	return 7
+}
+
+func TestServer_HealthCoalescedRequests(t *testing.T) {
+	handler := NewServer(MockCoalescingService{echoService()}).Handler()
+
+	rec := doRequest(t, handler, http.MethodGet, "/healthz", "")
+
+	var health HealthResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &health); err != nil {
+		t.Fatalf("Failed to decode health response: %v", err)
+	}
+
+	if health.CoalescedRequests == nil || *health.CoalescedRequests != 7 {
+		t.Errorf("Expected 7 coalesced requests, got %v", health.CoalescedRequests)
+	}
+}
+
+func TestServer_HealthCacheStats(t *testing.T) {
+	handler := NewServer(echoService()).
+		WithCacheStats(&MockCacheStats{stats: domain.CacheStats{Hits: 3, Misses: 1, Entries: 1}}).
+		Handler()
+
+	rec := doRequest(t, handler, http.MethodGet, "/healthz", "")
+
+	var health HealthResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &health); err != nil {
+		t.Fatalf("Failed to decode health response: %v", err)
+	}
+
+	if health.Cache == nil || health.Cache.Hits != 3 || health.Cache.Misses != 1 {
+		t.Errorf("Expected 3 hits and 1 miss, got %+v", health.Cache)
+	}
+}
+
+func TestServer_StartWithContext_InvalidAddress(t *testing.T) {
+	server := NewServer(echoService())
+
+	err := server.StartWithContext(context.Background(), "invalid-address")
+	if err == nil {
+		t.Error("Expected error for invalid address, got nil")
+	}
+}
+
+func TestServer_StartWithContext_CancelledContext(t *testing.T) {
+	server := NewServer(echoService())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	err := server.StartWithContext(ctx, "127.0.0.1:0")
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled error, got %v", err)
+	}
+}
+
+func TestServer_Stop(t *testing.T) {
+	server := NewServer(echoService())
+
+	if err := server.Stop(); err != nil {
+		t.Errorf("Stop() error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// decodeRecords parses the JSON records written to buf
+func decodeRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		var record map[string]any
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("Failed to decode log record: %v", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestNew_ContextAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, slog.LevelInfo).With("component", "test")
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "call")
+	defer span.End()
+	ctx = domain.WithRequestID(ctx, "req-1")
+
+	logger.InfoContext(ctx, "Detected", "language", "fr-FR")
+	logger.Info("No request")
+	logger.Debug("Below the level")
+
+	records := decodeRecords(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+
+	first := records[0]
+	expected := map[string]any{
+		"msg":        "Detected",
+		"level":      "INFO",
+		"component":  "test",
+		"language":   "fr-FR",
+		"request_id": "req-1",
+		"trace_id":   span.SpanContext().TraceID().String(),
+	}
+	for key, value := range expected {
+		if first[key] != value {
+			t.Errorf("Expected %s = %v, got %v", key, value, first[key])
+		}
+	}
+
+	if _, ok := records[1]["request_id"]; ok {
+		t.Errorf("Expected no request ID without a request context, got %v", records[1])
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected slog.Level
+		wantErr  bool
+	}{
+		{"debug", slog.LevelDebug, false},
+		{"INFO", slog.LevelInfo, false},
+		{"warn", slog.LevelWarn, false},
+		{"error", slog.LevelError, false},
+		{"verbose", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			level, err := ParseLevel(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLevel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && level != tt.expected {
+				t.Errorf("Expected level %v, got %v", tt.expected, level)
+			}
+		})
+	}
+}
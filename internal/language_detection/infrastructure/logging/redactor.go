@@ -0,0 +1,61 @@
+package logging
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"unicode/utf8"
+)
+
+// hashLength is the number of hex characters of a text hash kept in logs
+const hashLength = 16
+
+// Redactor describes request texts in logs by their length and a keyed hash,
+// so repeated texts can be correlated without revealing them. The hash is
+// keyed because a plain hash of a short text is easily reversed by guessing.
+type Redactor struct {
+	key         []byte
+	includeText bool
+}
+
+// NewRedactor creates a redactor hashing with key, or with a random key when
+// empty, which makes hashes comparable only within one process. With
+// includeText the raw text is logged as well, which is meant for debugging only.
+func NewRedactor(key string, includeText bool) *Redactor {
+	r := &Redactor{key: []byte(key), includeText: includeText}
+	if key == "" {
+		r.key = make([]byte, 32)
+		rand.Read(r.key)
+	}
+	return r
+}
+
+// TextAttrs describes a text by its length in characters and its hash
+func (r *Redactor) TextAttrs(text string) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.Int("text_length", utf8.RuneCountInString(text)),
+		slog.String("text_hash", r.hash([]byte(text))),
+	}
+	if r.includeText {
+		attrs = append(attrs, slog.String("text", text))
+	}
+	return attrs
+}
+
+// ContentAttrs describes binary content, such as a document, by its size in
+// bytes and its hash. The content itself is never logged.
+func (r *Redactor) ContentAttrs(content []byte) []slog.Attr {
+	return []slog.Attr{
+		slog.Int("content_bytes", len(content)),
+		slog.String("content_hash", r.hash(content)),
+	}
+}
+
+// hash returns the truncated HMAC-SHA256 of data
+func (r *Redactor) hash(data []byte) string {
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))[:hashLength]
+}
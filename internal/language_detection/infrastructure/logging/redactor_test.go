@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"log/slog"
+	"testing"
+)
+
+// attrMap indexes attributes by key
+func attrMap(attrs []slog.Attr) map[string]slog.Value {
+	values := make(map[string]slog.Value)
+	for _, attr := range attrs {
+		values[attr.Key] = attr.Value
+	}
+	return values
+}
+
+func TestRedactor_TextAttrs(t *testing.T) {
+	redactor := NewRedactor("secret", false)
+
+	attrs := attrMap(redactor.TextAttrs("¿Qué tal?"))
+	if attrs["text_length"].Int64() != 9 {
+		t.Errorf("Expected text length 9, got %v", attrs["text_length"])
+	}
+	if hash := attrs["text_hash"].String(); len(hash) != hashLength {
+		t.Errorf("Expected a %d character hash, got %q", hashLength, hash)
+	}
+	if _, ok := attrs["text"]; ok {
+		t.Error("Expected the text not to be logged")
+	}
+
+	// Hashes correlate equal texts under the same key only
+	same := attrMap(NewRedactor("secret", false).TextAttrs("¿Qué tal?"))
+	other := attrMap(NewRedactor("other", false).TextAttrs("¿Qué tal?"))
+	if same["text_hash"].String() != attrs["text_hash"].String() {
+		t.Error("Expected equal texts to hash equally under the same key")
+	}
+	if other["text_hash"].String() == attrs["text_hash"].String() {
+		t.Error("Expected different keys to give different hashes")
+	}
+}
+
+func TestRedactor_IncludeText(t *testing.T) {
+	attrs := attrMap(NewRedactor("", true).TextAttrs("hello"))
+	if attrs["text"].String() != "hello" {
+		t.Errorf("Expected the text to be logged when included, got %v", attrs["text"])
+	}
+
+	// Binary content is never logged
+	content := attrMap(NewRedactor("", true).ContentAttrs([]byte("PK\x03\x04")))
+	if content["content_bytes"].Int64() != 4 {
+		t.Errorf("Expected 4 content bytes, got %v", content["content_bytes"])
+	}
+	if _, ok := content["content"]; ok {
+		t.Error("Expected the content not to be logged")
+	}
+}
+
+func TestNewRedactor_RandomKey(t *testing.T) {
+	first := attrMap(NewRedactor("", false).TextAttrs("hello"))
+	second := attrMap(NewRedactor("", false).TextAttrs("hello"))
+	if first["text_hash"].String() == second["text_hash"].String() {
+		t.Error("Expected random keys to differ between redactors")
+	}
+}
@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// namespace prefixes every metric name
+const namespace = "language_detection"
+
+// Outcomes of a provider call
+const (
+	outcomeOK          = "ok"
+	outcomeUnavailable = "unavailable"
+	outcomeError       = "error"
+)
+
+// Prometheus implements the domain.Metrics interface with Prometheus collectors
+type Prometheus struct {
+	registry        *prometheus.Registry
+	requests        *prometheus.CounterVec
+	detections      *prometheus.CounterVec
+	confidence      *prometheus.HistogramVec
+	textLength      prometheus.Histogram
+	providerCalls   *prometheus.CounterVec
+	providerLatency *prometheus.HistogramVec
+	failovers       *prometheus.CounterVec
+}
+
+// NewPrometheus creates the collectors in a registry of their own, next to
+// the Go runtime and process collectors
+func NewPrometheus() *Prometheus {
+	p := &Prometheus{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Requests handled, by transport, method and status code.",
+		}, []string{"transport", "method", "code"}),
+		detections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "detections_total",
+			Help:      "Detections completed, by provider and detected language.",
+		}, []string{"provider", "language"}),
+		confidence: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "detection_confidence",
+			Help:      "Confidence of completed detections, by provider.",
+			Buckets:   []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1},
+		}, []string{"provider"}),
+		textLength: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "text_length_characters",
+			Help:      "Length of detected texts in characters.",
+			Buckets:   prometheus.ExponentialBuckets(10, 4, 8),
+		}),
+		providerCalls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "provider_calls_total",
+			Help:      "Calls to detection providers, by provider and outcome (ok, unavailable or error).",
+		}, []string{"provider", "outcome"}),
+		providerLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "provider_latency_seconds",
+			Help:      "Latency of calls to detection providers, by provider.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+		}, []string{"provider"}),
+		failovers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "failovers_total",
+			Help:      "Detections moved from an unavailable provider to the next one.",
+		}, []string{"from", "to"}),
+	}
+
+	p.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		p.requests, p.detections, p.confidence, p.textLength,
+		p.providerCalls, p.providerLatency, p.failovers,
+	)
+	return p
+}
+
+// Handler serves the metrics in the Prometheus text format
+func (p *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{})
+}
+
+// RequestHandled counts a finished request
+func (p *Prometheus) RequestHandled(transport, method, code string) {
+	p.requests.WithLabelValues(transport, method, code).Inc()
+}
+
+// DetectionCompleted records the language, confidence and text length of a detection
+func (p *Prometheus) DetectionCompleted(provider string, language domain.LanguageCode, confidence domain.Confidence, textLength int) {
+	p.detections.WithLabelValues(provider, string(language)).Inc()
+	p.confidence.WithLabelValues(provider).Observe(float64(confidence))
+	p.textLength.Observe(float64(textLength))
+}
+
+// ProviderCalled records the latency and outcome of a provider call
+func (p *Prometheus) ProviderCalled(provider string, duration time.Duration, err error) {
+	outcome := outcomeOK
+	switch {
+	case errors.Is(err, domain.ErrProviderUnavailable):
+		outcome = outcomeUnavailable
+	case err != nil:
+		outcome = outcomeError
+	}
+	p.providerCalls.WithLabelValues(provider, outcome).Inc()
+	p.providerLatency.WithLabelValues(provider).Observe(duration.Seconds())
+}
+
+// FailedOver counts a failover between two providers
+func (p *Prometheus) FailedOver(from, to string) {
+	p.failovers.WithLabelValues(from, to).Inc()
+}
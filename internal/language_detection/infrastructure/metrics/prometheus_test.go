@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func TestPrometheus_Counters(t *testing.T) {
+	p := NewPrometheus()
+
+	p.RequestHandled("grpc", "/detect", "OK")
+	p.RequestHandled("grpc", "/detect", "OK")
+	p.DetectionCompleted("aws-comprehend", "en-US", 0.95, 120)
+	p.ProviderCalled("aws-comprehend", 30*time.Millisecond, nil)
+	p.ProviderCalled("aws-comprehend", time.Second, domain.ErrProviderUnavailable)
+	p.ProviderCalled("aws-comprehend", time.Millisecond, errors.New("bad credentials"))
+	p.FailedOver("aws-comprehend", "fallback")
+
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"requests", testutil.ToFloat64(p.requests.WithLabelValues("grpc", "/detect", "OK")), 2},
+		{"detections", testutil.ToFloat64(p.detections.WithLabelValues("aws-comprehend", "en-US")), 1},
+		{"ok calls", testutil.ToFloat64(p.providerCalls.WithLabelValues("aws-comprehend", outcomeOK)), 1},
+		{"unavailable calls", testutil.ToFloat64(p.providerCalls.WithLabelValues("aws-comprehend", outcomeUnavailable)), 1},
+		{"failed calls", testutil.ToFloat64(p.providerCalls.WithLabelValues("aws-comprehend", outcomeError)), 1},
+		{"failovers", testutil.ToFloat64(p.failovers.WithLabelValues("aws-comprehend", "fallback")), 1},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("Expected %s %v, got %v", tt.name, tt.expected, tt.got)
+		}
+	}
+}
+
+func TestPrometheus_Handler(t *testing.T) {
+	p := NewPrometheus()
+	p.DetectionCompleted("fallback", "fr-FR", 0.75, 42)
+	p.ProviderCalled("fallback", time.Millisecond, nil)
+
+	rec := httptest.NewRecorder()
+	p.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+
+	body := rec.Body.String()
+	for _, expected := range []string{
+		`language_detection_detection_confidence_bucket{provider="fallback",le="0.8"} 1`,
+		`language_detection_text_length_characters_sum 42`,
+		`language_detection_provider_latency_seconds_count{provider="fallback"} 1`,
+		`go_goroutines`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected the scrape to contain %q", expected)
+		}
+	}
+}
+
+func TestServer_StartWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := NewServer(NewPrometheus().Handler()).StartWithContext(ctx, "127.0.0.1:0"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	if err := NewServer(NewPrometheus().Handler()).StartWithContext(context.Background(), "invalid-address"); err == nil {
+		t.Error("Expected an error for an invalid address, got nil")
+	}
+}
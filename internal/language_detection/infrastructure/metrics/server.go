@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// shutdownTimeout bounds how long a scrape in progress may delay shutdown
+const shutdownTimeout = 5 * time.Second
+
+// Server serves the metrics endpoint on a port of its own
+type Server struct {
+	server *http.Server
+}
+
+// NewServer creates a server exposing handler at /metrics
+func NewServer(handler http.Handler) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", handler)
+
+	return &Server{server: &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}}
+}
+
+// StartWithContext serves until ctx is done
+func (s *Server) StartWithContext(ctx context.Context, address string) error {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	slog.Info("Serving metrics", "address", address, "path", "/metrics")
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- s.server.Serve(lis)
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := s.server.Shutdown(shutdownCtx); err != nil {
+			s.server.Close()
+		}
+		return ctx.Err()
+	case err := <-serverErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
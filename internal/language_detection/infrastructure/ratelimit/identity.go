@@ -0,0 +1,33 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// Identity names the client of a request by its authenticated principal, then
+// by the subject of a verified client certificate, then by its address.
+// Identities are prefixed with "principal:", "cn:" or "ip:". Unverified
+// credentials are never used, so a caller cannot get a fresh bucket by sending
+// a new API key on every call.
+func Identity(ctx context.Context, clientCert *x509.Certificate, address string) string {
+	if principal, ok := domain.PrincipalFromContext(ctx); ok && principal.ID != "" {
+		return "principal:" + principal.ID
+	}
+
+	if clientCert != nil {
+		return "cn:" + clientCert.Subject.CommonName
+	}
+
+	if address == "" {
+		return "ip:unknown"
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	return "ip:" + host
+}
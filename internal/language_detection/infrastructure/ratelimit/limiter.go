@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// DefaultClass is the class of clients that are not assigned one
+const DefaultClass = "default"
+
+const (
+	// sweepThreshold is the number of client buckets above which idle ones are dropped
+	sweepThreshold = 10000
+
+	// sweepInterval bounds how often idle buckets are looked for
+	sweepInterval = time.Minute
+)
+
+// Limit is the token bucket of a client class: Rate requests per second on
+// average, with bursts of up to Burst requests
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Decision is the outcome of a rate limit check
+type Decision struct {
+	Class      string
+	Allowed    bool
+	RetryAfter time.Duration // until the next request would be allowed, when not allowed
+}
+
+// Limiter applies per-client token buckets. Each client identity gets its own
+// bucket, sized by the class it is assigned to.
+type Limiter struct {
+	classes map[string]Limit
+	clients map[string]string // identity to class
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// bucket holds the tokens of one client
+type bucket struct {
+	limit   Limit
+	tokens  float64
+	updated time.Time
+}
+
+// NewLimiter creates a new limiter. Clients missing from clients use DefaultClass;
+// if there is no such class they are not limited.
+func NewLimiter(classes map[string]Limit, clients map[string]string) *Limiter {
+	return &Limiter{
+		classes: classes,
+		clients: clients,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token from the client's bucket, or reports how long until one is available
+func (l *Limiter) Allow(identity string) Decision {
+	class, ok := l.clients[identity]
+	if !ok {
+		class = DefaultClass
+	}
+
+	limit, ok := l.classes[class]
+	if !ok {
+		return Decision{Class: class, Allowed: true}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[identity]
+	if !ok {
+		l.sweep(now)
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), updated: now}
+		l.buckets[identity] = b
+	}
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return Decision{Class: class, Allowed: true}
+	}
+
+	if limit.Rate <= 0 {
+		return Decision{Class: class, RetryAfter: time.Duration(math.MaxInt64)}
+	}
+	wait := (1 - b.tokens) / limit.Rate
+	return Decision{Class: class, RetryAfter: time.Duration(math.Ceil(wait * float64(time.Second)))}
+}
+
+// refill adds the tokens earned since the last update
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+		b.updated = now
+	}
+}
+
+// sweep drops the buckets that have refilled completely, which are the same
+// as new ones, once there are many clients; the caller holds the lock
+func (l *Limiter) sweep(now time.Time) {
+	if len(l.buckets) < sweepThreshold || now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for identity, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, identity)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(classes map[string]Limit, clients map[string]string) (*Limiter, *time.Time) {
+	limiter := NewLimiter(classes, clients)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	limiter, now := newTestLimiter(map[string]Limit{DefaultClass: {Rate: 2, Burst: 2}}, nil)
+
+	for i := 0; i < 2; i++ {
+		if d := limiter.Allow("ip:10.0.0.1"); !d.Allowed {
+			t.Fatalf("Expected request %d within the burst to be allowed", i)
+		}
+	}
+
+	d := limiter.Allow("ip:10.0.0.1")
+	if d.Allowed || d.RetryAfter != 500*time.Millisecond {
+		t.Errorf("Expected a denial with retry after 500ms, got %+v", d)
+	}
+
+	// Other clients have their own bucket
+	if d := limiter.Allow("ip:10.0.0.2"); !d.Allowed {
+		t.Error("Expected another client to be allowed")
+	}
+
+	*now = now.Add(500 * time.Millisecond)
+	if d := limiter.Allow("ip:10.0.0.1"); !d.Allowed {
+		t.Error("Expected a request to be allowed after a token was earned")
+	}
+}
+
+func TestLimiter_Classes(t *testing.T) {
+	limiter, _ := newTestLimiter(
+		map[string]Limit{DefaultClass: {Rate: 1, Burst: 1}, "premium": {Rate: 100, Burst: 5}},
+		map[string]string{"principal:reports": "premium", "cn:batch": "unlimited"},
+	)
+
+	for i := 0; i < 5; i++ {
+		if d := limiter.Allow("principal:reports"); !d.Allowed || d.Class != "premium" {
+			t.Fatalf("Expected premium request %d to be allowed, got %+v", i, d)
+		}
+	}
+
+	limiter.Allow("ip:10.0.0.1")
+	if d := limiter.Allow("ip:10.0.0.1"); d.Allowed || d.Class != DefaultClass {
+		t.Errorf("Expected the default class to be limited, got %+v", d)
+	}
+
+	// Classes without a limit are not limited
+	for i := 0; i < 10; i++ {
+		if d := limiter.Allow("cn:batch"); !d.Allowed {
+			t.Fatalf("Expected request %d of an unlimited class to be allowed", i)
+		}
+	}
+}
+
+func TestLimiter_SweepsIdleBuckets(t *testing.T) {
+	limiter, now := newTestLimiter(map[string]Limit{DefaultClass: {Rate: 1, Burst: 1}}, nil)
+
+	for i := 0; i < sweepThreshold; i++ {
+		limiter.Allow(fmt.Sprintf("ip:%d", i))
+	}
+
+	*now = now.Add(sweepInterval)
+	limiter.Allow("ip:new")
+
+	if len(limiter.buckets) != 1 {
+		t.Errorf("Expected the refilled buckets to be dropped, got %d buckets", len(limiter.buckets))
+	}
+}
@@ -0,0 +1,88 @@
+package stack
+
+import (
+	"fmt"
+	"log/slog"
+
+	"language-detection-service/internal/language_detection/application"
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/adapters"
+	"language-detection-service/internal/language_detection/infrastructure/charset"
+	"language-detection-service/internal/language_detection/infrastructure/config"
+	"language-detection-service/internal/language_detection/infrastructure/extraction"
+)
+
+// Stack holds the detection services built from one configuration, so that
+// the server and ldetect detect alike
+type Stack struct {
+	Detector  domain.LanguageDetector
+	Profiles  domain.ConfigResolver // nil without tenant profiles
+	Service   *application.LanguageDetectionServiceImpl
+	Catalog   *application.LanguageCatalogServiceImpl
+	Documents *application.DocumentDetectionServiceImpl
+	Subtitles *application.SubtitleDetectionServiceImpl
+	Raw       *application.RawTextDetectionServiceImpl
+}
+
+// New builds the configured detector, the other providers, the tenant
+// profiles and the services on top of them. Caching, usage, audit and
+// tracing are left to the caller.
+func New(configProvider *config.ConfigProvider, metrics domain.Metrics) (*Stack, error) {
+	cfg := configProvider.GetConfig()
+	detector := adapters.NewDetector(cfg, metrics)
+
+	// Offer pattern-based detection as a provider requests and profiles can choose
+	var otherDetectors []domain.LanguageDetector
+	if _, ok := detector.(*adapters.FallbackAdapter); !ok {
+		otherDetectors = append(otherDetectors, adapters.NewFallbackAdapter().WithMetrics(metrics))
+	}
+
+	service := application.NewLanguageDetectionService(detector, configProvider, otherDetectors...).
+		WithMetrics(metrics)
+	if cfg.FailoverProvider != "" {
+		service.WithFailover(cfg.FailoverProvider)
+		slog.Info("Failover enabled", "provider", cfg.FailoverProvider)
+	}
+
+	// Create language catalog from the providers that can report their languages
+	var providers []domain.LanguageProvider
+	for _, d := range append([]domain.LanguageDetector{detector}, otherDetectors...) {
+		if provider, ok := d.(domain.LanguageProvider); ok {
+			providers = append(providers, provider)
+		}
+	}
+
+	s := &Stack{
+		Detector:  detector,
+		Service:   service,
+		Catalog:   application.NewLanguageCatalogService(configProvider, providers...),
+		Documents: application.NewDocumentDetectionService(extraction.NewExtractor(), service, configProvider),
+		Subtitles: application.NewSubtitleDetectionService(extraction.NewSubtitleParser(), service, configProvider),
+		Raw:       application.NewRawTextDetectionService(charset.NewDetector(), service, configProvider),
+	}
+
+	// Configure each tenant with its own profile
+	if cfg.TenantProfilesFile != "" {
+		profiles, err := config.LoadProfiles(cfg.TenantProfilesFile, configProvider)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tenant profiles: %w", err)
+		}
+		var names []string
+		for _, provider := range providers {
+			names = append(names, provider.ProviderName())
+		}
+		if err := profiles.CheckProviders(names); err != nil {
+			return nil, fmt.Errorf("invalid tenant profiles: %w", err)
+		}
+
+		s.Profiles = profiles
+		s.Service.WithConfigResolver(profiles)
+		s.Catalog.WithConfigResolver(profiles)
+		s.Documents.WithConfigResolver(profiles)
+		s.Subtitles.WithConfigResolver(profiles)
+		s.Raw.WithConfigResolver(profiles)
+		slog.Info("Tenant profiles loaded", "count", profiles.Len(), "file", cfg.TenantProfilesFile)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,69 @@
+package stack
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"language-detection-service/internal/language_detection/domain"
+	"language-detection-service/internal/language_detection/infrastructure/adapters"
+	"language-detection-service/internal/language_detection/infrastructure/config"
+)
+
+func writeProfiles(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write profiles: %v", err)
+	}
+	return path
+}
+
+func TestNew_AppliesProfilesToEveryService(t *testing.T) {
+	t.Setenv("USE_AWS_COMPREHEND", "false")
+	t.Setenv("TENANT_PROFILES_FILE", writeProfiles(t, `{"acme": {"supported_languages": ["de"], "language_codes": {"de-DE": "de"}}}`))
+	configProvider, err := config.LoadConfigProvider("")
+	if err != nil {
+		t.Fatalf("LoadConfigProvider() error = %v, want nil", err)
+	}
+
+	detection, err := New(configProvider, domain.NopMetrics{})
+	if err != nil {
+		t.Fatalf("New() error = %v, want nil", err)
+	}
+
+	if _, ok := detection.Detector.(*adapters.FallbackAdapter); !ok {
+		t.Errorf("Expected the pattern-based detector, got %T", detection.Detector)
+	}
+	if detection.Profiles == nil {
+		t.Fatal("Expected tenant profiles to be loaded")
+	}
+
+	// Subtitle cues go through the text service, which maps the tenant's codes
+	ctx := domain.WithTenant(context.Background(), "acme")
+	resp, err := detection.Subtitles.DetectSubtitleLanguage(ctx, &domain.SubtitleDetectionRequest{
+		Content: []byte("1\n00:00:01,000 --> 00:00:02,000\nder die das und in auf zu\n"),
+		Format:  domain.SubtitleFormatSRT,
+	})
+	if err != nil {
+		t.Fatalf("DetectSubtitleLanguage() error = %v, want nil", err)
+	}
+	if resp.LanguageCode != "de" {
+		t.Errorf("Expected the tenant's code de, got %s", resp.LanguageCode)
+	}
+}
+
+func TestNew_UnknownProfileProvider(t *testing.T) {
+	t.Setenv("USE_AWS_COMPREHEND", "false")
+	t.Setenv("TENANT_PROFILES_FILE", writeProfiles(t, `{"acme": {"provider": "aws-comprehend"}}`))
+	configProvider, err := config.LoadConfigProvider("")
+	if err != nil {
+		t.Fatalf("LoadConfigProvider() error = %v, want nil", err)
+	}
+
+	if _, err := New(configProvider, domain.NopMetrics{}); err == nil {
+		t.Error("Expected an error for a profile naming an unavailable provider, got nil")
+	}
+}
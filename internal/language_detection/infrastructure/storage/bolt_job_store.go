@@ -0,0 +1,269 @@
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// Bucket layout: jobs/<job id>/{meta, documents/<index>, results/<index>}
+var (
+	jobsBucket      = []byte("jobs")
+	metaKey         = []byte("meta")
+	documentsBucket = []byte("documents")
+	resultsBucket   = []byte("results")
+)
+
+// BoltJobStore implements the JobStore interface on top of a bbolt database
+type BoltJobStore struct {
+	db *bolt.DB
+}
+
+// NewBoltJobStore opens (or creates) the job database at path
+func NewBoltJobStore(path string) (*BoltJobStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open job store %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(jobsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialise job store: %w", err)
+	}
+
+	return &BoltJobStore{db: db}, nil
+}
+
+// Close closes the underlying database
+func (s *BoltJobStore) Close() error {
+	return s.db.Close()
+}
+
+// CreateJob stores a new job together with its documents
+func (s *BoltJobStore) CreateJob(ctx context.Context, job *domain.Job, documents []domain.LanguageDetectionRequest) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(jobsBucket).CreateBucket([]byte(job.ID))
+		if err != nil {
+			return fmt.Errorf("failed to create job %s: %w", job.ID, err)
+		}
+
+		if err := putJSON(bucket, metaKey, job); err != nil {
+			return err
+		}
+
+		docs, err := bucket.CreateBucket(documentsBucket)
+		if err != nil {
+			return err
+		}
+		for i := range documents {
+			if err := putJSON(docs, indexKey(i), &documents[i]); err != nil {
+				return err
+			}
+		}
+
+		_, err = bucket.CreateBucket(resultsBucket)
+		return err
+	})
+}
+
+// GetJob returns a job by ID
+func (s *BoltJobStore) GetJob(ctx context.Context, id string) (*domain.Job, error) {
+	var job domain.Job
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket, err := jobBucket(tx, id)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(bucket.Get(metaKey), &job)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// UpdateJobStatus changes the status of a job
+func (s *BoltJobStore) UpdateJobStatus(ctx context.Context, id string, status domain.JobStatus) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := jobBucket(tx, id)
+		if err != nil {
+			return err
+		}
+
+		var job domain.Job
+		if err := json.Unmarshal(bucket.Get(metaKey), &job); err != nil {
+			return err
+		}
+
+		job.Status = status
+		job.UpdatedAt = time.Now().UTC()
+		if status == domain.JobStatusCompleted && job.CompletedAt.IsZero() {
+			job.CompletedAt = job.UpdatedAt
+		}
+
+		return putJSON(bucket, metaKey, &job)
+	})
+}
+
+// ListUnfinishedJobs returns the jobs that are not completed, oldest first
+func (s *BoltJobStore) ListUnfinishedJobs(ctx context.Context) ([]*domain.Job, error) {
+	var jobs []*domain.Job
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(jobsBucket).ForEachBucket(func(id []byte) error {
+			var job domain.Job
+			meta := tx.Bucket(jobsBucket).Bucket(id).Get(metaKey)
+			if err := json.Unmarshal(meta, &job); err != nil {
+				return fmt.Errorf("corrupt job %s: %w", id, err)
+			}
+			if job.Status != domain.JobStatusCompleted {
+				jobs = append(jobs, &job)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAt.Before(jobs[j].CreatedAt)
+	})
+	return jobs, nil
+}
+
+// PendingDocuments returns up to limit documents without a result, starting at index from
+func (s *BoltJobStore) PendingDocuments(ctx context.Context, id string, from int, limit int) ([]domain.JobDocument, error) {
+	var pending []domain.JobDocument
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket, err := jobBucket(tx, id)
+		if err != nil {
+			return err
+		}
+
+		results := bucket.Bucket(resultsBucket)
+		cursor := bucket.Bucket(documentsBucket).Cursor()
+		for k, v := cursor.Seek(indexKey(from)); k != nil && len(pending) < limit; k, v = cursor.Next() {
+			if results.Get(k) != nil {
+				continue
+			}
+
+			doc := domain.JobDocument{Index: int(binary.BigEndian.Uint64(k))}
+			if err := json.Unmarshal(v, &doc.Request); err != nil {
+				return fmt.Errorf("corrupt document %d of job %s: %w", doc.Index, id, err)
+			}
+			pending = append(pending, doc)
+		}
+		return nil
+	})
+	return pending, err
+}
+
+// SaveResult records the result of a document and updates the job counters.
+// Saving a result twice keeps the first one, so retried documents are not double counted.
+func (s *BoltJobStore) SaveResult(ctx context.Context, id string, result domain.JobResult) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := jobBucket(tx, id)
+		if err != nil {
+			return err
+		}
+
+		results := bucket.Bucket(resultsBucket)
+		key := indexKey(result.Index)
+		if results.Get(key) != nil {
+			return nil
+		}
+		if err := putJSON(results, key, &result); err != nil {
+			return err
+		}
+
+		var job domain.Job
+		if err := json.Unmarshal(bucket.Get(metaKey), &job); err != nil {
+			return err
+		}
+
+		job.ProcessedDocuments++
+		if result.Error != "" {
+			job.FailedDocuments++
+		}
+		job.UpdatedAt = time.Now().UTC()
+
+		return putJSON(bucket, metaKey, &job)
+	})
+}
+
+// ListResults returns a page of results ordered by document index.
+// The page token is the index of the first result of the next page.
+func (s *BoltJobStore) ListResults(ctx context.Context, id string, pageToken string, pageSize int) (*domain.JobResultPage, error) {
+	from := 0
+	if pageToken != "" {
+		var err error
+		from, err = strconv.Atoi(pageToken)
+		if err != nil || from < 0 {
+			return nil, fmt.Errorf("%w: invalid page token %q", domain.ErrInvalidRequest, pageToken)
+		}
+	}
+
+	page := &domain.JobResultPage{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket, err := jobBucket(tx, id)
+		if err != nil {
+			return err
+		}
+
+		cursor := bucket.Bucket(resultsBucket).Cursor()
+		for k, v := cursor.Seek(indexKey(from)); k != nil; k, v = cursor.Next() {
+			if len(page.Results) == pageSize {
+				page.NextPageToken = strconv.FormatUint(binary.BigEndian.Uint64(k), 10)
+				break
+			}
+
+			var result domain.JobResult
+			if err := json.Unmarshal(v, &result); err != nil {
+				return fmt.Errorf("corrupt result of job %s: %w", id, err)
+			}
+			page.Results = append(page.Results, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// jobBucket returns the bucket of a job or ErrJobNotFound
+func jobBucket(tx *bolt.Tx, id string) (*bolt.Bucket, error) {
+	bucket := tx.Bucket(jobsBucket).Bucket([]byte(id))
+	if bucket == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrJobNotFound, id)
+	}
+	return bucket, nil
+}
+
+// indexKey encodes a document index so keys sort in index order
+func indexKey(index int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(index))
+	return key
+}
+
+// putJSON stores v as JSON under key
+func putJSON(bucket *bolt.Bucket, key []byte, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(key, data)
+}
@@ -0,0 +1,259 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func newTestJobStore(t *testing.T) (*BoltJobStore, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "jobs.db")
+	store, err := NewBoltJobStore(path)
+	if err != nil {
+		t.Fatalf("NewBoltJobStore() error = %v, want nil", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store, path
+}
+
+func createTestJob(t *testing.T, store *BoltJobStore, id string, documents int) {
+	t.Helper()
+
+	var requests []domain.LanguageDetectionRequest
+	for i := 0; i < documents; i++ {
+		requests = append(requests, domain.LanguageDetectionRequest{
+			Text:       domain.Text(fmt.Sprintf("document %d", i)),
+			DocumentID: fmt.Sprintf("doc-%d", i),
+		})
+	}
+
+	job := &domain.Job{
+		ID:             id,
+		TenantID:       "acme",
+		Status:         domain.JobStatusPending,
+		TotalDocuments: documents,
+		CreatedAt:      time.Now().UTC(),
+	}
+	if err := store.CreateJob(context.Background(), job, requests); err != nil {
+		t.Fatalf("CreateJob() error = %v, want nil", err)
+	}
+}
+
+func TestBoltJobStore_CreateAndGetJob(t *testing.T) {
+	store, _ := newTestJobStore(t)
+	ctx := context.Background()
+
+	createTestJob(t, store, "job-1", 3)
+
+	job, err := store.GetJob(ctx, "job-1")
+	if err != nil {
+		t.Fatalf("GetJob() error = %v, want nil", err)
+	}
+
+	if job.TotalDocuments != 3 {
+		t.Errorf("Expected 3 documents, got %d", job.TotalDocuments)
+	}
+
+	if job.Status != domain.JobStatusPending {
+		t.Errorf("Expected status pending, got %s", job.Status)
+	}
+
+	if job.TenantID != "acme" {
+		t.Errorf("Expected tenant acme, got %q", job.TenantID)
+	}
+
+	// Creating the same job twice fails
+	err = store.CreateJob(ctx, &domain.Job{ID: "job-1"}, nil)
+	if err == nil {
+		t.Error("Expected error for duplicate job, got nil")
+	}
+}
+
+func TestBoltJobStore_GetJob_NotFound(t *testing.T) {
+	store, _ := newTestJobStore(t)
+
+	_, err := store.GetJob(context.Background(), "missing")
+	if !errors.Is(err, domain.ErrJobNotFound) {
+		t.Errorf("Expected ErrJobNotFound, got %v", err)
+	}
+}
+
+func TestBoltJobStore_PendingDocuments(t *testing.T) {
+	store, _ := newTestJobStore(t)
+	ctx := context.Background()
+
+	createTestJob(t, store, "job-1", 5)
+
+	if err := store.SaveResult(ctx, "job-1", domain.JobResult{Index: 1}); err != nil {
+		t.Fatalf("SaveResult() error = %v, want nil", err)
+	}
+
+	pending, err := store.PendingDocuments(ctx, "job-1", 0, 3)
+	if err != nil {
+		t.Fatalf("PendingDocuments() error = %v, want nil", err)
+	}
+
+	// Document 1 already has a result and is skipped
+	expected := []int{0, 2, 3}
+	if len(pending) != len(expected) {
+		t.Fatalf("Expected %d pending documents, got %d", len(expected), len(pending))
+	}
+	for i, index := range expected {
+		if pending[i].Index != index {
+			t.Errorf("Expected document index %d at position %d, got %d", index, i, pending[i].Index)
+		}
+		if pending[i].Request.DocumentID != fmt.Sprintf("doc-%d", index) {
+			t.Errorf("Expected document ID doc-%d, got %s", index, pending[i].Request.DocumentID)
+		}
+	}
+
+	rest, err := store.PendingDocuments(ctx, "job-1", 4, 10)
+	if err != nil {
+		t.Fatalf("PendingDocuments() error = %v, want nil", err)
+	}
+	if len(rest) != 1 || rest[0].Index != 4 {
+		t.Errorf("Expected only document 4, got %v", rest)
+	}
+}
+
+func TestBoltJobStore_SaveResult_UpdatesCounters(t *testing.T) {
+	store, _ := newTestJobStore(t)
+	ctx := context.Background()
+
+	createTestJob(t, store, "job-1", 3)
+
+	results := []domain.JobResult{
+		{Index: 0, Response: &domain.LanguageDetectionResponse{LanguageCode: "en-US"}},
+		{Index: 1, Error: "text cannot be empty"},
+		{Index: 0, Error: "duplicate must be ignored"},
+	}
+	for _, result := range results {
+		if err := store.SaveResult(ctx, "job-1", result); err != nil {
+			t.Fatalf("SaveResult() error = %v, want nil", err)
+		}
+	}
+
+	job, err := store.GetJob(ctx, "job-1")
+	if err != nil {
+		t.Fatalf("GetJob() error = %v, want nil", err)
+	}
+
+	if job.ProcessedDocuments != 2 {
+		t.Errorf("Expected 2 processed documents, got %d", job.ProcessedDocuments)
+	}
+
+	if job.FailedDocuments != 1 {
+		t.Errorf("Expected 1 failed document, got %d", job.FailedDocuments)
+	}
+
+	if job.Done() {
+		t.Error("Expected job not to be done")
+	}
+}
+
+func TestBoltJobStore_ListResults_Paging(t *testing.T) {
+	store, _ := newTestJobStore(t)
+	ctx := context.Background()
+
+	createTestJob(t, store, "job-1", 5)
+	for i := 0; i < 5; i++ {
+		store.SaveResult(ctx, "job-1", domain.JobResult{Index: i, DocumentID: fmt.Sprintf("doc-%d", i)})
+	}
+
+	var all []domain.JobResult
+	token := ""
+	pages := 0
+	for {
+		page, err := store.ListResults(ctx, "job-1", token, 2)
+		if err != nil {
+			t.Fatalf("ListResults() error = %v, want nil", err)
+		}
+		pages++
+		all = append(all, page.Results...)
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+
+	if pages != 3 {
+		t.Errorf("Expected 3 pages, got %d", pages)
+	}
+
+	if len(all) != 5 {
+		t.Fatalf("Expected 5 results, got %d", len(all))
+	}
+
+	for i, result := range all {
+		if result.Index != i {
+			t.Errorf("Expected result index %d, got %d", i, result.Index)
+		}
+	}
+
+	_, err := store.ListResults(ctx, "job-1", "not-a-number", 2)
+	if !errors.Is(err, domain.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for a bad page token, got %v", err)
+	}
+}
+
+func TestBoltJobStore_UpdateStatusAndUnfinished(t *testing.T) {
+	store, _ := newTestJobStore(t)
+	ctx := context.Background()
+
+	createTestJob(t, store, "job-1", 1)
+	createTestJob(t, store, "job-2", 1)
+
+	if err := store.UpdateJobStatus(ctx, "job-1", domain.JobStatusCompleted); err != nil {
+		t.Fatalf("UpdateJobStatus() error = %v, want nil", err)
+	}
+
+	job, _ := store.GetJob(ctx, "job-1")
+	if job.CompletedAt.IsZero() {
+		t.Error("Expected completion time to be set")
+	}
+
+	unfinished, err := store.ListUnfinishedJobs(ctx)
+	if err != nil {
+		t.Fatalf("ListUnfinishedJobs() error = %v, want nil", err)
+	}
+
+	if len(unfinished) != 1 || unfinished[0].ID != "job-2" {
+		t.Errorf("Expected only job-2 to be unfinished, got %v", unfinished)
+	}
+}
+
+func TestBoltJobStore_PersistsAcrossReopen(t *testing.T) {
+	store, path := newTestJobStore(t)
+	ctx := context.Background()
+
+	createTestJob(t, store, "job-1", 2)
+	store.SaveResult(ctx, "job-1", domain.JobResult{Index: 0})
+	store.Close()
+
+	reopened, err := NewBoltJobStore(path)
+	if err != nil {
+		t.Fatalf("NewBoltJobStore() error = %v, want nil", err)
+	}
+	defer reopened.Close()
+
+	job, err := reopened.GetJob(ctx, "job-1")
+	if err != nil {
+		t.Fatalf("GetJob() error = %v, want nil", err)
+	}
+
+	if job.ProcessedDocuments != 1 {
+		t.Errorf("Expected 1 processed document after reopen, got %d", job.ProcessedDocuments)
+	}
+
+	pending, _ := reopened.PendingDocuments(ctx, "job-1", 0, 10)
+	if len(pending) != 1 || pending[0].Index != 1 {
+		t.Errorf("Expected document 1 to still be pending, got %v", pending)
+	}
+}
@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+// Bucket layout: usage/<period>/<tenant id> = Usage, for daily and monthly periods
+var usageBucket = []byte("usage")
+
+// BoltUsageLedger implements the UsageLedger interface on top of a bbolt database
+type BoltUsageLedger struct {
+	db *bolt.DB
+}
+
+// NewBoltUsageLedger opens (or creates) the usage database at path
+func NewBoltUsageLedger(path string) (*BoltUsageLedger, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open usage ledger %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(usageBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialise usage ledger: %w", err)
+	}
+
+	return &BoltUsageLedger{db: db}, nil
+}
+
+// Close closes the underlying database
+func (l *BoltUsageLedger) Close() error {
+	return l.db.Close()
+}
+
+// RecordUsage adds usage to the daily and monthly totals of a tenant at the given time
+func (l *BoltUsageLedger) RecordUsage(ctx context.Context, tenantID string, at time.Time, usage domain.Usage) error {
+	return l.db.Update(func(tx *bolt.Tx) error {
+		for _, period := range []string{domain.DayPeriod(at), domain.MonthPeriod(at)} {
+			bucket, err := tx.Bucket(usageBucket).CreateBucketIfNotExists([]byte(period))
+			if err != nil {
+				return err
+			}
+
+			total, err := getUsage(bucket, tenantID)
+			if err != nil {
+				return err
+			}
+			if err := putJSON(bucket, []byte(tenantID), total.Add(usage)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetUsage returns the usage of a tenant over a period, zero if there is none
+func (l *BoltUsageLedger) GetUsage(ctx context.Context, tenantID string, period string) (domain.Usage, error) {
+	var usage domain.Usage
+	err := l.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(usageBucket).Bucket([]byte(period))
+		if bucket == nil {
+			return nil
+		}
+
+		var err error
+		usage, err = getUsage(bucket, tenantID)
+		return err
+	})
+	return usage, err
+}
+
+// ListUsage returns the usage of every tenant over a period, ordered by tenant
+func (l *BoltUsageLedger) ListUsage(ctx context.Context, period string) ([]domain.TenantUsage, error) {
+	var report []domain.TenantUsage
+	err := l.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(usageBucket).Bucket([]byte(period))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			entry := domain.TenantUsage{TenantID: string(k), Period: period}
+			if err := json.Unmarshal(v, &entry.Usage); err != nil {
+				return fmt.Errorf("corrupt usage of tenant %s in %s: %w", k, period, err)
+			}
+			report = append(report, entry)
+			return nil
+		})
+	})
+	return report, err
+}
+
+// getUsage reads the usage of a tenant from a period bucket
+func getUsage(bucket *bolt.Bucket, tenantID string) (domain.Usage, error) {
+	var usage domain.Usage
+	data := bucket.Get([]byte(tenantID))
+	if data == nil {
+		return usage, nil
+	}
+	if err := json.Unmarshal(data, &usage); err != nil {
+		return usage, fmt.Errorf("corrupt usage of tenant %s: %w", tenantID, err)
+	}
+	return usage, nil
+}
@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"language-detection-service/internal/language_detection/domain"
+)
+
+func newTestUsageLedger(t *testing.T) (*BoltUsageLedger, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "usage.db")
+	ledger, err := NewBoltUsageLedger(path)
+	if err != nil {
+		t.Fatalf("NewBoltUsageLedger() error = %v, want nil", err)
+	}
+	t.Cleanup(func() { ledger.Close() })
+	return ledger, path
+}
+
+func TestBoltUsageLedger_RecordUsage(t *testing.T) {
+	ledger, _ := newTestUsageLedger(t)
+	ctx := context.Background()
+	day1 := time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	usage := domain.Usage{Documents: 1, Characters: 250, BillingUnits: 3}
+
+	for _, at := range []time.Time{day1, day1, day2} {
+		if err := ledger.RecordUsage(ctx, "acme", at, usage); err != nil {
+			t.Fatalf("RecordUsage() error = %v, want nil", err)
+		}
+	}
+	if err := ledger.RecordUsage(ctx, "globex", day1, usage); err != nil {
+		t.Fatalf("RecordUsage() error = %v, want nil", err)
+	}
+
+	daily, err := ledger.GetUsage(ctx, "acme", "2026-04-01")
+	if err != nil {
+		t.Fatalf("GetUsage() error = %v, want nil", err)
+	}
+	if daily != (domain.Usage{Documents: 2, Characters: 500, BillingUnits: 6}) {
+		t.Errorf("Expected 2 documents on the first day, got %+v", daily)
+	}
+
+	monthly, _ := ledger.GetUsage(ctx, "acme", "2026-04")
+	if monthly.Documents != 3 || monthly.BillingUnits != 9 {
+		t.Errorf("Expected 3 documents and 9 units in the month, got %+v", monthly)
+	}
+
+	none, err := ledger.GetUsage(ctx, "acme", "2026-05")
+	if err != nil || none != (domain.Usage{}) {
+		t.Errorf("Expected no usage in another month, got %+v (%v)", none, err)
+	}
+
+	report, err := ledger.ListUsage(ctx, "2026-04")
+	if err != nil {
+		t.Fatalf("ListUsage() error = %v, want nil", err)
+	}
+	if len(report) != 2 || report[0].TenantID != "acme" || report[1].TenantID != "globex" || report[1].Documents != 1 {
+		t.Errorf("Expected acme then globex, got %+v", report)
+	}
+}
+
+func TestBoltUsageLedger_Persists(t *testing.T) {
+	ledger, path := newTestUsageLedger(t)
+	ctx := context.Background()
+	at := time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	if err := ledger.RecordUsage(ctx, "acme", at, domain.Usage{Documents: 1}); err != nil {
+		t.Fatalf("RecordUsage() error = %v, want nil", err)
+	}
+	ledger.Close()
+
+	reopened, err := NewBoltUsageLedger(path)
+	if err != nil {
+		t.Fatalf("NewBoltUsageLedger() error = %v, want nil", err)
+	}
+	defer reopened.Close()
+
+	if usage, _ := reopened.GetUsage(ctx, "acme", "2026-04-01"); usage.Documents != 1 {
+		t.Errorf("Expected the usage to survive a restart, got %+v", usage)
+	}
+}
@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"language-detection-service/internal/language_detection/infrastructure/config"
+)
+
+// serviceName identifies the service in exported spans
+const serviceName = "language-detection-service"
+
+// Tracing exports the spans of the service with the configured exporter
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+	file     io.Closer
+}
+
+// New creates the tracer provider selected by the configuration, exporting
+// to an OTLP/gRPC collector, stdout or a file of one JSON span per line
+func New(ctx context.Context, cfg *config.Config) (*Tracing, error) {
+	t := &Tracing{}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.TracingExporter {
+	case "otlp":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.TracingOTLPEndpoint)}
+		if cfg.TracingOTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case "file":
+		file, openErr := os.OpenFile(cfg.TracingFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if openErr != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", openErr)
+		}
+		t.file = file
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.TracingExporter)
+	}
+	if err != nil {
+		if t.file != nil {
+			t.file.Close()
+		}
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", cfg.TracingExporter, err)
+	}
+
+	t.provider = newProvider(exporter, float64(cfg.TracingSampleRatio), cfg.ServiceVersion)
+	return t, nil
+}
+
+// newProvider batches the sampled spans of the service to exporter
+func newProvider(exporter sdktrace.SpanExporter, sampleRatio float64, version string) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version),
+		)),
+	)
+}
+
+// TracerProvider returns the provider creating the spans of the service
+func (t *Tracing) TracerProvider() trace.TracerProvider {
+	return t.provider
+}
+
+// Shutdown exports the spans still buffered and closes the exporter
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	err := t.provider.Shutdown(ctx)
+	if t.file != nil {
+		err = errors.Join(err, t.file.Close())
+	}
+	return err
+}
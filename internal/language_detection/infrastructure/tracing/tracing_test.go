@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"language-detection-service/internal/language_detection/infrastructure/config"
+)
+
+func TestNew_FileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	tracing, err := New(context.Background(), &config.Config{
+		TracingExporter:    "file",
+		TracingFile:        path,
+		TracingSampleRatio: 1,
+		ServiceVersion:     "2.0.0",
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v, want nil", err)
+	}
+
+	_, span := tracing.TracerProvider().Tracer("test").Start(context.Background(), "detect")
+	span.End()
+
+	if err := tracing.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v, want nil", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read trace file: %v", err)
+	}
+	for _, expected := range []string{`"Name":"detect"`, `"Value":"language-detection-service"`, `"Value":"2.0.0"`} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Expected the trace file to contain %s, got %s", expected, data)
+		}
+	}
+}
+
+func TestNew_Sampling(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	tracing, err := New(context.Background(), &config.Config{
+		TracingExporter:    "file",
+		TracingFile:        path,
+		TracingSampleRatio: 0,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v, want nil", err)
+	}
+	defer tracing.Shutdown(context.Background())
+
+	_, span := tracing.TracerProvider().Tracer("test").Start(context.Background(), "detect")
+	defer span.End()
+
+	if span.SpanContext().IsSampled() {
+		t.Error("Expected the span not to be sampled with a ratio of 0")
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.Config
+	}{
+		{"Unknown exporter", &config.Config{TracingExporter: "jaeger"}},
+		{"Unwritable file", &config.Config{TracingExporter: "file", TracingFile: filepath.Join(t.TempDir(), "missing", "traces.jsonl")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(context.Background(), tt.cfg); err == nil {
+				t.Error("New() error = nil, want an error")
+			}
+		})
+	}
+}
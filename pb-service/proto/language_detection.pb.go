@@ -0,0 +1,2778 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v4.25.1
+// source: language_detection.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DetectLanguageRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Text       string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	DocumentId string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Metadata   map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// explain asks for the evidence behind the detection in the response
+	Explain bool `protobuf:"varint,4,opt,name=explain,proto3" json:"explain,omitempty"`
+	// options tune this detection; unset fields use the service defaults
+	Options       *DetectionOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectLanguageRequest) Reset() {
+	*x = DetectLanguageRequest{}
+	mi := &file_language_detection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectLanguageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectLanguageRequest) ProtoMessage() {}
+
+func (x *DetectLanguageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectLanguageRequest.ProtoReflect.Descriptor instead.
+func (*DetectLanguageRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DetectLanguageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DetectLanguageRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DetectLanguageRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DetectLanguageRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+func (x *DetectLanguageRequest) GetOptions() *DetectionOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type DetectionOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_alternatives limits the alternatives returned; 0 returns all of them
+	MaxAlternatives int32 `protobuf:"varint,1,opt,name=max_alternatives,json=maxAlternatives,proto3" json:"max_alternatives,omitempty"`
+	// min_alternative_score drops alternatives scoring less
+	MinAlternativeScore float32 `protobuf:"fixed32,2,opt,name=min_alternative_score,json=minAlternativeScore,proto3" json:"min_alternative_score,omitempty"`
+	// min_confidence replaces the configured confidence threshold when set
+	MinConfidence float32 `protobuf:"fixed32,3,opt,name=min_confidence,json=minConfidence,proto3" json:"min_confidence,omitempty"`
+	// provider names the preferred provider, e.g. "aws-comprehend" or "fallback"
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// timeout_ms bounds the detection time; 0 applies no extra deadline
+	TimeoutMs     int64 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectionOptions) Reset() {
+	*x = DetectionOptions{}
+	mi := &file_language_detection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectionOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectionOptions) ProtoMessage() {}
+
+func (x *DetectionOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectionOptions.ProtoReflect.Descriptor instead.
+func (*DetectionOptions) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DetectionOptions) GetMaxAlternatives() int32 {
+	if x != nil {
+		return x.MaxAlternatives
+	}
+	return 0
+}
+
+func (x *DetectionOptions) GetMinAlternativeScore() float32 {
+	if x != nil {
+		return x.MinAlternativeScore
+	}
+	return 0
+}
+
+func (x *DetectionOptions) GetMinConfidence() float32 {
+	if x != nil {
+		return x.MinConfidence
+	}
+	return 0
+}
+
+func (x *DetectionOptions) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DetectionOptions) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type DetectLanguageResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	LanguageCode string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Confidence   float32                `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Alternatives []*LanguageAlternative `protobuf:"bytes,3,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	DocumentId   string                 `protobuf:"bytes,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Metadata     *ProcessingMetadata    `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// explanation is set when the request asked for one
+	Explanation   *Explanation `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectLanguageResponse) Reset() {
+	*x = DetectLanguageResponse{}
+	mi := &file_language_detection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectLanguageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectLanguageResponse) ProtoMessage() {}
+
+func (x *DetectLanguageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectLanguageResponse.ProtoReflect.Descriptor instead.
+func (*DetectLanguageResponse) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DetectLanguageResponse) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *DetectLanguageResponse) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *DetectLanguageResponse) GetAlternatives() []*LanguageAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *DetectLanguageResponse) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DetectLanguageResponse) GetMetadata() *ProcessingMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DetectLanguageResponse) GetExplanation() *Explanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+type LanguageAlternative struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LanguageCode  string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Confidence    float32                `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LanguageAlternative) Reset() {
+	*x = LanguageAlternative{}
+	mi := &file_language_detection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LanguageAlternative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageAlternative) ProtoMessage() {}
+
+func (x *LanguageAlternative) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguageAlternative.ProtoReflect.Descriptor instead.
+func (*LanguageAlternative) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LanguageAlternative) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *LanguageAlternative) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type ProcessingMetadata struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProcessingTimeMs int64                  `protobuf:"varint,1,opt,name=processing_time_ms,json=processingTimeMs,proto3" json:"processing_time_ms,omitempty"`
+	ServiceVersion   string                 `protobuf:"bytes,2,opt,name=service_version,json=serviceVersion,proto3" json:"service_version,omitempty"`
+	ModelVersion     string                 `protobuf:"bytes,3,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	Provider         string                 `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// details holds provider-specific information, e.g. the reason for an unknown result
+	Details map[string]string `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// cached is set when the result was served from the result cache
+	Cached        bool `protobuf:"varint,6,opt,name=cached,proto3" json:"cached,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessingMetadata) Reset() {
+	*x = ProcessingMetadata{}
+	mi := &file_language_detection_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessingMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingMetadata) ProtoMessage() {}
+
+func (x *ProcessingMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingMetadata.ProtoReflect.Descriptor instead.
+func (*ProcessingMetadata) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProcessingMetadata) GetProcessingTimeMs() int64 {
+	if x != nil {
+		return x.ProcessingTimeMs
+	}
+	return 0
+}
+
+func (x *ProcessingMetadata) GetServiceVersion() string {
+	if x != nil {
+		return x.ServiceVersion
+	}
+	return ""
+}
+
+func (x *ProcessingMetadata) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+func (x *ProcessingMetadata) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ProcessingMetadata) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ProcessingMetadata) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+type Explanation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// preprocessing lists the steps applied to the text, in order
+	Preprocessing []string `protobuf:"bytes,1,rep,name=preprocessing,proto3" json:"preprocessing,omitempty"`
+	// candidates are ordered by descending score
+	Candidates []*CandidateEvidence `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// raw_output is the provider's own output, usually JSON
+	RawOutput     string `protobuf:"bytes,3,opt,name=raw_output,json=rawOutput,proto3" json:"raw_output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Explanation) Reset() {
+	*x = Explanation{}
+	mi := &file_language_detection_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Explanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation) ProtoMessage() {}
+
+func (x *Explanation) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation.ProtoReflect.Descriptor instead.
+func (*Explanation) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Explanation) GetPreprocessing() []string {
+	if x != nil {
+		return x.Preprocessing
+	}
+	return nil
+}
+
+func (x *Explanation) GetCandidates() []*CandidateEvidence {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *Explanation) GetRawOutput() string {
+	if x != nil {
+		return x.RawOutput
+	}
+	return ""
+}
+
+type CandidateEvidence struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	LanguageCode string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Score        float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// features are the words or n-grams that contributed most, largest first
+	Features      []*FeatureContribution `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CandidateEvidence) Reset() {
+	*x = CandidateEvidence{}
+	mi := &file_language_detection_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CandidateEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateEvidence) ProtoMessage() {}
+
+func (x *CandidateEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidateEvidence.ProtoReflect.Descriptor instead.
+func (*CandidateEvidence) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CandidateEvidence) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *CandidateEvidence) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CandidateEvidence) GetFeatures() []*FeatureContribution {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type FeatureContribution struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Feature string                 `protobuf:"bytes,1,opt,name=feature,proto3" json:"feature,omitempty"`
+	// kind is "word" or "ngram"
+	Kind          string  `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Count         int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Contribution  float64 `protobuf:"fixed64,4,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureContribution) Reset() {
+	*x = FeatureContribution{}
+	mi := &file_language_detection_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureContribution) ProtoMessage() {}
+
+func (x *FeatureContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureContribution.ProtoReflect.Descriptor instead.
+func (*FeatureContribution) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FeatureContribution) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *FeatureContribution) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FeatureContribution) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FeatureContribution) GetContribution() float64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+type StreamDetectLanguageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// session_id groups fragments of the same conversation or document
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// fragment_id is echoed back so clients can correlate responses
+	FragmentId string            `protobuf:"bytes,2,opt,name=fragment_id,json=fragmentId,proto3" json:"fragment_id,omitempty"`
+	Text       string            `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Metadata   map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// include_session_estimate asks for the running session estimate
+	IncludeSessionEstimate bool `protobuf:"varint,5,opt,name=include_session_estimate,json=includeSessionEstimate,proto3" json:"include_session_estimate,omitempty"`
+	// end_of_session releases the session state once this fragment is handled
+	EndOfSession  bool `protobuf:"varint,6,opt,name=end_of_session,json=endOfSession,proto3" json:"end_of_session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDetectLanguageRequest) Reset() {
+	*x = StreamDetectLanguageRequest{}
+	mi := &file_language_detection_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDetectLanguageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDetectLanguageRequest) ProtoMessage() {}
+
+func (x *StreamDetectLanguageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDetectLanguageRequest.ProtoReflect.Descriptor instead.
+func (*StreamDetectLanguageRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamDetectLanguageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StreamDetectLanguageRequest) GetFragmentId() string {
+	if x != nil {
+		return x.FragmentId
+	}
+	return ""
+}
+
+func (x *StreamDetectLanguageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *StreamDetectLanguageRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StreamDetectLanguageRequest) GetIncludeSessionEstimate() bool {
+	if x != nil {
+		return x.IncludeSessionEstimate
+	}
+	return false
+}
+
+func (x *StreamDetectLanguageRequest) GetEndOfSession() bool {
+	if x != nil {
+		return x.EndOfSession
+	}
+	return false
+}
+
+type StreamDetectLanguageResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SessionId  string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	FragmentId string                 `protobuf:"bytes,2,opt,name=fragment_id,json=fragmentId,proto3" json:"fragment_id,omitempty"`
+	// detection is unset when the fragment failed or carried no text
+	Detection       *DetectLanguageResponse `protobuf:"bytes,3,opt,name=detection,proto3" json:"detection,omitempty"`
+	SessionEstimate *SessionEstimate        `protobuf:"bytes,4,opt,name=session_estimate,json=sessionEstimate,proto3" json:"session_estimate,omitempty"`
+	// error describes a fragment failure; the stream itself stays open
+	Error         *StreamError `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDetectLanguageResponse) Reset() {
+	*x = StreamDetectLanguageResponse{}
+	mi := &file_language_detection_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDetectLanguageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDetectLanguageResponse) ProtoMessage() {}
+
+func (x *StreamDetectLanguageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDetectLanguageResponse.ProtoReflect.Descriptor instead.
+func (*StreamDetectLanguageResponse) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StreamDetectLanguageResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StreamDetectLanguageResponse) GetFragmentId() string {
+	if x != nil {
+		return x.FragmentId
+	}
+	return ""
+}
+
+func (x *StreamDetectLanguageResponse) GetDetection() *DetectLanguageResponse {
+	if x != nil {
+		return x.Detection
+	}
+	return nil
+}
+
+func (x *StreamDetectLanguageResponse) GetSessionEstimate() *SessionEstimate {
+	if x != nil {
+		return x.SessionEstimate
+	}
+	return nil
+}
+
+func (x *StreamDetectLanguageResponse) GetError() *StreamError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type SessionEstimate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LanguageCode   string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Confidence     float32                `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Alternatives   []*LanguageAlternative `protobuf:"bytes,3,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	FragmentCount  int32                  `protobuf:"varint,4,opt,name=fragment_count,json=fragmentCount,proto3" json:"fragment_count,omitempty"`
+	CharacterCount int64                  `protobuf:"varint,5,opt,name=character_count,json=characterCount,proto3" json:"character_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SessionEstimate) Reset() {
+	*x = SessionEstimate{}
+	mi := &file_language_detection_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEstimate) ProtoMessage() {}
+
+func (x *SessionEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEstimate.ProtoReflect.Descriptor instead.
+func (*SessionEstimate) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SessionEstimate) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *SessionEstimate) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *SessionEstimate) GetAlternatives() []*LanguageAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *SessionEstimate) GetFragmentCount() int32 {
+	if x != nil {
+		return x.FragmentCount
+	}
+	return 0
+}
+
+func (x *SessionEstimate) GetCharacterCount() int64 {
+	if x != nil {
+		return x.CharacterCount
+	}
+	return 0
+}
+
+type StreamError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code is the numeric gRPC status code of the failure
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// reason is the stable ErrorInfo reason of the failure, e.g. "EMPTY_TEXT"
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamError) Reset() {
+	*x = StreamError{}
+	mi := &file_language_detection_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamError) ProtoMessage() {}
+
+func (x *StreamError) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamError.ProtoReflect.Descriptor instead.
+func (*StreamError) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StreamError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *StreamError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StreamError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListSupportedLanguagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider optionally restricts the list to languages this provider can produce
+	Provider      string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupportedLanguagesRequest) Reset() {
+	*x = ListSupportedLanguagesRequest{}
+	mi := &file_language_detection_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupportedLanguagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportedLanguagesRequest) ProtoMessage() {}
+
+func (x *ListSupportedLanguagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportedLanguagesRequest.ProtoReflect.Descriptor instead.
+func (*ListSupportedLanguagesRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSupportedLanguagesRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type ListSupportedLanguagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Languages     []*LanguageInfo        `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupportedLanguagesResponse) Reset() {
+	*x = ListSupportedLanguagesResponse{}
+	mi := &file_language_detection_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupportedLanguagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportedLanguagesResponse) ProtoMessage() {}
+
+func (x *ListSupportedLanguagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportedLanguagesResponse.ProtoReflect.Descriptor instead.
+func (*ListSupportedLanguagesResponse) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSupportedLanguagesResponse) GetLanguages() []*LanguageInfo {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+type GetLanguageInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LanguageCode  string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLanguageInfoRequest) Reset() {
+	*x = GetLanguageInfoRequest{}
+	mi := &file_language_detection_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLanguageInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLanguageInfoRequest) ProtoMessage() {}
+
+func (x *GetLanguageInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLanguageInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetLanguageInfoRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetLanguageInfoRequest) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+type LanguageInfo struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	LanguageCode string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	EnglishName  string                 `protobuf:"bytes,2,opt,name=english_name,json=englishName,proto3" json:"english_name,omitempty"`
+	NativeName   string                 `protobuf:"bytes,3,opt,name=native_name,json=nativeName,proto3" json:"native_name,omitempty"`
+	// script is the ISO 15924 script code, e.g. "Latn" or "Cyrl"
+	Script string `protobuf:"bytes,4,opt,name=script,proto3" json:"script,omitempty"`
+	// direction is the writing direction, "ltr" or "rtl"
+	Direction string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	// providers lists the configured providers that can produce the language
+	Providers     []string `protobuf:"bytes,6,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LanguageInfo) Reset() {
+	*x = LanguageInfo{}
+	mi := &file_language_detection_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LanguageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageInfo) ProtoMessage() {}
+
+func (x *LanguageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguageInfo.ProtoReflect.Descriptor instead.
+func (*LanguageInfo) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LanguageInfo) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *LanguageInfo) GetEnglishName() string {
+	if x != nil {
+		return x.EnglishName
+	}
+	return ""
+}
+
+func (x *LanguageInfo) GetNativeName() string {
+	if x != nil {
+		return x.NativeName
+	}
+	return ""
+}
+
+func (x *LanguageInfo) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *LanguageInfo) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *LanguageInfo) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type SubmitDetectionJobRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Documents     []*DetectLanguageRequest `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	Metadata      map[string]string        `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDetectionJobRequest) Reset() {
+	*x = SubmitDetectionJobRequest{}
+	mi := &file_language_detection_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDetectionJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDetectionJobRequest) ProtoMessage() {}
+
+func (x *SubmitDetectionJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDetectionJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDetectionJobRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SubmitDetectionJobRequest) GetDocuments() []*DetectLanguageRequest {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *SubmitDetectionJobRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetDetectionJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDetectionJobRequest) Reset() {
+	*x = GetDetectionJobRequest{}
+	mi := &file_language_detection_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDetectionJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDetectionJobRequest) ProtoMessage() {}
+
+func (x *GetDetectionJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDetectionJobRequest.ProtoReflect.Descriptor instead.
+func (*GetDetectionJobRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDetectionJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DetectionJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// status is one of "pending", "running" or "completed"
+	Status             string            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	TotalDocuments     int32             `protobuf:"varint,3,opt,name=total_documents,json=totalDocuments,proto3" json:"total_documents,omitempty"`
+	ProcessedDocuments int32             `protobuf:"varint,4,opt,name=processed_documents,json=processedDocuments,proto3" json:"processed_documents,omitempty"`
+	FailedDocuments    int32             `protobuf:"varint,5,opt,name=failed_documents,json=failedDocuments,proto3" json:"failed_documents,omitempty"`
+	Metadata           map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAtUnixMs    int64             `protobuf:"varint,7,opt,name=created_at_unix_ms,json=createdAtUnixMs,proto3" json:"created_at_unix_ms,omitempty"`
+	UpdatedAtUnixMs    int64             `protobuf:"varint,8,opt,name=updated_at_unix_ms,json=updatedAtUnixMs,proto3" json:"updated_at_unix_ms,omitempty"`
+	// completed_at_unix_ms is zero until the job is completed
+	CompletedAtUnixMs int64 `protobuf:"varint,9,opt,name=completed_at_unix_ms,json=completedAtUnixMs,proto3" json:"completed_at_unix_ms,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DetectionJob) Reset() {
+	*x = DetectionJob{}
+	mi := &file_language_detection_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectionJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectionJob) ProtoMessage() {}
+
+func (x *DetectionJob) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectionJob.ProtoReflect.Descriptor instead.
+func (*DetectionJob) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DetectionJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *DetectionJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DetectionJob) GetTotalDocuments() int32 {
+	if x != nil {
+		return x.TotalDocuments
+	}
+	return 0
+}
+
+func (x *DetectionJob) GetProcessedDocuments() int32 {
+	if x != nil {
+		return x.ProcessedDocuments
+	}
+	return 0
+}
+
+func (x *DetectionJob) GetFailedDocuments() int32 {
+	if x != nil {
+		return x.FailedDocuments
+	}
+	return 0
+}
+
+func (x *DetectionJob) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DetectionJob) GetCreatedAtUnixMs() int64 {
+	if x != nil {
+		return x.CreatedAtUnixMs
+	}
+	return 0
+}
+
+func (x *DetectionJob) GetUpdatedAtUnixMs() int64 {
+	if x != nil {
+		return x.UpdatedAtUnixMs
+	}
+	return 0
+}
+
+func (x *DetectionJob) GetCompletedAtUnixMs() int64 {
+	if x != nil {
+		return x.CompletedAtUnixMs
+	}
+	return 0
+}
+
+type ListDetectionJobResultsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// page_size defaults to 100 and is capped at 1000
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDetectionJobResultsRequest) Reset() {
+	*x = ListDetectionJobResultsRequest{}
+	mi := &file_language_detection_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDetectionJobResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDetectionJobResultsRequest) ProtoMessage() {}
+
+func (x *ListDetectionJobResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDetectionJobResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListDetectionJobResultsRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDetectionJobResultsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListDetectionJobResultsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDetectionJobResultsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDetectionJobResultsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*DetectionJobResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// next_page_token is empty when there are no more results yet
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDetectionJobResultsResponse) Reset() {
+	*x = ListDetectionJobResultsResponse{}
+	mi := &file_language_detection_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDetectionJobResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDetectionJobResultsResponse) ProtoMessage() {}
+
+func (x *ListDetectionJobResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDetectionJobResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListDetectionJobResultsResponse) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDetectionJobResultsResponse) GetResults() []*DetectionJobResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListDetectionJobResultsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DetectionJobResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index is the position of the document in the submission
+	Index      int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	DocumentId string `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// response is unset when the document failed
+	Response      *DetectLanguageResponse `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	Error         string                  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectionJobResult) Reset() {
+	*x = DetectionJobResult{}
+	mi := &file_language_detection_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectionJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectionJobResult) ProtoMessage() {}
+
+func (x *DetectionJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectionJobResult.ProtoReflect.Descriptor instead.
+func (*DetectionJobResult) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DetectionJobResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DetectionJobResult) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DetectionJobResult) GetResponse() *DetectLanguageResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DetectionJobResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DetectDocumentLanguageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectDocumentLanguageRequest) Reset() {
+	*x = DetectDocumentLanguageRequest{}
+	mi := &file_language_detection_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectDocumentLanguageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectDocumentLanguageRequest) ProtoMessage() {}
+
+func (x *DetectDocumentLanguageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectDocumentLanguageRequest.ProtoReflect.Descriptor instead.
+func (*DetectDocumentLanguageRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DetectDocumentLanguageRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DetectDocumentLanguageRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *DetectDocumentLanguageRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DetectDocumentLanguageRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type DetectDocumentLanguageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LanguageCode   string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Confidence     float32                `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Alternatives   []*LanguageAlternative `protobuf:"bytes,3,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	DocumentId     string                 `protobuf:"bytes,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	MimeType       string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	CharacterCount int64                  `protobuf:"varint,6,opt,name=character_count,json=characterCount,proto3" json:"character_count,omitempty"`
+	Sections       []*SectionDetection    `protobuf:"bytes,7,rep,name=sections,proto3" json:"sections,omitempty"`
+	Metadata       *ProcessingMetadata    `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DetectDocumentLanguageResponse) Reset() {
+	*x = DetectDocumentLanguageResponse{}
+	mi := &file_language_detection_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectDocumentLanguageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectDocumentLanguageResponse) ProtoMessage() {}
+
+func (x *DetectDocumentLanguageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectDocumentLanguageResponse.ProtoReflect.Descriptor instead.
+func (*DetectDocumentLanguageResponse) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DetectDocumentLanguageResponse) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *DetectDocumentLanguageResponse) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *DetectDocumentLanguageResponse) GetAlternatives() []*LanguageAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *DetectDocumentLanguageResponse) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DetectDocumentLanguageResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *DetectDocumentLanguageResponse) GetCharacterCount() int64 {
+	if x != nil {
+		return x.CharacterCount
+	}
+	return 0
+}
+
+func (x *DetectDocumentLanguageResponse) GetSections() []*SectionDetection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *DetectDocumentLanguageResponse) GetMetadata() *ProcessingMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type SectionDetection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Index          int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CharacterCount int64                  `protobuf:"varint,3,opt,name=character_count,json=characterCount,proto3" json:"character_count,omitempty"`
+	LanguageCode   string                 `protobuf:"bytes,4,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Confidence     float32                `protobuf:"fixed32,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Alternatives   []*LanguageAlternative `protobuf:"bytes,6,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	// error is set when no part of the section could be detected
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionDetection) Reset() {
+	*x = SectionDetection{}
+	mi := &file_language_detection_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionDetection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionDetection) ProtoMessage() {}
+
+func (x *SectionDetection) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionDetection.ProtoReflect.Descriptor instead.
+func (*SectionDetection) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SectionDetection) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SectionDetection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SectionDetection) GetCharacterCount() int64 {
+	if x != nil {
+		return x.CharacterCount
+	}
+	return 0
+}
+
+func (x *SectionDetection) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *SectionDetection) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *SectionDetection) GetAlternatives() []*LanguageAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *SectionDetection) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DetectSubtitleLanguageRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Content []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// format is "srt" or "vtt"; empty infers it from the content
+	Format  string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	TrackId string `protobuf:"bytes,3,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// declared_language is the language the track is tagged with, if any
+	DeclaredLanguage string            `protobuf:"bytes,4,opt,name=declared_language,json=declaredLanguage,proto3" json:"declared_language,omitempty"`
+	Metadata         map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DetectSubtitleLanguageRequest) Reset() {
+	*x = DetectSubtitleLanguageRequest{}
+	mi := &file_language_detection_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectSubtitleLanguageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectSubtitleLanguageRequest) ProtoMessage() {}
+
+func (x *DetectSubtitleLanguageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectSubtitleLanguageRequest.ProtoReflect.Descriptor instead.
+func (*DetectSubtitleLanguageRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DetectSubtitleLanguageRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DetectSubtitleLanguageRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DetectSubtitleLanguageRequest) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *DetectSubtitleLanguageRequest) GetDeclaredLanguage() string {
+	if x != nil {
+		return x.DeclaredLanguage
+	}
+	return ""
+}
+
+func (x *DetectSubtitleLanguageRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type DetectSubtitleLanguageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LanguageCode     string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Confidence       float32                `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Alternatives     []*LanguageAlternative `protobuf:"bytes,3,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	TrackId          string                 `protobuf:"bytes,4,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Format           string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	CueCount         int32                  `protobuf:"varint,6,opt,name=cue_count,json=cueCount,proto3" json:"cue_count,omitempty"`
+	DetectedCueCount int32                  `protobuf:"varint,7,opt,name=detected_cue_count,json=detectedCueCount,proto3" json:"detected_cue_count,omitempty"`
+	DeclaredLanguage string                 `protobuf:"bytes,8,opt,name=declared_language,json=declaredLanguage,proto3" json:"declared_language,omitempty"`
+	DeclaredMatches  bool                   `protobuf:"varint,9,opt,name=declared_matches,json=declaredMatches,proto3" json:"declared_matches,omitempty"`
+	DisagreeingCues  []*CueDetection        `protobuf:"bytes,10,rep,name=disagreeing_cues,json=disagreeingCues,proto3" json:"disagreeing_cues,omitempty"`
+	Metadata         *ProcessingMetadata    `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DetectSubtitleLanguageResponse) Reset() {
+	*x = DetectSubtitleLanguageResponse{}
+	mi := &file_language_detection_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectSubtitleLanguageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectSubtitleLanguageResponse) ProtoMessage() {}
+
+func (x *DetectSubtitleLanguageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectSubtitleLanguageResponse.ProtoReflect.Descriptor instead.
+func (*DetectSubtitleLanguageResponse) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DetectSubtitleLanguageResponse) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *DetectSubtitleLanguageResponse) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *DetectSubtitleLanguageResponse) GetAlternatives() []*LanguageAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *DetectSubtitleLanguageResponse) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *DetectSubtitleLanguageResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DetectSubtitleLanguageResponse) GetCueCount() int32 {
+	if x != nil {
+		return x.CueCount
+	}
+	return 0
+}
+
+func (x *DetectSubtitleLanguageResponse) GetDetectedCueCount() int32 {
+	if x != nil {
+		return x.DetectedCueCount
+	}
+	return 0
+}
+
+func (x *DetectSubtitleLanguageResponse) GetDeclaredLanguage() string {
+	if x != nil {
+		return x.DeclaredLanguage
+	}
+	return ""
+}
+
+func (x *DetectSubtitleLanguageResponse) GetDeclaredMatches() bool {
+	if x != nil {
+		return x.DeclaredMatches
+	}
+	return false
+}
+
+func (x *DetectSubtitleLanguageResponse) GetDisagreeingCues() []*CueDetection {
+	if x != nil {
+		return x.DisagreeingCues
+	}
+	return nil
+}
+
+func (x *DetectSubtitleLanguageResponse) GetMetadata() *ProcessingMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CueDetection struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Index                int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	StartMs              int64                  `protobuf:"varint,2,opt,name=start_ms,json=startMs,proto3" json:"start_ms,omitempty"`
+	EndMs                int64                  `protobuf:"varint,3,opt,name=end_ms,json=endMs,proto3" json:"end_ms,omitempty"`
+	Text                 string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	LanguageCode         string                 `protobuf:"bytes,5,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Confidence           float32                `protobuf:"fixed32,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	SmoothedLanguageCode string                 `protobuf:"bytes,7,opt,name=smoothed_language_code,json=smoothedLanguageCode,proto3" json:"smoothed_language_code,omitempty"`
+	SmoothedConfidence   float32                `protobuf:"fixed32,8,opt,name=smoothed_confidence,json=smoothedConfidence,proto3" json:"smoothed_confidence,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CueDetection) Reset() {
+	*x = CueDetection{}
+	mi := &file_language_detection_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CueDetection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CueDetection) ProtoMessage() {}
+
+func (x *CueDetection) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CueDetection.ProtoReflect.Descriptor instead.
+func (*CueDetection) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CueDetection) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CueDetection) GetStartMs() int64 {
+	if x != nil {
+		return x.StartMs
+	}
+	return 0
+}
+
+func (x *CueDetection) GetEndMs() int64 {
+	if x != nil {
+		return x.EndMs
+	}
+	return 0
+}
+
+func (x *CueDetection) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CueDetection) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *CueDetection) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *CueDetection) GetSmoothedLanguageCode() string {
+	if x != nil {
+		return x.SmoothedLanguageCode
+	}
+	return ""
+}
+
+func (x *CueDetection) GetSmoothedConfidence() float32 {
+	if x != nil {
+		return x.SmoothedConfidence
+	}
+	return 0
+}
+
+type DetectLanguageRawRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Content []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// charset is the declared character set; empty detects it from the content
+	Charset       string            `protobuf:"bytes,2,opt,name=charset,proto3" json:"charset,omitempty"`
+	DocumentId    string            `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Metadata      map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectLanguageRawRequest) Reset() {
+	*x = DetectLanguageRawRequest{}
+	mi := &file_language_detection_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectLanguageRawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectLanguageRawRequest) ProtoMessage() {}
+
+func (x *DetectLanguageRawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectLanguageRawRequest.ProtoReflect.Descriptor instead.
+func (*DetectLanguageRawRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DetectLanguageRawRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DetectLanguageRawRequest) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+func (x *DetectLanguageRawRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DetectLanguageRawRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type DetectLanguageRawResponse struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Detection         *DetectLanguageResponse `protobuf:"bytes,1,opt,name=detection,proto3" json:"detection,omitempty"`
+	Charset           string                  `protobuf:"bytes,2,opt,name=charset,proto3" json:"charset,omitempty"`
+	CharsetConfidence float32                 `protobuf:"fixed32,3,opt,name=charset_confidence,json=charsetConfidence,proto3" json:"charset_confidence,omitempty"`
+	// charset_matches_language reports whether the charset is typically used for the detected language
+	CharsetMatchesLanguage bool `protobuf:"varint,4,opt,name=charset_matches_language,json=charsetMatchesLanguage,proto3" json:"charset_matches_language,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DetectLanguageRawResponse) Reset() {
+	*x = DetectLanguageRawResponse{}
+	mi := &file_language_detection_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectLanguageRawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectLanguageRawResponse) ProtoMessage() {}
+
+func (x *DetectLanguageRawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectLanguageRawResponse.ProtoReflect.Descriptor instead.
+func (*DetectLanguageRawResponse) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DetectLanguageRawResponse) GetDetection() *DetectLanguageResponse {
+	if x != nil {
+		return x.Detection
+	}
+	return nil
+}
+
+func (x *DetectLanguageRawResponse) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+func (x *DetectLanguageRawResponse) GetCharsetConfidence() float32 {
+	if x != nil {
+		return x.CharsetConfidence
+	}
+	return 0
+}
+
+func (x *DetectLanguageRawResponse) GetCharsetMatchesLanguage() bool {
+	if x != nil {
+		return x.CharsetMatchesLanguage
+	}
+	return false
+}
+
+type GetUsageReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// period is a day (YYYY-MM-DD) or a month (YYYY-MM) in UTC; empty is the current month
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// tenant_id limits the report to one tenant; empty reports every tenant
+	TenantId      string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageReportRequest) Reset() {
+	*x = GetUsageReportRequest{}
+	mi := &file_language_detection_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportRequest) ProtoMessage() {}
+
+func (x *GetUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetUsageReportRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type GetUsageReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usage         []*TenantUsage         `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageReportResponse) Reset() {
+	*x = GetUsageReportResponse{}
+	mi := &file_language_detection_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportResponse) ProtoMessage() {}
+
+func (x *GetUsageReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageReportResponse) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetUsageReportResponse) GetUsage() []*TenantUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type TenantUsage struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TenantId   string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Period     string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Documents  int64                  `protobuf:"varint,3,opt,name=documents,proto3" json:"documents,omitempty"`
+	Characters int64                  `protobuf:"varint,4,opt,name=characters,proto3" json:"characters,omitempty"`
+	// billing_units counts AWS Comprehend units of 100 characters, at least 3 per document
+	BillingUnits  int64 `protobuf:"varint,5,opt,name=billing_units,json=billingUnits,proto3" json:"billing_units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantUsage) Reset() {
+	*x = TenantUsage{}
+	mi := &file_language_detection_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsage) ProtoMessage() {}
+
+func (x *TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_language_detection_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsage.ProtoReflect.Descriptor instead.
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return file_language_detection_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TenantUsage) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantUsage) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *TenantUsage) GetDocuments() int64 {
+	if x != nil {
+		return x.Documents
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetCharacters() int64 {
+	if x != nil {
+		return x.Characters
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetBillingUnits() int64 {
+	if x != nil {
+		return x.BillingUnits
+	}
+	return 0
+}
+
+var File_language_detection_proto protoreflect.FileDescriptor
+
+const file_language_detection_proto_rawDesc = "" +
+	"\n" +
+	"\x18language_detection.proto\x12\x02pb\"\x98\x02\n" +
+	"\x15DetectLanguageRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12C\n" +
+	"\bmetadata\x18\x03 \x03(\v2'.pb.DetectLanguageRequest.MetadataEntryR\bmetadata\x12\x18\n" +
+	"\aexplain\x18\x04 \x01(\bR\aexplain\x12.\n" +
+	"\aoptions\x18\x05 \x01(\v2\x14.pb.DetectionOptionsR\aoptions\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd3\x01\n" +
+	"\x10DetectionOptions\x12)\n" +
+	"\x10max_alternatives\x18\x01 \x01(\x05R\x0fmaxAlternatives\x122\n" +
+	"\x15min_alternative_score\x18\x02 \x01(\x02R\x13minAlternativeScore\x12%\n" +
+	"\x0emin_confidence\x18\x03 \x01(\x02R\rminConfidence\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x05 \x01(\x03R\ttimeoutMs\"\xa2\x02\n" +
+	"\x16DetectLanguageResponse\x12#\n" +
+	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x02R\n" +
+	"confidence\x12;\n" +
+	"\falternatives\x18\x03 \x03(\v2\x17.pb.LanguageAlternativeR\falternatives\x12\x1f\n" +
+	"\vdocument_id\x18\x04 \x01(\tR\n" +
+	"documentId\x122\n" +
+	"\bmetadata\x18\x05 \x01(\v2\x16.pb.ProcessingMetadataR\bmetadata\x121\n" +
+	"\vexplanation\x18\x06 \x01(\v2\x0f.pb.ExplanationR\vexplanation\"Z\n" +
+	"\x13LanguageAlternative\x12#\n" +
+	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x02R\n" +
+	"confidence\"\xbf\x02\n" +
+	"\x12ProcessingMetadata\x12,\n" +
+	"\x12processing_time_ms\x18\x01 \x01(\x03R\x10processingTimeMs\x12'\n" +
+	"\x0fservice_version\x18\x02 \x01(\tR\x0eserviceVersion\x12#\n" +
+	"\rmodel_version\x18\x03 \x01(\tR\fmodelVersion\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12=\n" +
+	"\adetails\x18\x05 \x03(\v2#.pb.ProcessingMetadata.DetailsEntryR\adetails\x12\x16\n" +
+	"\x06cached\x18\x06 \x01(\bR\x06cached\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
+	"\vExplanation\x12$\n" +
+	"\rpreprocessing\x18\x01 \x03(\tR\rpreprocessing\x125\n" +
+	"\n" +
+	"candidates\x18\x02 \x03(\v2\x15.pb.CandidateEvidenceR\n" +
+	"candidates\x12\x1d\n" +
+	"\n" +
+	"raw_output\x18\x03 \x01(\tR\trawOutput\"\x83\x01\n" +
+	"\x11CandidateEvidence\x12#\n" +
+	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x123\n" +
+	"\bfeatures\x18\x03 \x03(\v2\x17.pb.FeatureContributionR\bfeatures\"}\n" +
+	"\x13FeatureContribution\x12\x18\n" +
+	"\afeature\x18\x01 \x01(\tR\afeature\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\"\n" +
+	"\fcontribution\x18\x04 \x01(\x01R\fcontribution\"\xd9\x02\n" +
+	"\x1bStreamDetectLanguageRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vfragment_id\x18\x02 \x01(\tR\n" +
+	"fragmentId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12I\n" +
+	"\bmetadata\x18\x04 \x03(\v2-.pb.StreamDetectLanguageRequest.MetadataEntryR\bmetadata\x128\n" +
+	"\x18include_session_estimate\x18\x05 \x01(\bR\x16includeSessionEstimate\x12$\n" +
+	"\x0eend_of_session\x18\x06 \x01(\bR\fendOfSession\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xff\x01\n" +
+	"\x1cStreamDetectLanguageResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vfragment_id\x18\x02 \x01(\tR\n" +
+	"fragmentId\x128\n" +
+	"\tdetection\x18\x03 \x01(\v2\x1a.pb.DetectLanguageResponseR\tdetection\x12>\n" +
+	"\x10session_estimate\x18\x04 \x01(\v2\x13.pb.SessionEstimateR\x0fsessionEstimate\x12%\n" +
+	"\x05error\x18\x05 \x01(\v2\x0f.pb.StreamErrorR\x05error\"\xe3\x01\n" +
+	"\x0fSessionEstimate\x12#\n" +
+	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x02R\n" +
+	"confidence\x12;\n" +
+	"\falternatives\x18\x03 \x03(\v2\x17.pb.LanguageAlternativeR\falternatives\x12%\n" +
+	"\x0efragment_count\x18\x04 \x01(\x05R\rfragmentCount\x12'\n" +
+	"\x0fcharacter_count\x18\x05 \x01(\x03R\x0echaracterCount\"S\n" +
+	"\vStreamError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\";\n" +
+	"\x1dListSupportedLanguagesRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"P\n" +
+	"\x1eListSupportedLanguagesResponse\x12.\n" +
+	"\tlanguages\x18\x01 \x03(\v2\x10.pb.LanguageInfoR\tlanguages\"=\n" +
+	"\x16GetLanguageInfoRequest\x12#\n" +
+	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\"\xcb\x01\n" +
+	"\fLanguageInfo\x12#\n" +
+	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12!\n" +
+	"\fenglish_name\x18\x02 \x01(\tR\venglishName\x12\x1f\n" +
+	"\vnative_name\x18\x03 \x01(\tR\n" +
+	"nativeName\x12\x16\n" +
+	"\x06script\x18\x04 \x01(\tR\x06script\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12\x1c\n" +
+	"\tproviders\x18\x06 \x03(\tR\tproviders\"\xda\x01\n" +
+	"\x19SubmitDetectionJobRequest\x127\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x19.pb.DetectLanguageRequestR\tdocuments\x12G\n" +
+	"\bmetadata\x18\x02 \x03(\v2+.pb.SubmitDetectionJobRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"/\n" +
+	"\x16GetDetectionJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xc6\x03\n" +
+	"\fDetectionJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0ftotal_documents\x18\x03 \x01(\x05R\x0etotalDocuments\x12/\n" +
+	"\x13processed_documents\x18\x04 \x01(\x05R\x12processedDocuments\x12)\n" +
+	"\x10failed_documents\x18\x05 \x01(\x05R\x0ffailedDocuments\x12:\n" +
+	"\bmetadata\x18\x06 \x03(\v2\x1e.pb.DetectionJob.MetadataEntryR\bmetadata\x12+\n" +
+	"\x12created_at_unix_ms\x18\a \x01(\x03R\x0fcreatedAtUnixMs\x12+\n" +
+	"\x12updated_at_unix_ms\x18\b \x01(\x03R\x0fupdatedAtUnixMs\x12/\n" +
+	"\x14completed_at_unix_ms\x18\t \x01(\x03R\x11completedAtUnixMs\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"s\n" +
+	"\x1eListDetectionJobResultsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"{\n" +
+	"\x1fListDetectionJobResultsResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.pb.DetectionJobResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x99\x01\n" +
+	"\x12DetectionJobResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x126\n" +
+	"\bresponse\x18\x03 \x01(\v2\x1a.pb.DetectLanguageResponseR\bresponse\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x81\x02\n" +
+	"\x1dDetectDocumentLanguageRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x1f\n" +
+	"\vdocument_id\x18\x03 \x01(\tR\n" +
+	"documentId\x12K\n" +
+	"\bmetadata\x18\x04 \x03(\v2/.pb.DetectDocumentLanguageRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xef\x02\n" +
+	"\x1eDetectDocumentLanguageResponse\x12#\n" +
+	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x02R\n" +
+	"confidence\x12;\n" +
+	"\falternatives\x18\x03 \x03(\v2\x17.pb.LanguageAlternativeR\falternatives\x12\x1f\n" +
+	"\vdocument_id\x18\x04 \x01(\tR\n" +
+	"documentId\x12\x1b\n" +
+	"\tmime_type\x18\x05 \x01(\tR\bmimeType\x12'\n" +
+	"\x0fcharacter_count\x18\x06 \x01(\x03R\x0echaracterCount\x120\n" +
+	"\bsections\x18\a \x03(\v2\x14.pb.SectionDetectionR\bsections\x122\n" +
+	"\bmetadata\x18\b \x01(\v2\x16.pb.ProcessingMetadataR\bmetadata\"\xfd\x01\n" +
+	"\x10SectionDetection\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x0fcharacter_count\x18\x03 \x01(\x03R\x0echaracterCount\x12#\n" +
+	"\rlanguage_code\x18\x04 \x01(\tR\flanguageCode\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\x02R\n" +
+	"confidence\x12;\n" +
+	"\falternatives\x18\x06 \x03(\v2\x17.pb.LanguageAlternativeR\falternatives\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xa3\x02\n" +
+	"\x1dDetectSubtitleLanguageRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x19\n" +
+	"\btrack_id\x18\x03 \x01(\tR\atrackId\x12+\n" +
+	"\x11declared_language\x18\x04 \x01(\tR\x10declaredLanguage\x12K\n" +
+	"\bmetadata\x18\x05 \x03(\v2/.pb.DetectSubtitleLanguageRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe9\x03\n" +
+	"\x1eDetectSubtitleLanguageResponse\x12#\n" +
+	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x02R\n" +
+	"confidence\x12;\n" +
+	"\falternatives\x18\x03 \x03(\v2\x17.pb.LanguageAlternativeR\falternatives\x12\x19\n" +
+	"\btrack_id\x18\x04 \x01(\tR\atrackId\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1b\n" +
+	"\tcue_count\x18\x06 \x01(\x05R\bcueCount\x12,\n" +
+	"\x12detected_cue_count\x18\a \x01(\x05R\x10detectedCueCount\x12+\n" +
+	"\x11declared_language\x18\b \x01(\tR\x10declaredLanguage\x12)\n" +
+	"\x10declared_matches\x18\t \x01(\bR\x0fdeclaredMatches\x12;\n" +
+	"\x10disagreeing_cues\x18\n" +
+	" \x03(\v2\x10.pb.CueDetectionR\x0fdisagreeingCues\x122\n" +
+	"\bmetadata\x18\v \x01(\v2\x16.pb.ProcessingMetadataR\bmetadata\"\x96\x02\n" +
+	"\fCueDetection\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x19\n" +
+	"\bstart_ms\x18\x02 \x01(\x03R\astartMs\x12\x15\n" +
+	"\x06end_ms\x18\x03 \x01(\x03R\x05endMs\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12#\n" +
+	"\rlanguage_code\x18\x05 \x01(\tR\flanguageCode\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x06 \x01(\x02R\n" +
+	"confidence\x124\n" +
+	"\x16smoothed_language_code\x18\a \x01(\tR\x14smoothedLanguageCode\x12/\n" +
+	"\x13smoothed_confidence\x18\b \x01(\x02R\x12smoothedConfidence\"\xf4\x01\n" +
+	"\x18DetectLanguageRawRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x18\n" +
+	"\acharset\x18\x02 \x01(\tR\acharset\x12\x1f\n" +
+	"\vdocument_id\x18\x03 \x01(\tR\n" +
+	"documentId\x12F\n" +
+	"\bmetadata\x18\x04 \x03(\v2*.pb.DetectLanguageRawRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd8\x01\n" +
+	"\x19DetectLanguageRawResponse\x128\n" +
+	"\tdetection\x18\x01 \x01(\v2\x1a.pb.DetectLanguageResponseR\tdetection\x12\x18\n" +
+	"\acharset\x18\x02 \x01(\tR\acharset\x12-\n" +
+	"\x12charset_confidence\x18\x03 \x01(\x02R\x11charsetConfidence\x128\n" +
+	"\x18charset_matches_language\x18\x04 \x01(\bR\x16charsetMatchesLanguage\"L\n" +
+	"\x15GetUsageReportRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"?\n" +
+	"\x16GetUsageReportResponse\x12%\n" +
+	"\x05usage\x18\x01 \x03(\v2\x0f.pb.TenantUsageR\x05usage\"\xa5\x01\n" +
+	"\vTenantUsage\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x1c\n" +
+	"\tdocuments\x18\x03 \x01(\x03R\tdocuments\x12\x1e\n" +
+	"\n" +
+	"characters\x18\x04 \x01(\x03R\n" +
+	"characters\x12#\n" +
+	"\rbilling_units\x18\x05 \x01(\x03R\fbillingUnits2\xad\a\n" +
+	"\x18LanguageDetectionService\x12G\n" +
+	"\x0eDetectLanguage\x12\x19.pb.DetectLanguageRequest\x1a\x1a.pb.DetectLanguageResponse\x12]\n" +
+	"\x14DetectLanguageStream\x12\x1f.pb.StreamDetectLanguageRequest\x1a .pb.StreamDetectLanguageResponse(\x010\x01\x12_\n" +
+	"\x16ListSupportedLanguages\x12!.pb.ListSupportedLanguagesRequest\x1a\".pb.ListSupportedLanguagesResponse\x12?\n" +
+	"\x0fGetLanguageInfo\x12\x1a.pb.GetLanguageInfoRequest\x1a\x10.pb.LanguageInfo\x12E\n" +
+	"\x12SubmitDetectionJob\x12\x1d.pb.SubmitDetectionJobRequest\x1a\x10.pb.DetectionJob\x12?\n" +
+	"\x0fGetDetectionJob\x12\x1a.pb.GetDetectionJobRequest\x1a\x10.pb.DetectionJob\x12b\n" +
+	"\x17ListDetectionJobResults\x12\".pb.ListDetectionJobResultsRequest\x1a#.pb.ListDetectionJobResultsResponse\x12_\n" +
+	"\x16DetectDocumentLanguage\x12!.pb.DetectDocumentLanguageRequest\x1a\".pb.DetectDocumentLanguageResponse\x12_\n" +
+	"\x16DetectSubtitleLanguage\x12!.pb.DetectSubtitleLanguageRequest\x1a\".pb.DetectSubtitleLanguageResponse\x12P\n" +
+	"\x11DetectLanguageRaw\x12\x1c.pb.DetectLanguageRawRequest\x1a\x1d.pb.DetectLanguageRawResponse\x12G\n" +
+	"\x0eGetUsageReport\x12\x19.pb.GetUsageReportRequest\x1a\x1a.pb.GetUsageReportResponseB0Z.language-detection-service/pb-service/proto;pbb\x06proto3"
+
+var (
+	file_language_detection_proto_rawDescOnce sync.Once
+	file_language_detection_proto_rawDescData []byte
+)
+
+func file_language_detection_proto_rawDescGZIP() []byte {
+	file_language_detection_proto_rawDescOnce.Do(func() {
+		file_language_detection_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_language_detection_proto_rawDesc), len(file_language_detection_proto_rawDesc)))
+	})
+	return file_language_detection_proto_rawDescData
+}
+
+var file_language_detection_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_language_detection_proto_goTypes = []any{
+	(*DetectLanguageRequest)(nil),           // 0: pb.DetectLanguageRequest
+	(*DetectionOptions)(nil),                // 1: pb.DetectionOptions
+	(*DetectLanguageResponse)(nil),          // 2: pb.DetectLanguageResponse
+	(*LanguageAlternative)(nil),             // 3: pb.LanguageAlternative
+	(*ProcessingMetadata)(nil),              // 4: pb.ProcessingMetadata
+	(*Explanation)(nil),                     // 5: pb.Explanation
+	(*CandidateEvidence)(nil),               // 6: pb.CandidateEvidence
+	(*FeatureContribution)(nil),             // 7: pb.FeatureContribution
+	(*StreamDetectLanguageRequest)(nil),     // 8: pb.StreamDetectLanguageRequest
+	(*StreamDetectLanguageResponse)(nil),    // 9: pb.StreamDetectLanguageResponse
+	(*SessionEstimate)(nil),                 // 10: pb.SessionEstimate
+	(*StreamError)(nil),                     // 11: pb.StreamError
+	(*ListSupportedLanguagesRequest)(nil),   // 12: pb.ListSupportedLanguagesRequest
+	(*ListSupportedLanguagesResponse)(nil),  // 13: pb.ListSupportedLanguagesResponse
+	(*GetLanguageInfoRequest)(nil),          // 14: pb.GetLanguageInfoRequest
+	(*LanguageInfo)(nil),                    // 15: pb.LanguageInfo
+	(*SubmitDetectionJobRequest)(nil),       // 16: pb.SubmitDetectionJobRequest
+	(*GetDetectionJobRequest)(nil),          // 17: pb.GetDetectionJobRequest
+	(*DetectionJob)(nil),                    // 18: pb.DetectionJob
+	(*ListDetectionJobResultsRequest)(nil),  // 19: pb.ListDetectionJobResultsRequest
+	(*ListDetectionJobResultsResponse)(nil), // 20: pb.ListDetectionJobResultsResponse
+	(*DetectionJobResult)(nil),              // 21: pb.DetectionJobResult
+	(*DetectDocumentLanguageRequest)(nil),   // 22: pb.DetectDocumentLanguageRequest
+	(*DetectDocumentLanguageResponse)(nil),  // 23: pb.DetectDocumentLanguageResponse
+	(*SectionDetection)(nil),                // 24: pb.SectionDetection
+	(*DetectSubtitleLanguageRequest)(nil),   // 25: pb.DetectSubtitleLanguageRequest
+	(*DetectSubtitleLanguageResponse)(nil),  // 26: pb.DetectSubtitleLanguageResponse
+	(*CueDetection)(nil),                    // 27: pb.CueDetection
+	(*DetectLanguageRawRequest)(nil),        // 28: pb.DetectLanguageRawRequest
+	(*DetectLanguageRawResponse)(nil),       // 29: pb.DetectLanguageRawResponse
+	(*GetUsageReportRequest)(nil),           // 30: pb.GetUsageReportRequest
+	(*GetUsageReportResponse)(nil),          // 31: pb.GetUsageReportResponse
+	(*TenantUsage)(nil),                     // 32: pb.TenantUsage
+	nil,                                     // 33: pb.DetectLanguageRequest.MetadataEntry
+	nil,                                     // 34: pb.ProcessingMetadata.DetailsEntry
+	nil,                                     // 35: pb.StreamDetectLanguageRequest.MetadataEntry
+	nil,                                     // 36: pb.SubmitDetectionJobRequest.MetadataEntry
+	nil,                                     // 37: pb.DetectionJob.MetadataEntry
+	nil,                                     // 38: pb.DetectDocumentLanguageRequest.MetadataEntry
+	nil,                                     // 39: pb.DetectSubtitleLanguageRequest.MetadataEntry
+	nil,                                     // 40: pb.DetectLanguageRawRequest.MetadataEntry
+}
+var file_language_detection_proto_depIdxs = []int32{
+	33, // 0: pb.DetectLanguageRequest.metadata:type_name -> pb.DetectLanguageRequest.MetadataEntry
+	1,  // 1: pb.DetectLanguageRequest.options:type_name -> pb.DetectionOptions
+	3,  // 2: pb.DetectLanguageResponse.alternatives:type_name -> pb.LanguageAlternative
+	4,  // 3: pb.DetectLanguageResponse.metadata:type_name -> pb.ProcessingMetadata
+	5,  // 4: pb.DetectLanguageResponse.explanation:type_name -> pb.Explanation
+	34, // 5: pb.ProcessingMetadata.details:type_name -> pb.ProcessingMetadata.DetailsEntry
+	6,  // 6: pb.Explanation.candidates:type_name -> pb.CandidateEvidence
+	7,  // 7: pb.CandidateEvidence.features:type_name -> pb.FeatureContribution
+	35, // 8: pb.StreamDetectLanguageRequest.metadata:type_name -> pb.StreamDetectLanguageRequest.MetadataEntry
+	2,  // 9: pb.StreamDetectLanguageResponse.detection:type_name -> pb.DetectLanguageResponse
+	10, // 10: pb.StreamDetectLanguageResponse.session_estimate:type_name -> pb.SessionEstimate
+	11, // 11: pb.StreamDetectLanguageResponse.error:type_name -> pb.StreamError
+	3,  // 12: pb.SessionEstimate.alternatives:type_name -> pb.LanguageAlternative
+	15, // 13: pb.ListSupportedLanguagesResponse.languages:type_name -> pb.LanguageInfo
+	0,  // 14: pb.SubmitDetectionJobRequest.documents:type_name -> pb.DetectLanguageRequest
+	36, // 15: pb.SubmitDetectionJobRequest.metadata:type_name -> pb.SubmitDetectionJobRequest.MetadataEntry
+	37, // 16: pb.DetectionJob.metadata:type_name -> pb.DetectionJob.MetadataEntry
+	21, // 17: pb.ListDetectionJobResultsResponse.results:type_name -> pb.DetectionJobResult
+	2,  // 18: pb.DetectionJobResult.response:type_name -> pb.DetectLanguageResponse
+	38, // 19: pb.DetectDocumentLanguageRequest.metadata:type_name -> pb.DetectDocumentLanguageRequest.MetadataEntry
+	3,  // 20: pb.DetectDocumentLanguageResponse.alternatives:type_name -> pb.LanguageAlternative
+	24, // 21: pb.DetectDocumentLanguageResponse.sections:type_name -> pb.SectionDetection
+	4,  // 22: pb.DetectDocumentLanguageResponse.metadata:type_name -> pb.ProcessingMetadata
+	3,  // 23: pb.SectionDetection.alternatives:type_name -> pb.LanguageAlternative
+	39, // 24: pb.DetectSubtitleLanguageRequest.metadata:type_name -> pb.DetectSubtitleLanguageRequest.MetadataEntry
+	3,  // 25: pb.DetectSubtitleLanguageResponse.alternatives:type_name -> pb.LanguageAlternative
+	27, // 26: pb.DetectSubtitleLanguageResponse.disagreeing_cues:type_name -> pb.CueDetection
+	4,  // 27: pb.DetectSubtitleLanguageResponse.metadata:type_name -> pb.ProcessingMetadata
+	40, // 28: pb.DetectLanguageRawRequest.metadata:type_name -> pb.DetectLanguageRawRequest.MetadataEntry
+	2,  // 29: pb.DetectLanguageRawResponse.detection:type_name -> pb.DetectLanguageResponse
+	32, // 30: pb.GetUsageReportResponse.usage:type_name -> pb.TenantUsage
+	0,  // 31: pb.LanguageDetectionService.DetectLanguage:input_type -> pb.DetectLanguageRequest
+	8,  // 32: pb.LanguageDetectionService.DetectLanguageStream:input_type -> pb.StreamDetectLanguageRequest
+	12, // 33: pb.LanguageDetectionService.ListSupportedLanguages:input_type -> pb.ListSupportedLanguagesRequest
+	14, // 34: pb.LanguageDetectionService.GetLanguageInfo:input_type -> pb.GetLanguageInfoRequest
+	16, // 35: pb.LanguageDetectionService.SubmitDetectionJob:input_type -> pb.SubmitDetectionJobRequest
+	17, // 36: pb.LanguageDetectionService.GetDetectionJob:input_type -> pb.GetDetectionJobRequest
+	19, // 37: pb.LanguageDetectionService.ListDetectionJobResults:input_type -> pb.ListDetectionJobResultsRequest
+	22, // 38: pb.LanguageDetectionService.DetectDocumentLanguage:input_type -> pb.DetectDocumentLanguageRequest
+	25, // 39: pb.LanguageDetectionService.DetectSubtitleLanguage:input_type -> pb.DetectSubtitleLanguageRequest
+	28, // 40: pb.LanguageDetectionService.DetectLanguageRaw:input_type -> pb.DetectLanguageRawRequest
+	30, // 41: pb.LanguageDetectionService.GetUsageReport:input_type -> pb.GetUsageReportRequest
+	2,  // 42: pb.LanguageDetectionService.DetectLanguage:output_type -> pb.DetectLanguageResponse
+	9,  // 43: pb.LanguageDetectionService.DetectLanguageStream:output_type -> pb.StreamDetectLanguageResponse
+	13, // 44: pb.LanguageDetectionService.ListSupportedLanguages:output_type -> pb.ListSupportedLanguagesResponse
+	15, // 45: pb.LanguageDetectionService.GetLanguageInfo:output_type -> pb.LanguageInfo
+	18, // 46: pb.LanguageDetectionService.SubmitDetectionJob:output_type -> pb.DetectionJob
+	18, // 47: pb.LanguageDetectionService.GetDetectionJob:output_type -> pb.DetectionJob
+	20, // 48: pb.LanguageDetectionService.ListDetectionJobResults:output_type -> pb.ListDetectionJobResultsResponse
+	23, // 49: pb.LanguageDetectionService.DetectDocumentLanguage:output_type -> pb.DetectDocumentLanguageResponse
+	26, // 50: pb.LanguageDetectionService.DetectSubtitleLanguage:output_type -> pb.DetectSubtitleLanguageResponse
+	29, // 51: pb.LanguageDetectionService.DetectLanguageRaw:output_type -> pb.DetectLanguageRawResponse
+	31, // 52: pb.LanguageDetectionService.GetUsageReport:output_type -> pb.GetUsageReportResponse
+	42, // [42:53] is the sub-list for method output_type
+	31, // [31:42] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_language_detection_proto_init() }
+func file_language_detection_proto_init() {
+	if File_language_detection_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_language_detection_proto_rawDesc), len(file_language_detection_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_language_detection_proto_goTypes,
+		DependencyIndexes: file_language_detection_proto_depIdxs,
+		MessageInfos:      file_language_detection_proto_msgTypes,
+	}.Build()
+	File_language_detection_proto = out.File
+	file_language_detection_proto_goTypes = nil
+	file_language_detection_proto_depIdxs = nil
+}
@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.1
+// source: language_detection.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LanguageDetectionService_DetectLanguage_FullMethodName          = "/pb.LanguageDetectionService/DetectLanguage"
+	LanguageDetectionService_DetectLanguageStream_FullMethodName    = "/pb.LanguageDetectionService/DetectLanguageStream"
+	LanguageDetectionService_ListSupportedLanguages_FullMethodName  = "/pb.LanguageDetectionService/ListSupportedLanguages"
+	LanguageDetectionService_GetLanguageInfo_FullMethodName         = "/pb.LanguageDetectionService/GetLanguageInfo"
+	LanguageDetectionService_SubmitDetectionJob_FullMethodName      = "/pb.LanguageDetectionService/SubmitDetectionJob"
+	LanguageDetectionService_GetDetectionJob_FullMethodName         = "/pb.LanguageDetectionService/GetDetectionJob"
+	LanguageDetectionService_ListDetectionJobResults_FullMethodName = "/pb.LanguageDetectionService/ListDetectionJobResults"
+	LanguageDetectionService_DetectDocumentLanguage_FullMethodName  = "/pb.LanguageDetectionService/DetectDocumentLanguage"
+	LanguageDetectionService_DetectSubtitleLanguage_FullMethodName  = "/pb.LanguageDetectionService/DetectSubtitleLanguage"
+	LanguageDetectionService_DetectLanguageRaw_FullMethodName       = "/pb.LanguageDetectionService/DetectLanguageRaw"
+	LanguageDetectionService_GetUsageReport_FullMethodName          = "/pb.LanguageDetectionService/GetUsageReport"
+)
+
+// LanguageDetectionServiceClient is the client API for LanguageDetectionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LanguageDetectionService provides language detection capabilities
+type LanguageDetectionServiceClient interface {
+	DetectLanguage(ctx context.Context, in *DetectLanguageRequest, opts ...grpc.CallOption) (*DetectLanguageResponse, error)
+	// DetectLanguageStream detects the language of a continuous flow of text
+	// fragments. Every fragment is answered with its own detection and, on
+	// request, a running estimate for the fragment's session.
+	DetectLanguageStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamDetectLanguageRequest, StreamDetectLanguageResponse], error)
+	// ListSupportedLanguages returns the languages the running instance can return
+	ListSupportedLanguages(ctx context.Context, in *ListSupportedLanguagesRequest, opts ...grpc.CallOption) (*ListSupportedLanguagesResponse, error)
+	// GetLanguageInfo returns the description of a single supported language
+	GetLanguageInfo(ctx context.Context, in *GetLanguageInfoRequest, opts ...grpc.CallOption) (*LanguageInfo, error)
+	// SubmitDetectionJob queues a large document set for asynchronous detection
+	SubmitDetectionJob(ctx context.Context, in *SubmitDetectionJobRequest, opts ...grpc.CallOption) (*DetectionJob, error)
+	// GetDetectionJob reports the progress of a detection job
+	GetDetectionJob(ctx context.Context, in *GetDetectionJobRequest, opts ...grpc.CallOption) (*DetectionJob, error)
+	// ListDetectionJobResults returns a page of detection job results
+	ListDetectionJobResults(ctx context.Context, in *ListDetectionJobResultsRequest, opts ...grpc.CallOption) (*ListDetectionJobResultsResponse, error)
+	// DetectDocumentLanguage extracts the text of a file (plain text, DOCX, ODT
+	// or EPUB) and detects the language of each section and of the whole file
+	DetectDocumentLanguage(ctx context.Context, in *DetectDocumentLanguageRequest, opts ...grpc.CallOption) (*DetectDocumentLanguageResponse, error)
+	// DetectSubtitleLanguage detects every cue of an SRT or WebVTT track and
+	// returns the track's dominant language with the cues that disagree
+	DetectSubtitleLanguage(ctx context.Context, in *DetectSubtitleLanguageRequest, opts ...grpc.CallOption) (*DetectSubtitleLanguageResponse, error)
+	// DetectLanguageRaw detects the charset of legacy-encoded bytes (for example
+	// Windows-1251 or Shift-JIS), decodes them to UTF-8 and detects the language
+	DetectLanguageRaw(ctx context.Context, in *DetectLanguageRawRequest, opts ...grpc.CallOption) (*DetectLanguageRawResponse, error)
+	// GetUsageReport returns the documents, characters and billing units detected
+	// per tenant over a day or a month, for billing
+	GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportResponse, error)
+}
+
+type languageDetectionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLanguageDetectionServiceClient(cc grpc.ClientConnInterface) LanguageDetectionServiceClient {
+	return &languageDetectionServiceClient{cc}
+}
+
+func (c *languageDetectionServiceClient) DetectLanguage(ctx context.Context, in *DetectLanguageRequest, opts ...grpc.CallOption) (*DetectLanguageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectLanguageResponse)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_DetectLanguage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) DetectLanguageStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamDetectLanguageRequest, StreamDetectLanguageResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LanguageDetectionService_ServiceDesc.Streams[0], LanguageDetectionService_DetectLanguageStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamDetectLanguageRequest, StreamDetectLanguageResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LanguageDetectionService_DetectLanguageStreamClient = grpc.BidiStreamingClient[StreamDetectLanguageRequest, StreamDetectLanguageResponse]
+
+func (c *languageDetectionServiceClient) ListSupportedLanguages(ctx context.Context, in *ListSupportedLanguagesRequest, opts ...grpc.CallOption) (*ListSupportedLanguagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSupportedLanguagesResponse)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_ListSupportedLanguages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) GetLanguageInfo(ctx context.Context, in *GetLanguageInfoRequest, opts ...grpc.CallOption) (*LanguageInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LanguageInfo)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_GetLanguageInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) SubmitDetectionJob(ctx context.Context, in *SubmitDetectionJobRequest, opts ...grpc.CallOption) (*DetectionJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectionJob)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_SubmitDetectionJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) GetDetectionJob(ctx context.Context, in *GetDetectionJobRequest, opts ...grpc.CallOption) (*DetectionJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectionJob)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_GetDetectionJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) ListDetectionJobResults(ctx context.Context, in *ListDetectionJobResultsRequest, opts ...grpc.CallOption) (*ListDetectionJobResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDetectionJobResultsResponse)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_ListDetectionJobResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) DetectDocumentLanguage(ctx context.Context, in *DetectDocumentLanguageRequest, opts ...grpc.CallOption) (*DetectDocumentLanguageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectDocumentLanguageResponse)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_DetectDocumentLanguage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) DetectSubtitleLanguage(ctx context.Context, in *DetectSubtitleLanguageRequest, opts ...grpc.CallOption) (*DetectSubtitleLanguageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectSubtitleLanguageResponse)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_DetectSubtitleLanguage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) DetectLanguageRaw(ctx context.Context, in *DetectLanguageRawRequest, opts ...grpc.CallOption) (*DetectLanguageRawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectLanguageRawResponse)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_DetectLanguageRaw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *languageDetectionServiceClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageReportResponse)
+	err := c.cc.Invoke(ctx, LanguageDetectionService_GetUsageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LanguageDetectionServiceServer is the server API for LanguageDetectionService service.
+// All implementations must embed UnimplementedLanguageDetectionServiceServer
+// for forward compatibility.
+//
+// LanguageDetectionService provides language detection capabilities
+type LanguageDetectionServiceServer interface {
+	DetectLanguage(context.Context, *DetectLanguageRequest) (*DetectLanguageResponse, error)
+	// DetectLanguageStream detects the language of a continuous flow of text
+	// fragments. Every fragment is answered with its own detection and, on
+	// request, a running estimate for the fragment's session.
+	DetectLanguageStream(grpc.BidiStreamingServer[StreamDetectLanguageRequest, StreamDetectLanguageResponse]) error
+	// ListSupportedLanguages returns the languages the running instance can return
+	ListSupportedLanguages(context.Context, *ListSupportedLanguagesRequest) (*ListSupportedLanguagesResponse, error)
+	// GetLanguageInfo returns the description of a single supported language
+	GetLanguageInfo(context.Context, *GetLanguageInfoRequest) (*LanguageInfo, error)
+	// SubmitDetectionJob queues a large document set for asynchronous detection
+	SubmitDetectionJob(context.Context, *SubmitDetectionJobRequest) (*DetectionJob, error)
+	// GetDetectionJob reports the progress of a detection job
+	GetDetectionJob(context.Context, *GetDetectionJobRequest) (*DetectionJob, error)
+	// ListDetectionJobResults returns a page of detection job results
+	ListDetectionJobResults(context.Context, *ListDetectionJobResultsRequest) (*ListDetectionJobResultsResponse, error)
+	// DetectDocumentLanguage extracts the text of a file (plain text, DOCX, ODT
+	// or EPUB) and detects the language of each section and of the whole file
+	DetectDocumentLanguage(context.Context, *DetectDocumentLanguageRequest) (*DetectDocumentLanguageResponse, error)
+	// DetectSubtitleLanguage detects every cue of an SRT or WebVTT track and
+	// returns the track's dominant language with the cues that disagree
+	DetectSubtitleLanguage(context.Context, *DetectSubtitleLanguageRequest) (*DetectSubtitleLanguageResponse, error)
+	// DetectLanguageRaw detects the charset of legacy-encoded bytes (for example
+	// Windows-1251 or Shift-JIS), decodes them to UTF-8 and detects the language
+	DetectLanguageRaw(context.Context, *DetectLanguageRawRequest) (*DetectLanguageRawResponse, error)
+	// GetUsageReport returns the documents, characters and billing units detected
+	// per tenant over a day or a month, for billing
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error)
+	mustEmbedUnimplementedLanguageDetectionServiceServer()
+}
+
+// UnimplementedLanguageDetectionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLanguageDetectionServiceServer struct{}
+
+func (UnimplementedLanguageDetectionServiceServer) DetectLanguage(context.Context, *DetectLanguageRequest) (*DetectLanguageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectLanguage not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) DetectLanguageStream(grpc.BidiStreamingServer[StreamDetectLanguageRequest, StreamDetectLanguageResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DetectLanguageStream not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) ListSupportedLanguages(context.Context, *ListSupportedLanguagesRequest) (*ListSupportedLanguagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedLanguages not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) GetLanguageInfo(context.Context, *GetLanguageInfoRequest) (*LanguageInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLanguageInfo not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) SubmitDetectionJob(context.Context, *SubmitDetectionJobRequest) (*DetectionJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDetectionJob not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) GetDetectionJob(context.Context, *GetDetectionJobRequest) (*DetectionJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDetectionJob not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) ListDetectionJobResults(context.Context, *ListDetectionJobResultsRequest) (*ListDetectionJobResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDetectionJobResults not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) DetectDocumentLanguage(context.Context, *DetectDocumentLanguageRequest) (*DetectDocumentLanguageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectDocumentLanguage not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) DetectSubtitleLanguage(context.Context, *DetectSubtitleLanguageRequest) (*DetectSubtitleLanguageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectSubtitleLanguage not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) DetectLanguageRaw(context.Context, *DetectLanguageRawRequest) (*DetectLanguageRawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectLanguageRaw not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageReport not implemented")
+}
+func (UnimplementedLanguageDetectionServiceServer) mustEmbedUnimplementedLanguageDetectionServiceServer() {
+}
+func (UnimplementedLanguageDetectionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeLanguageDetectionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LanguageDetectionServiceServer will
+// result in compilation errors.
+type UnsafeLanguageDetectionServiceServer interface {
+	mustEmbedUnimplementedLanguageDetectionServiceServer()
+}
+
+func RegisterLanguageDetectionServiceServer(s grpc.ServiceRegistrar, srv LanguageDetectionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLanguageDetectionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LanguageDetectionService_ServiceDesc, srv)
+}
+
+func _LanguageDetectionService_DetectLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectLanguageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).DetectLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_DetectLanguage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).DetectLanguage(ctx, req.(*DetectLanguageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_DetectLanguageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LanguageDetectionServiceServer).DetectLanguageStream(&grpc.GenericServerStream[StreamDetectLanguageRequest, StreamDetectLanguageResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LanguageDetectionService_DetectLanguageStreamServer = grpc.BidiStreamingServer[StreamDetectLanguageRequest, StreamDetectLanguageResponse]
+
+func _LanguageDetectionService_ListSupportedLanguages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupportedLanguagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).ListSupportedLanguages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_ListSupportedLanguages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).ListSupportedLanguages(ctx, req.(*ListSupportedLanguagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_GetLanguageInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLanguageInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).GetLanguageInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_GetLanguageInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).GetLanguageInfo(ctx, req.(*GetLanguageInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_SubmitDetectionJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDetectionJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).SubmitDetectionJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_SubmitDetectionJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).SubmitDetectionJob(ctx, req.(*SubmitDetectionJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_GetDetectionJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDetectionJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).GetDetectionJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_GetDetectionJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).GetDetectionJob(ctx, req.(*GetDetectionJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_ListDetectionJobResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDetectionJobResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).ListDetectionJobResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_ListDetectionJobResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).ListDetectionJobResults(ctx, req.(*ListDetectionJobResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_DetectDocumentLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectDocumentLanguageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).DetectDocumentLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_DetectDocumentLanguage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).DetectDocumentLanguage(ctx, req.(*DetectDocumentLanguageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_DetectSubtitleLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectSubtitleLanguageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).DetectSubtitleLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_DetectSubtitleLanguage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).DetectSubtitleLanguage(ctx, req.(*DetectSubtitleLanguageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_DetectLanguageRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectLanguageRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).DetectLanguageRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_DetectLanguageRaw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).DetectLanguageRaw(ctx, req.(*DetectLanguageRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanguageDetectionService_GetUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageDetectionServiceServer).GetUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageDetectionService_GetUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageDetectionServiceServer).GetUsageReport(ctx, req.(*GetUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LanguageDetectionService_ServiceDesc is the grpc.ServiceDesc for LanguageDetectionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LanguageDetectionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.LanguageDetectionService",
+	HandlerType: (*LanguageDetectionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DetectLanguage",
+			Handler:    _LanguageDetectionService_DetectLanguage_Handler,
+		},
+		{
+			MethodName: "ListSupportedLanguages",
+			Handler:    _LanguageDetectionService_ListSupportedLanguages_Handler,
+		},
+		{
+			MethodName: "GetLanguageInfo",
+			Handler:    _LanguageDetectionService_GetLanguageInfo_Handler,
+		},
+		{
+			MethodName: "SubmitDetectionJob",
+			Handler:    _LanguageDetectionService_SubmitDetectionJob_Handler,
+		},
+		{
+			MethodName: "GetDetectionJob",
+			Handler:    _LanguageDetectionService_GetDetectionJob_Handler,
+		},
+		{
+			MethodName: "ListDetectionJobResults",
+			Handler:    _LanguageDetectionService_ListDetectionJobResults_Handler,
+		},
+		{
+			MethodName: "DetectDocumentLanguage",
+			Handler:    _LanguageDetectionService_DetectDocumentLanguage_Handler,
+		},
+		{
+			MethodName: "DetectSubtitleLanguage",
+			Handler:    _LanguageDetectionService_DetectSubtitleLanguage_Handler,
+		},
+		{
+			MethodName: "DetectLanguageRaw",
+			Handler:    _LanguageDetectionService_DetectLanguageRaw_Handler,
+		},
+		{
+			MethodName: "GetUsageReport",
+			Handler:    _LanguageDetectionService_GetUsageReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DetectLanguageStream",
+			Handler:       _LanguageDetectionService_DetectLanguageStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "language_detection.proto",
+}